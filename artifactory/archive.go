@@ -0,0 +1,255 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifactory
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"path"
+	"strings"
+	"sync"
+)
+
+// ArchiveFormat represents the format of an archive deployed with UploadArchive.
+type ArchiveFormat int
+
+const (
+	// ArchiveZip deploys a zip archive.
+	ArchiveZip ArchiveFormat = iota
+	// ArchiveTar deploys an uncompressed tar archive.
+	ArchiveTar
+	// ArchiveTarGz deploys a gzip compressed tar archive.
+	ArchiveTarGz
+)
+
+func (f ArchiveFormat) String() string {
+	switch f {
+	case ArchiveTar:
+		return "tar"
+	case ArchiveTarGz:
+		return "tar.gz"
+	default:
+		return "zip"
+	}
+}
+
+// ArchiveOptions represents the options for deploying an archive exploded by Artifactory.
+type ArchiveOptions struct {
+	// Format is the format of the archive sent to Artifactory.
+	// Defaults to ArchiveZip.
+	Format ArchiveFormat
+
+	// Include lists the glob patterns of the files to archive, all files
+	// if empty. Exclude lists the glob patterns of the files left out,
+	// even if included. The patterns are matched as for UploadDirOptions.
+	Include []string
+	Exclude []string
+
+	// Atomic makes Artifactory deploy the content of the archive all at
+	// once, so none of it is visible if the archive fails to explode.
+	Atomic bool
+
+	// Properties are attached to the exploded artifacts.
+	Properties map[string][]string
+}
+
+// UploadArchive deploys the regular files of the fsys file system to the
+// provided repository, under the provided path prefix, in a single request.
+// Use os.DirFS to deploy a local directory tree.
+//
+// The files are packed into an archive as it is sent, without temporary
+// files, and Artifactory explodes it into the prefix. The archive is
+// packed again if the request is retried. Unlike Upload, no checksums are
+// sent along, as they are only known once the archive is fully sent.
+//
+// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-DeployArtifactsfromArchive
+func (s *ArtifactsService) UploadArchive(repo, prefix string, fsys fs.FS, opts *ArchiveOptions) (*string, *Response, error) {
+	return s.UploadArchiveWithContext(context.Background(), repo, prefix, fsys, opts)
+}
+
+// UploadArchiveWithContext deploys the regular files of the fsys file system to the provided repository in a single request using the provided context.
+func (s *ArtifactsService) UploadArchiveWithContext(ctx context.Context, repo, prefix string, fsys fs.FS, opts *ArchiveOptions) (*string, *Response, error) {
+	ctx = withOperation(ctx, "Artifacts.UploadArchive", "/{repo}/{path}")
+
+	if opts == nil {
+		opts = new(ArchiveOptions)
+	}
+
+	files, err := listFS(fsys, opts.Include, opts.Exclude)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(files) == 0 {
+		return nil, nil, errors.New("no files selected for the archive")
+	}
+
+	a := &archive{fsys: fsys, files: files, format: opts.Format}
+	defer a.close()
+
+	body, err := a.open()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	name := path.Join(strings.Trim(prefix, "/"), "archive."+opts.Format.String())
+
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", uploadURL(repo, name, opts.Properties), body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Retries replay the body by packing the archive again, instead of buffering it.
+	req.GetBody = a.open
+
+	req.Header.Set("X-Explode-Archive", "true")
+	if opts.Atomic {
+		req.Header.Set("X-Explode-Archive-Atomic", "true")
+	}
+
+	v := new(string)
+
+	resp, err := s.client.DoContext(ctx, req, v)
+	return v, resp, err
+}
+
+// archive packs files of a file system into an archive streamed through a pipe.
+type archive struct {
+	fsys   fs.FS
+	files  []DirFile
+	format ArchiveFormat
+
+	mu      sync.Mutex
+	readers []*io.PipeReader
+}
+
+// open returns a reader of the archive, packed by a goroutine as it is read.
+func (a *archive) open() (io.ReadCloser, error) {
+	r, w := io.Pipe()
+
+	a.mu.Lock()
+	a.readers = append(a.readers, r)
+	a.mu.Unlock()
+
+	go func() {
+		_ = w.CloseWithError(a.write(w))
+	}()
+
+	return r, nil
+}
+
+// close closes the readers returned by open, so the goroutines
+// packing archives that weren't fully read stop.
+func (a *archive) close() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for _, r := range a.readers {
+		_ = r.Close()
+	}
+}
+
+// write packs the files into an archive written to w.
+func (a *archive) write(w io.Writer) error {
+	if a.format == ArchiveZip {
+		return a.writeZip(w)
+	}
+
+	if a.format == ArchiveTarGz {
+		gz := gzip.NewWriter(w)
+		if err := a.writeTar(gz); err != nil {
+			return err
+		}
+
+		return gz.Close()
+	}
+
+	return a.writeTar(w)
+}
+
+// writeZip packs the files into a zip archive written to w.
+func (a *archive) writeZip(w io.Writer) error {
+	zw := zip.NewWriter(w)
+
+	for _, f := range a.files {
+		err := a.copyFile(f.Path, func(info fs.FileInfo) (io.Writer, error) {
+			header, err := zip.FileInfoHeader(info)
+			if err != nil {
+				return nil, err
+			}
+
+			header.Name = f.Path
+			header.Method = zip.Deflate
+
+			return zw.CreateHeader(header)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return zw.Close()
+}
+
+// writeTar packs the files into a tar archive written to w.
+func (a *archive) writeTar(w io.Writer) error {
+	tw := tar.NewWriter(w)
+
+	for _, f := range a.files {
+		err := a.copyFile(f.Path, func(info fs.FileInfo) (io.Writer, error) {
+			header, err := tar.FileInfoHeader(info, "")
+			if err != nil {
+				return nil, err
+			}
+
+			header.Name = f.Path
+
+			return tw, tw.WriteHeader(header)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return tw.Close()
+}
+
+// copyFile copies the content of the named file to the writer create
+// returns for its current info.
+func (a *archive) copyFile(name string, create func(info fs.FileInfo) (io.Writer, error)) error {
+	f, err := a.fsys.Open(name)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }()
+
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	w, err := create(info)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(w, f)
+	return err
+}
@@ -0,0 +1,158 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifactory
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/franela/goblin"
+)
+
+func Test_UploadArchive(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		failures int
+		bodies   [][]byte
+		requests []*http.Request
+	)
+
+	// Create http test server that fails the first requests
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		body, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, body)
+		requests = append(requests, r)
+
+		if len(requests) <= failures {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+	}))
+
+	// Create the client to interact with the http test server
+	c, _ := NewClient(s.URL, nil)
+
+	fsys := fstest.MapFS{
+		"org/app.jar":      {Data: []byte("jar")},
+		"org/app.pom":      {Data: []byte("pom")},
+		"org/test/app.jar": {Data: []byte("test jar")},
+	}
+
+	g := goblin.Goblin(t)
+	g.Describe("Artifacts.UploadArchive", func() {
+		g.BeforeEach(func() {
+			failures, bodies, requests = 0, nil, nil
+			c.RetryPolicy = nil
+		})
+
+		// Close http test server after we're done using it
+		g.After(func() {
+			s.Close()
+		})
+
+		g.It("- should deploy a zip archive to be exploded", func() {
+			opts := &ArchiveOptions{Exclude: []string{"**/test/**"}, Properties: map[string][]string{"build.number": {"42"}}}
+			_, resp, err := c.Artifacts.UploadArchive("libs-local", "/dist/", fsys, opts)
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(resp.StatusCode).Equal(http.StatusCreated)
+			g.Assert(requests[0].Method).Equal("PUT")
+			g.Assert(requests[0].URL.EscapedPath()).Equal("/libs-local/dist/archive.zip;build.number=42")
+			g.Assert(requests[0].Header.Get("X-Explode-Archive")).Equal("true")
+			g.Assert(requests[0].Header.Get("X-Explode-Archive-Atomic")).Equal("")
+
+			zr, err := zip.NewReader(bytes.NewReader(bodies[0]), int64(len(bodies[0])))
+			g.Assert(err == nil).IsTrue()
+			g.Assert(len(zr.File)).Equal(2)
+			g.Assert(zr.File[0].Name).Equal("org/app.jar")
+			g.Assert(zr.File[1].Name).Equal("org/app.pom")
+		})
+
+		g.It("- should deploy a tar archive atomically", func() {
+			_, _, err := c.Artifacts.UploadArchive("libs-local", "", fsys, &ArchiveOptions{Format: ArchiveTar, Atomic: true})
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(requests[0].URL.EscapedPath()).Equal("/libs-local/archive.tar")
+			g.Assert(requests[0].Header.Get("X-Explode-Archive-Atomic")).Equal("true")
+
+			var names []string
+			tr := tar.NewReader(bytes.NewReader(bodies[0]))
+			for {
+				header, err := tr.Next()
+				if err == io.EOF {
+					break
+				}
+
+				data, _ := ioutil.ReadAll(tr)
+				g.Assert(data).Equal(fsys[header.Name].Data)
+
+				names = append(names, header.Name)
+			}
+			g.Assert(names).Equal([]string{"org/app.jar", "org/app.pom", "org/test/app.jar"})
+		})
+
+		g.It("- should pack the archive again when retried", func() {
+			failures = 1
+			c.RetryPolicy = DefaultRetryPolicy()
+			c.RetryPolicy.MinBackoff = time.Millisecond
+			c.RetryPolicy.MaxBackoff = 5 * time.Millisecond
+
+			_, _, err := c.Artifacts.UploadArchive("libs-local", "dist", fsys, nil)
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(len(bodies)).Equal(2)
+			g.Assert(len(bodies[1]) > 0).IsTrue()
+			g.Assert(bodies[0]).Equal(bodies[1])
+		})
+
+		g.It("- should fail without files to archive", func() {
+			_, _, err := c.Artifacts.UploadArchive("libs-local", "dist", fsys, &ArchiveOptions{Include: []string{"*.txt"}})
+
+			g.Assert(err != nil).IsTrue()
+			g.Assert(len(requests)).Equal(0)
+		})
+	})
+}
+
+func Test_ArchiveFormat(t *testing.T) {
+	var tests = []struct {
+		format ArchiveFormat
+		out    string
+	}{
+		{ArchiveZip, "zip"},
+		{ArchiveTar, "tar"},
+		{ArchiveTarGz, "tar.gz"},
+	}
+
+	for i, tt := range tests {
+		if got := tt.format.String(); got != tt.out {
+			t.Errorf("ArchiveFormat #%d returned %q, want %q", i, got, tt.out)
+		}
+	}
+}
@@ -1815,6 +1815,14 @@ func (i *ItemProperties) GetURI() string {
 	return *i.URI
 }
 
+// GetResume returns the Resume field.
+func (l *LargeUploadOptions) GetResume() *MultipartUpload {
+	if l == nil {
+		return nil
+	}
+	return l.Resume
+}
+
 // GetDescriptionAttribute returns the DescriptionAttribute field if it's non-nil, zero value otherwise.
 func (l *LdapGroupSetting) GetDescriptionAttribute() string {
 	if l == nil || l.DescriptionAttribute == nil {
@@ -2279,6 +2287,14 @@ func (m *Modules) GetProperties() map[string]string {
 	return *m.Properties
 }
 
+// GetUpload returns the Upload field.
+func (m *MultipartUploadError) GetUpload() *MultipartUpload {
+	if m == nil {
+		return nil
+	}
+	return m.Upload
+}
+
 // GetCronExp returns the CronExp field if it's non-nil, zero value otherwise.
 func (m *MultiPushReplication) GetCronExp() string {
 	if m == nil || m.CronExp == nil {
@@ -0,0 +1,2930 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+// Code generated by gen-services; DO NOT EDIT.
+
+package artifactory
+
+import (
+	"context"
+	"io"
+	"io/fs"
+)
+
+// ArtifactsAPI is the interface implemented by the ArtifactsService.
+// The field of the Client can be set to a fake, like a ArtifactsStub, in tests.
+type ArtifactsAPI interface {
+	// ApplySync applies the provided plan, as computed by PlanSync. Entries failing
+	// to apply don't stop the others; their errors are set in the plan and joined
+	// in the returned error. Deleted entries are left out if NoDelete is set.
+	ApplySync(plan *SyncPlan, opts *SyncOptions) error
+
+	// ApplySyncWithContext applies the provided plan using the provided context.
+	ApplySyncWithContext(ctx context.Context, plan *SyncPlan, opts *SyncOptions) error
+
+	// Copy duplicates the provided artifact to the provided destination.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-CopyItem
+	Copy(sourceRepo string, sourcePath string, targetRepo string, targetPath string) (*Artifacts, *Response, error)
+
+	// CopyItem duplicates the provided artifact, or folder, to the provided destination
+	// with the provided options. The messages of the copy are returned along with an
+	// error if some items failed, so that Result can tell them apart.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-CopyItem
+	CopyItem(sourceRepo string, sourcePath string, targetRepo string, targetPath string, opts *TransferOptions) (*Artifacts, *Response, error)
+
+	// CopyItemWithContext duplicates the provided artifact, or folder, to the provided destination
+	// with the provided options using the provided context.
+	CopyItemWithContext(ctx context.Context, sourceRepo string, sourcePath string, targetRepo string, targetPath string, opts *TransferOptions) (*Artifacts, *Response, error)
+
+	// CopyWithContext duplicates the provided artifact to the provided destination using the provided context.
+	CopyWithContext(ctx context.Context, sourceRepo string, sourcePath string, targetRepo string, targetPath string) (*Artifacts, *Response, error)
+
+	// Delete removes the provided artifact.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-DeleteItem
+	Delete(repo string, path string) (*string, *Response, error)
+
+	// DeleteWithContext removes the provided artifact using the provided context.
+	DeleteWithContext(ctx context.Context, repo string, path string) (*string, *Response, error)
+
+	// Download retrieves the provided artifact.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-RetrieveArtifact
+	Download(repo string, path string) (*[]byte, *Response, error)
+
+	// DownloadDir downloads the artifacts under the provided path prefix of the
+	// repository to the localDir directory, using a pool of workers. The
+	// artifacts are listed with StorageService.GetFileList.
+	//
+	// Files whose SHA-1 checksum already matches the one of the artifact are
+	// skipped. The other files are written to a temporary file in the same
+	// directory, only replacing the existing file once complete, with their
+	// checksums verified as for DownloadTo. A file failing to download doesn't
+	// stop the others; the report lists the outcome for every file, and the
+	// returned error joins the errors of the failed files.
+	DownloadDir(repo string, prefix string, localDir string, opts *DownloadDirOptions) (*DirReport, error)
+
+	// DownloadDirWithContext downloads the artifacts under the provided path prefix of the repository to the localDir directory using the provided context.
+	DownloadDirWithContext(ctx context.Context, repo string, prefix string, localDir string, opts *DownloadDirOptions) (*DirReport, error)
+
+	// DownloadLarge downloads the provided artifact to the dest file, fetching
+	// segments of it concurrently with Range requests.
+	//
+	// The artifact is written to dest with a ".part" suffix, alongside a
+	// ".part.json" file recording the segments written so far. If the download
+	// is interrupted, calling DownloadLarge again for the same artifact resumes
+	// it from the recorded segments. A segment that fails midway is requested
+	// again from where it stopped, as allowed by the RetryPolicy of the Client.
+	//
+	// Once all segments are written, the SHA-256 checksum of the file is verified
+	// against the checksums returned by StorageService.GetFile, and dest is only
+	// created if they match. Otherwise a *ChecksumError is returned and the partial
+	// download is discarded.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-RetrieveArtifact
+	DownloadLarge(repo string, path string, dest string, opts *LargeDownloadOptions) (*File, *Response, error)
+
+	// DownloadLargeWithContext downloads the provided artifact to the dest file in concurrent segments using the provided context.
+	DownloadLargeWithContext(ctx context.Context, repo string, path string, dest string, opts *LargeDownloadOptions) (*File, *Response, error)
+
+	// DownloadTo streams the provided artifact to w, without buffering it in memory.
+	// The X-Checksum-Sha1 and X-Checksum-Sha256 response headers are verified
+	// against the streamed content, and a *ChecksumError is returned if they differ.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-RetrieveArtifact
+	DownloadTo(repo string, path string, w io.Writer, opts *DownloadOptions) (*Response, error)
+
+	// DownloadToWithContext streams the provided artifact to w using the provided context.
+	DownloadToWithContext(ctx context.Context, repo string, path string, w io.Writer, opts *DownloadOptions) (*Response, error)
+
+	// DownloadWithContext retrieves the provided artifact using the provided context.
+	DownloadWithContext(ctx context.Context, repo string, path string) (*[]byte, *Response, error)
+
+	// Move migrates the provided artifact to the provided destination.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-MoveItem
+	Move(sourceRepo string, sourcePath string, targetRepo string, targetPath string) (*Artifacts, *Response, error)
+
+	// MoveItem migrates the provided artifact, or folder, to the provided destination
+	// with the provided options. The messages of the move are returned along with an
+	// error if some items failed, so that Result can tell them apart.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-MoveItem
+	MoveItem(sourceRepo string, sourcePath string, targetRepo string, targetPath string, opts *TransferOptions) (*Artifacts, *Response, error)
+
+	// MoveItemWithContext migrates the provided artifact, or folder, to the provided destination
+	// with the provided options using the provided context.
+	MoveItemWithContext(ctx context.Context, sourceRepo string, sourcePath string, targetRepo string, targetPath string, opts *TransferOptions) (*Artifacts, *Response, error)
+
+	// MoveWithContext migrates the provided artifact to the provided destination using the provided context.
+	MoveWithContext(ctx context.Context, sourceRepo string, sourcePath string, targetRepo string, targetPath string) (*Artifacts, *Response, error)
+
+	// Open returns a reader streaming the provided artifact.
+	// The caller must close the reader. Reading it to the end verifies the
+	// X-Checksum-Sha1 and X-Checksum-Sha256 response headers against the
+	// streamed content, and the final read returns a *ChecksumError if they differ.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-RetrieveArtifact
+	Open(repo string, path string, opts *DownloadOptions) (io.ReadCloser, *Response, error)
+
+	// OpenWithContext returns a reader streaming the provided artifact using the provided context.
+	// Cancelling ctx aborts any read in progress.
+	OpenWithContext(ctx context.Context, repo string, path string, opts *DownloadOptions) (io.ReadCloser, *Response, error)
+
+	// PlanSync computes the changes syncing the localDir directory and the provided
+	// path of the repository, without applying them. The artifacts are listed with
+	// StorageService.GetFileList, and the local files are hashed concurrently.
+	// A missing repository path is synced as an empty one.
+	PlanSync(localDir string, repo string, prefix string, opts *SyncOptions) (*SyncPlan, error)
+
+	// PlanSyncWithContext computes the changes syncing the localDir directory and the provided path of the repository using the provided context.
+	PlanSyncWithContext(ctx context.Context, localDir string, repo string, prefix string, opts *SyncOptions) (*SyncPlan, error)
+
+	// Sync makes the provided path of the repository mirror the localDir
+	// directory, or the other way around for a SyncDownload, comparing the
+	// files by SHA-1 checksum. It returns the plan of the changes, with the
+	// errors of the entries that failed to apply, which are joined in the
+	// returned error. With DryRun set, the plan is only computed.
+	//
+	// Sync is the same as PlanSync followed by ApplySync.
+	Sync(localDir string, repo string, prefix string, opts *SyncOptions) (*SyncPlan, error)
+
+	// SyncWithContext makes the provided path of the repository mirror the localDir directory using the provided context.
+	SyncWithContext(ctx context.Context, localDir string, repo string, prefix string, opts *SyncOptions) (*SyncPlan, error)
+
+	// Upload deploys the provided artifact to the provided repository.
+	// The checksums of the artifact are sent along, so Artifactory can verify them.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-DeployArtifact
+	Upload(repo string, path string, source string, properties map[string][]string) (*string, *Response, error)
+
+	// UploadArchive deploys the regular files of the fsys file system to the
+	// provided repository, under the provided path prefix, in a single request.
+	// Use os.DirFS to deploy a local directory tree.
+	//
+	// The files are packed into an archive as it is sent, without temporary
+	// files, and Artifactory explodes it into the prefix. The archive is
+	// packed again if the request is retried. Unlike Upload, no checksums are
+	// sent along, as they are only known once the archive is fully sent.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-DeployArtifactsfromArchive
+	UploadArchive(repo string, prefix string, fsys fs.FS, opts *ArchiveOptions) (*string, *Response, error)
+
+	// UploadArchiveWithContext deploys the regular files of the fsys file system to the provided repository in a single request using the provided context.
+	UploadArchiveWithContext(ctx context.Context, repo string, prefix string, fsys fs.FS, opts *ArchiveOptions) (*string, *Response, error)
+
+	// UploadDir deploys the files of the localDir directory tree to the provided
+	// repository, under the provided path prefix, using a pool of workers.
+	//
+	// Files whose SHA-1 checksum matches the one of the artifact already deployed
+	// are skipped. A file failing to upload doesn't stop the others; the report
+	// lists the outcome for every file, and the returned error joins the errors
+	// of the failed files.
+	UploadDir(localDir string, repo string, prefix string, opts *UploadDirOptions) (*DirReport, error)
+
+	// UploadDirWithContext deploys the files of the localDir directory tree to the provided repository using the provided context.
+	UploadDirWithContext(ctx context.Context, localDir string, repo string, prefix string, opts *UploadDirOptions) (*DirReport, error)
+
+	// UploadLarge deploys a large artifact by uploading it in parts concurrently.
+	// Content smaller than the threshold is deployed with a single request, like UploadReader.
+	//
+	// A part that fails is retried as allowed by the RetryPolicy of the Client.
+	// If the upload still fails, the returned error is a *MultipartUploadError
+	// holding the state needed to resume it. Once all parts are uploaded, the
+	// SHA-256 checksum of the deployed artifact is verified against the content.
+	//
+	// Upload in parts requires an Artifactory instance with cloud storage.
+	UploadLarge(repo string, path string, r io.ReaderAt, size int64, opts *LargeUploadOptions) (*File, *Response, error)
+
+	// UploadLargeWithContext deploys a large artifact by uploading it in parts concurrently using the provided context.
+	UploadLargeWithContext(ctx context.Context, repo string, path string, r io.ReaderAt, size int64, opts *LargeUploadOptions) (*File, *Response, error)
+
+	// UploadReader deploys the content read from r to the provided repository.
+	// size is the expected length of the content, or -1 if unknown.
+	// The MD5, SHA-1 and SHA-256 checksums of the content are computed and sent
+	// along, so Artifactory can verify them. Content that can't be rewound is
+	// spooled to a temporary file while its checksums are computed.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-DeployArtifact
+	//
+	// 	https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-DeployArtifactbyChecksum
+	UploadReader(repo string, path string, r io.Reader, size int64, opts *UploadOptions) (*File, *Response, error)
+
+	// UploadReaderWithContext deploys the content read from r to the provided repository using the provided context.
+	UploadReaderWithContext(ctx context.Context, repo string, path string, r io.Reader, size int64, opts *UploadOptions) (*File, *Response, error)
+
+	// UploadWithContext deploys the provided artifact to the provided repository using the provided context.
+	UploadWithContext(ctx context.Context, repo string, path string, source string, properties map[string][]string) (*string, *Response, error)
+}
+
+// ArtifactsStub is an implementation of the ArtifactsAPI for tests.
+// Every method calls the field of the same name with a Func suffix.
+// When it is nil, the method calls the field of its context variant,
+// or of the method it is the context variant of, and panics if that
+// one is nil too.
+type ArtifactsStub struct {
+	ApplySyncFunc                func(plan *SyncPlan, opts *SyncOptions) error
+	ApplySyncWithContextFunc     func(ctx context.Context, plan *SyncPlan, opts *SyncOptions) error
+	CopyFunc                     func(sourceRepo string, sourcePath string, targetRepo string, targetPath string) (*Artifacts, *Response, error)
+	CopyItemFunc                 func(sourceRepo string, sourcePath string, targetRepo string, targetPath string, opts *TransferOptions) (*Artifacts, *Response, error)
+	CopyItemWithContextFunc      func(ctx context.Context, sourceRepo string, sourcePath string, targetRepo string, targetPath string, opts *TransferOptions) (*Artifacts, *Response, error)
+	CopyWithContextFunc          func(ctx context.Context, sourceRepo string, sourcePath string, targetRepo string, targetPath string) (*Artifacts, *Response, error)
+	DeleteFunc                   func(repo string, path string) (*string, *Response, error)
+	DeleteWithContextFunc        func(ctx context.Context, repo string, path string) (*string, *Response, error)
+	DownloadFunc                 func(repo string, path string) (*[]byte, *Response, error)
+	DownloadDirFunc              func(repo string, prefix string, localDir string, opts *DownloadDirOptions) (*DirReport, error)
+	DownloadDirWithContextFunc   func(ctx context.Context, repo string, prefix string, localDir string, opts *DownloadDirOptions) (*DirReport, error)
+	DownloadLargeFunc            func(repo string, path string, dest string, opts *LargeDownloadOptions) (*File, *Response, error)
+	DownloadLargeWithContextFunc func(ctx context.Context, repo string, path string, dest string, opts *LargeDownloadOptions) (*File, *Response, error)
+	DownloadToFunc               func(repo string, path string, w io.Writer, opts *DownloadOptions) (*Response, error)
+	DownloadToWithContextFunc    func(ctx context.Context, repo string, path string, w io.Writer, opts *DownloadOptions) (*Response, error)
+	DownloadWithContextFunc      func(ctx context.Context, repo string, path string) (*[]byte, *Response, error)
+	MoveFunc                     func(sourceRepo string, sourcePath string, targetRepo string, targetPath string) (*Artifacts, *Response, error)
+	MoveItemFunc                 func(sourceRepo string, sourcePath string, targetRepo string, targetPath string, opts *TransferOptions) (*Artifacts, *Response, error)
+	MoveItemWithContextFunc      func(ctx context.Context, sourceRepo string, sourcePath string, targetRepo string, targetPath string, opts *TransferOptions) (*Artifacts, *Response, error)
+	MoveWithContextFunc          func(ctx context.Context, sourceRepo string, sourcePath string, targetRepo string, targetPath string) (*Artifacts, *Response, error)
+	OpenFunc                     func(repo string, path string, opts *DownloadOptions) (io.ReadCloser, *Response, error)
+	OpenWithContextFunc          func(ctx context.Context, repo string, path string, opts *DownloadOptions) (io.ReadCloser, *Response, error)
+	PlanSyncFunc                 func(localDir string, repo string, prefix string, opts *SyncOptions) (*SyncPlan, error)
+	PlanSyncWithContextFunc      func(ctx context.Context, localDir string, repo string, prefix string, opts *SyncOptions) (*SyncPlan, error)
+	SyncFunc                     func(localDir string, repo string, prefix string, opts *SyncOptions) (*SyncPlan, error)
+	SyncWithContextFunc          func(ctx context.Context, localDir string, repo string, prefix string, opts *SyncOptions) (*SyncPlan, error)
+	UploadFunc                   func(repo string, path string, source string, properties map[string][]string) (*string, *Response, error)
+	UploadArchiveFunc            func(repo string, prefix string, fsys fs.FS, opts *ArchiveOptions) (*string, *Response, error)
+	UploadArchiveWithContextFunc func(ctx context.Context, repo string, prefix string, fsys fs.FS, opts *ArchiveOptions) (*string, *Response, error)
+	UploadDirFunc                func(localDir string, repo string, prefix string, opts *UploadDirOptions) (*DirReport, error)
+	UploadDirWithContextFunc     func(ctx context.Context, localDir string, repo string, prefix string, opts *UploadDirOptions) (*DirReport, error)
+	UploadLargeFunc              func(repo string, path string, r io.ReaderAt, size int64, opts *LargeUploadOptions) (*File, *Response, error)
+	UploadLargeWithContextFunc   func(ctx context.Context, repo string, path string, r io.ReaderAt, size int64, opts *LargeUploadOptions) (*File, *Response, error)
+	UploadReaderFunc             func(repo string, path string, r io.Reader, size int64, opts *UploadOptions) (*File, *Response, error)
+	UploadReaderWithContextFunc  func(ctx context.Context, repo string, path string, r io.Reader, size int64, opts *UploadOptions) (*File, *Response, error)
+	UploadWithContextFunc        func(ctx context.Context, repo string, path string, source string, properties map[string][]string) (*string, *Response, error)
+}
+
+// ApplySync calls ApplySyncFunc.
+func (stub *ArtifactsStub) ApplySync(plan *SyncPlan, opts *SyncOptions) error {
+	if stub.ApplySyncFunc != nil {
+		return stub.ApplySyncFunc(plan, opts)
+	}
+	if stub.ApplySyncWithContextFunc != nil {
+		return stub.ApplySyncWithContextFunc(context.Background(), plan, opts)
+	}
+	panic("ArtifactsStub.ApplySync not stubbed")
+}
+
+// ApplySyncWithContext calls ApplySyncWithContextFunc.
+func (stub *ArtifactsStub) ApplySyncWithContext(ctx context.Context, plan *SyncPlan, opts *SyncOptions) error {
+	if stub.ApplySyncWithContextFunc != nil {
+		return stub.ApplySyncWithContextFunc(ctx, plan, opts)
+	}
+	if stub.ApplySyncFunc != nil {
+		return stub.ApplySyncFunc(plan, opts)
+	}
+	panic("ArtifactsStub.ApplySyncWithContext not stubbed")
+}
+
+// Copy calls CopyFunc.
+func (stub *ArtifactsStub) Copy(sourceRepo string, sourcePath string, targetRepo string, targetPath string) (*Artifacts, *Response, error) {
+	if stub.CopyFunc != nil {
+		return stub.CopyFunc(sourceRepo, sourcePath, targetRepo, targetPath)
+	}
+	if stub.CopyWithContextFunc != nil {
+		return stub.CopyWithContextFunc(context.Background(), sourceRepo, sourcePath, targetRepo, targetPath)
+	}
+	panic("ArtifactsStub.Copy not stubbed")
+}
+
+// CopyItem calls CopyItemFunc.
+func (stub *ArtifactsStub) CopyItem(sourceRepo string, sourcePath string, targetRepo string, targetPath string, opts *TransferOptions) (*Artifacts, *Response, error) {
+	if stub.CopyItemFunc != nil {
+		return stub.CopyItemFunc(sourceRepo, sourcePath, targetRepo, targetPath, opts)
+	}
+	if stub.CopyItemWithContextFunc != nil {
+		return stub.CopyItemWithContextFunc(context.Background(), sourceRepo, sourcePath, targetRepo, targetPath, opts)
+	}
+	panic("ArtifactsStub.CopyItem not stubbed")
+}
+
+// CopyItemWithContext calls CopyItemWithContextFunc.
+func (stub *ArtifactsStub) CopyItemWithContext(ctx context.Context, sourceRepo string, sourcePath string, targetRepo string, targetPath string, opts *TransferOptions) (*Artifacts, *Response, error) {
+	if stub.CopyItemWithContextFunc != nil {
+		return stub.CopyItemWithContextFunc(ctx, sourceRepo, sourcePath, targetRepo, targetPath, opts)
+	}
+	if stub.CopyItemFunc != nil {
+		return stub.CopyItemFunc(sourceRepo, sourcePath, targetRepo, targetPath, opts)
+	}
+	panic("ArtifactsStub.CopyItemWithContext not stubbed")
+}
+
+// CopyWithContext calls CopyWithContextFunc.
+func (stub *ArtifactsStub) CopyWithContext(ctx context.Context, sourceRepo string, sourcePath string, targetRepo string, targetPath string) (*Artifacts, *Response, error) {
+	if stub.CopyWithContextFunc != nil {
+		return stub.CopyWithContextFunc(ctx, sourceRepo, sourcePath, targetRepo, targetPath)
+	}
+	if stub.CopyFunc != nil {
+		return stub.CopyFunc(sourceRepo, sourcePath, targetRepo, targetPath)
+	}
+	panic("ArtifactsStub.CopyWithContext not stubbed")
+}
+
+// Delete calls DeleteFunc.
+func (stub *ArtifactsStub) Delete(repo string, path string) (*string, *Response, error) {
+	if stub.DeleteFunc != nil {
+		return stub.DeleteFunc(repo, path)
+	}
+	if stub.DeleteWithContextFunc != nil {
+		return stub.DeleteWithContextFunc(context.Background(), repo, path)
+	}
+	panic("ArtifactsStub.Delete not stubbed")
+}
+
+// DeleteWithContext calls DeleteWithContextFunc.
+func (stub *ArtifactsStub) DeleteWithContext(ctx context.Context, repo string, path string) (*string, *Response, error) {
+	if stub.DeleteWithContextFunc != nil {
+		return stub.DeleteWithContextFunc(ctx, repo, path)
+	}
+	if stub.DeleteFunc != nil {
+		return stub.DeleteFunc(repo, path)
+	}
+	panic("ArtifactsStub.DeleteWithContext not stubbed")
+}
+
+// Download calls DownloadFunc.
+func (stub *ArtifactsStub) Download(repo string, path string) (*[]byte, *Response, error) {
+	if stub.DownloadFunc != nil {
+		return stub.DownloadFunc(repo, path)
+	}
+	if stub.DownloadWithContextFunc != nil {
+		return stub.DownloadWithContextFunc(context.Background(), repo, path)
+	}
+	panic("ArtifactsStub.Download not stubbed")
+}
+
+// DownloadDir calls DownloadDirFunc.
+func (stub *ArtifactsStub) DownloadDir(repo string, prefix string, localDir string, opts *DownloadDirOptions) (*DirReport, error) {
+	if stub.DownloadDirFunc != nil {
+		return stub.DownloadDirFunc(repo, prefix, localDir, opts)
+	}
+	if stub.DownloadDirWithContextFunc != nil {
+		return stub.DownloadDirWithContextFunc(context.Background(), repo, prefix, localDir, opts)
+	}
+	panic("ArtifactsStub.DownloadDir not stubbed")
+}
+
+// DownloadDirWithContext calls DownloadDirWithContextFunc.
+func (stub *ArtifactsStub) DownloadDirWithContext(ctx context.Context, repo string, prefix string, localDir string, opts *DownloadDirOptions) (*DirReport, error) {
+	if stub.DownloadDirWithContextFunc != nil {
+		return stub.DownloadDirWithContextFunc(ctx, repo, prefix, localDir, opts)
+	}
+	if stub.DownloadDirFunc != nil {
+		return stub.DownloadDirFunc(repo, prefix, localDir, opts)
+	}
+	panic("ArtifactsStub.DownloadDirWithContext not stubbed")
+}
+
+// DownloadLarge calls DownloadLargeFunc.
+func (stub *ArtifactsStub) DownloadLarge(repo string, path string, dest string, opts *LargeDownloadOptions) (*File, *Response, error) {
+	if stub.DownloadLargeFunc != nil {
+		return stub.DownloadLargeFunc(repo, path, dest, opts)
+	}
+	if stub.DownloadLargeWithContextFunc != nil {
+		return stub.DownloadLargeWithContextFunc(context.Background(), repo, path, dest, opts)
+	}
+	panic("ArtifactsStub.DownloadLarge not stubbed")
+}
+
+// DownloadLargeWithContext calls DownloadLargeWithContextFunc.
+func (stub *ArtifactsStub) DownloadLargeWithContext(ctx context.Context, repo string, path string, dest string, opts *LargeDownloadOptions) (*File, *Response, error) {
+	if stub.DownloadLargeWithContextFunc != nil {
+		return stub.DownloadLargeWithContextFunc(ctx, repo, path, dest, opts)
+	}
+	if stub.DownloadLargeFunc != nil {
+		return stub.DownloadLargeFunc(repo, path, dest, opts)
+	}
+	panic("ArtifactsStub.DownloadLargeWithContext not stubbed")
+}
+
+// DownloadTo calls DownloadToFunc.
+func (stub *ArtifactsStub) DownloadTo(repo string, path string, w io.Writer, opts *DownloadOptions) (*Response, error) {
+	if stub.DownloadToFunc != nil {
+		return stub.DownloadToFunc(repo, path, w, opts)
+	}
+	if stub.DownloadToWithContextFunc != nil {
+		return stub.DownloadToWithContextFunc(context.Background(), repo, path, w, opts)
+	}
+	panic("ArtifactsStub.DownloadTo not stubbed")
+}
+
+// DownloadToWithContext calls DownloadToWithContextFunc.
+func (stub *ArtifactsStub) DownloadToWithContext(ctx context.Context, repo string, path string, w io.Writer, opts *DownloadOptions) (*Response, error) {
+	if stub.DownloadToWithContextFunc != nil {
+		return stub.DownloadToWithContextFunc(ctx, repo, path, w, opts)
+	}
+	if stub.DownloadToFunc != nil {
+		return stub.DownloadToFunc(repo, path, w, opts)
+	}
+	panic("ArtifactsStub.DownloadToWithContext not stubbed")
+}
+
+// DownloadWithContext calls DownloadWithContextFunc.
+func (stub *ArtifactsStub) DownloadWithContext(ctx context.Context, repo string, path string) (*[]byte, *Response, error) {
+	if stub.DownloadWithContextFunc != nil {
+		return stub.DownloadWithContextFunc(ctx, repo, path)
+	}
+	if stub.DownloadFunc != nil {
+		return stub.DownloadFunc(repo, path)
+	}
+	panic("ArtifactsStub.DownloadWithContext not stubbed")
+}
+
+// Move calls MoveFunc.
+func (stub *ArtifactsStub) Move(sourceRepo string, sourcePath string, targetRepo string, targetPath string) (*Artifacts, *Response, error) {
+	if stub.MoveFunc != nil {
+		return stub.MoveFunc(sourceRepo, sourcePath, targetRepo, targetPath)
+	}
+	if stub.MoveWithContextFunc != nil {
+		return stub.MoveWithContextFunc(context.Background(), sourceRepo, sourcePath, targetRepo, targetPath)
+	}
+	panic("ArtifactsStub.Move not stubbed")
+}
+
+// MoveItem calls MoveItemFunc.
+func (stub *ArtifactsStub) MoveItem(sourceRepo string, sourcePath string, targetRepo string, targetPath string, opts *TransferOptions) (*Artifacts, *Response, error) {
+	if stub.MoveItemFunc != nil {
+		return stub.MoveItemFunc(sourceRepo, sourcePath, targetRepo, targetPath, opts)
+	}
+	if stub.MoveItemWithContextFunc != nil {
+		return stub.MoveItemWithContextFunc(context.Background(), sourceRepo, sourcePath, targetRepo, targetPath, opts)
+	}
+	panic("ArtifactsStub.MoveItem not stubbed")
+}
+
+// MoveItemWithContext calls MoveItemWithContextFunc.
+func (stub *ArtifactsStub) MoveItemWithContext(ctx context.Context, sourceRepo string, sourcePath string, targetRepo string, targetPath string, opts *TransferOptions) (*Artifacts, *Response, error) {
+	if stub.MoveItemWithContextFunc != nil {
+		return stub.MoveItemWithContextFunc(ctx, sourceRepo, sourcePath, targetRepo, targetPath, opts)
+	}
+	if stub.MoveItemFunc != nil {
+		return stub.MoveItemFunc(sourceRepo, sourcePath, targetRepo, targetPath, opts)
+	}
+	panic("ArtifactsStub.MoveItemWithContext not stubbed")
+}
+
+// MoveWithContext calls MoveWithContextFunc.
+func (stub *ArtifactsStub) MoveWithContext(ctx context.Context, sourceRepo string, sourcePath string, targetRepo string, targetPath string) (*Artifacts, *Response, error) {
+	if stub.MoveWithContextFunc != nil {
+		return stub.MoveWithContextFunc(ctx, sourceRepo, sourcePath, targetRepo, targetPath)
+	}
+	if stub.MoveFunc != nil {
+		return stub.MoveFunc(sourceRepo, sourcePath, targetRepo, targetPath)
+	}
+	panic("ArtifactsStub.MoveWithContext not stubbed")
+}
+
+// Open calls OpenFunc.
+func (stub *ArtifactsStub) Open(repo string, path string, opts *DownloadOptions) (io.ReadCloser, *Response, error) {
+	if stub.OpenFunc != nil {
+		return stub.OpenFunc(repo, path, opts)
+	}
+	if stub.OpenWithContextFunc != nil {
+		return stub.OpenWithContextFunc(context.Background(), repo, path, opts)
+	}
+	panic("ArtifactsStub.Open not stubbed")
+}
+
+// OpenWithContext calls OpenWithContextFunc.
+func (stub *ArtifactsStub) OpenWithContext(ctx context.Context, repo string, path string, opts *DownloadOptions) (io.ReadCloser, *Response, error) {
+	if stub.OpenWithContextFunc != nil {
+		return stub.OpenWithContextFunc(ctx, repo, path, opts)
+	}
+	if stub.OpenFunc != nil {
+		return stub.OpenFunc(repo, path, opts)
+	}
+	panic("ArtifactsStub.OpenWithContext not stubbed")
+}
+
+// PlanSync calls PlanSyncFunc.
+func (stub *ArtifactsStub) PlanSync(localDir string, repo string, prefix string, opts *SyncOptions) (*SyncPlan, error) {
+	if stub.PlanSyncFunc != nil {
+		return stub.PlanSyncFunc(localDir, repo, prefix, opts)
+	}
+	if stub.PlanSyncWithContextFunc != nil {
+		return stub.PlanSyncWithContextFunc(context.Background(), localDir, repo, prefix, opts)
+	}
+	panic("ArtifactsStub.PlanSync not stubbed")
+}
+
+// PlanSyncWithContext calls PlanSyncWithContextFunc.
+func (stub *ArtifactsStub) PlanSyncWithContext(ctx context.Context, localDir string, repo string, prefix string, opts *SyncOptions) (*SyncPlan, error) {
+	if stub.PlanSyncWithContextFunc != nil {
+		return stub.PlanSyncWithContextFunc(ctx, localDir, repo, prefix, opts)
+	}
+	if stub.PlanSyncFunc != nil {
+		return stub.PlanSyncFunc(localDir, repo, prefix, opts)
+	}
+	panic("ArtifactsStub.PlanSyncWithContext not stubbed")
+}
+
+// Sync calls SyncFunc.
+func (stub *ArtifactsStub) Sync(localDir string, repo string, prefix string, opts *SyncOptions) (*SyncPlan, error) {
+	if stub.SyncFunc != nil {
+		return stub.SyncFunc(localDir, repo, prefix, opts)
+	}
+	if stub.SyncWithContextFunc != nil {
+		return stub.SyncWithContextFunc(context.Background(), localDir, repo, prefix, opts)
+	}
+	panic("ArtifactsStub.Sync not stubbed")
+}
+
+// SyncWithContext calls SyncWithContextFunc.
+func (stub *ArtifactsStub) SyncWithContext(ctx context.Context, localDir string, repo string, prefix string, opts *SyncOptions) (*SyncPlan, error) {
+	if stub.SyncWithContextFunc != nil {
+		return stub.SyncWithContextFunc(ctx, localDir, repo, prefix, opts)
+	}
+	if stub.SyncFunc != nil {
+		return stub.SyncFunc(localDir, repo, prefix, opts)
+	}
+	panic("ArtifactsStub.SyncWithContext not stubbed")
+}
+
+// Upload calls UploadFunc.
+func (stub *ArtifactsStub) Upload(repo string, path string, source string, properties map[string][]string) (*string, *Response, error) {
+	if stub.UploadFunc != nil {
+		return stub.UploadFunc(repo, path, source, properties)
+	}
+	if stub.UploadWithContextFunc != nil {
+		return stub.UploadWithContextFunc(context.Background(), repo, path, source, properties)
+	}
+	panic("ArtifactsStub.Upload not stubbed")
+}
+
+// UploadArchive calls UploadArchiveFunc.
+func (stub *ArtifactsStub) UploadArchive(repo string, prefix string, fsys fs.FS, opts *ArchiveOptions) (*string, *Response, error) {
+	if stub.UploadArchiveFunc != nil {
+		return stub.UploadArchiveFunc(repo, prefix, fsys, opts)
+	}
+	if stub.UploadArchiveWithContextFunc != nil {
+		return stub.UploadArchiveWithContextFunc(context.Background(), repo, prefix, fsys, opts)
+	}
+	panic("ArtifactsStub.UploadArchive not stubbed")
+}
+
+// UploadArchiveWithContext calls UploadArchiveWithContextFunc.
+func (stub *ArtifactsStub) UploadArchiveWithContext(ctx context.Context, repo string, prefix string, fsys fs.FS, opts *ArchiveOptions) (*string, *Response, error) {
+	if stub.UploadArchiveWithContextFunc != nil {
+		return stub.UploadArchiveWithContextFunc(ctx, repo, prefix, fsys, opts)
+	}
+	if stub.UploadArchiveFunc != nil {
+		return stub.UploadArchiveFunc(repo, prefix, fsys, opts)
+	}
+	panic("ArtifactsStub.UploadArchiveWithContext not stubbed")
+}
+
+// UploadDir calls UploadDirFunc.
+func (stub *ArtifactsStub) UploadDir(localDir string, repo string, prefix string, opts *UploadDirOptions) (*DirReport, error) {
+	if stub.UploadDirFunc != nil {
+		return stub.UploadDirFunc(localDir, repo, prefix, opts)
+	}
+	if stub.UploadDirWithContextFunc != nil {
+		return stub.UploadDirWithContextFunc(context.Background(), localDir, repo, prefix, opts)
+	}
+	panic("ArtifactsStub.UploadDir not stubbed")
+}
+
+// UploadDirWithContext calls UploadDirWithContextFunc.
+func (stub *ArtifactsStub) UploadDirWithContext(ctx context.Context, localDir string, repo string, prefix string, opts *UploadDirOptions) (*DirReport, error) {
+	if stub.UploadDirWithContextFunc != nil {
+		return stub.UploadDirWithContextFunc(ctx, localDir, repo, prefix, opts)
+	}
+	if stub.UploadDirFunc != nil {
+		return stub.UploadDirFunc(localDir, repo, prefix, opts)
+	}
+	panic("ArtifactsStub.UploadDirWithContext not stubbed")
+}
+
+// UploadLarge calls UploadLargeFunc.
+func (stub *ArtifactsStub) UploadLarge(repo string, path string, r io.ReaderAt, size int64, opts *LargeUploadOptions) (*File, *Response, error) {
+	if stub.UploadLargeFunc != nil {
+		return stub.UploadLargeFunc(repo, path, r, size, opts)
+	}
+	if stub.UploadLargeWithContextFunc != nil {
+		return stub.UploadLargeWithContextFunc(context.Background(), repo, path, r, size, opts)
+	}
+	panic("ArtifactsStub.UploadLarge not stubbed")
+}
+
+// UploadLargeWithContext calls UploadLargeWithContextFunc.
+func (stub *ArtifactsStub) UploadLargeWithContext(ctx context.Context, repo string, path string, r io.ReaderAt, size int64, opts *LargeUploadOptions) (*File, *Response, error) {
+	if stub.UploadLargeWithContextFunc != nil {
+		return stub.UploadLargeWithContextFunc(ctx, repo, path, r, size, opts)
+	}
+	if stub.UploadLargeFunc != nil {
+		return stub.UploadLargeFunc(repo, path, r, size, opts)
+	}
+	panic("ArtifactsStub.UploadLargeWithContext not stubbed")
+}
+
+// UploadReader calls UploadReaderFunc.
+func (stub *ArtifactsStub) UploadReader(repo string, path string, r io.Reader, size int64, opts *UploadOptions) (*File, *Response, error) {
+	if stub.UploadReaderFunc != nil {
+		return stub.UploadReaderFunc(repo, path, r, size, opts)
+	}
+	if stub.UploadReaderWithContextFunc != nil {
+		return stub.UploadReaderWithContextFunc(context.Background(), repo, path, r, size, opts)
+	}
+	panic("ArtifactsStub.UploadReader not stubbed")
+}
+
+// UploadReaderWithContext calls UploadReaderWithContextFunc.
+func (stub *ArtifactsStub) UploadReaderWithContext(ctx context.Context, repo string, path string, r io.Reader, size int64, opts *UploadOptions) (*File, *Response, error) {
+	if stub.UploadReaderWithContextFunc != nil {
+		return stub.UploadReaderWithContextFunc(ctx, repo, path, r, size, opts)
+	}
+	if stub.UploadReaderFunc != nil {
+		return stub.UploadReaderFunc(repo, path, r, size, opts)
+	}
+	panic("ArtifactsStub.UploadReaderWithContext not stubbed")
+}
+
+// UploadWithContext calls UploadWithContextFunc.
+func (stub *ArtifactsStub) UploadWithContext(ctx context.Context, repo string, path string, source string, properties map[string][]string) (*string, *Response, error) {
+	if stub.UploadWithContextFunc != nil {
+		return stub.UploadWithContextFunc(ctx, repo, path, source, properties)
+	}
+	if stub.UploadFunc != nil {
+		return stub.UploadFunc(repo, path, source, properties)
+	}
+	panic("ArtifactsStub.UploadWithContext not stubbed")
+}
+
+var (
+	_ ArtifactsAPI = (*ArtifactsService)(nil)
+	_ ArtifactsAPI = (*ArtifactsStub)(nil)
+)
+
+// BuildAPI is the interface implemented by the BuildService.
+// The field of the Client can be set to a fake, like a BuildStub, in tests.
+type BuildAPI interface {
+	// GetInfo retrieves the provided build.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-BuildInfo
+	GetInfo(name string, number string) (*Build, *Response, error)
+
+	// GetInfoWithContext retrieves the provided build using the provided context.
+	GetInfoWithContext(ctx context.Context, name string, number string) (*Build, *Response, error)
+}
+
+// BuildStub is an implementation of the BuildAPI for tests.
+// Every method calls the field of the same name with a Func suffix.
+// When it is nil, the method calls the field of its context variant,
+// or of the method it is the context variant of, and panics if that
+// one is nil too.
+type BuildStub struct {
+	GetInfoFunc            func(name string, number string) (*Build, *Response, error)
+	GetInfoWithContextFunc func(ctx context.Context, name string, number string) (*Build, *Response, error)
+}
+
+// GetInfo calls GetInfoFunc.
+func (stub *BuildStub) GetInfo(name string, number string) (*Build, *Response, error) {
+	if stub.GetInfoFunc != nil {
+		return stub.GetInfoFunc(name, number)
+	}
+	if stub.GetInfoWithContextFunc != nil {
+		return stub.GetInfoWithContextFunc(context.Background(), name, number)
+	}
+	panic("BuildStub.GetInfo not stubbed")
+}
+
+// GetInfoWithContext calls GetInfoWithContextFunc.
+func (stub *BuildStub) GetInfoWithContext(ctx context.Context, name string, number string) (*Build, *Response, error) {
+	if stub.GetInfoWithContextFunc != nil {
+		return stub.GetInfoWithContextFunc(ctx, name, number)
+	}
+	if stub.GetInfoFunc != nil {
+		return stub.GetInfoFunc(name, number)
+	}
+	panic("BuildStub.GetInfoWithContext not stubbed")
+}
+
+var (
+	_ BuildAPI = (*BuildService)(nil)
+	_ BuildAPI = (*BuildStub)(nil)
+)
+
+// DockerAPI is the interface implemented by the DockerService.
+// The field of the Client can be set to a fake, like a DockerStub, in tests.
+type DockerAPI interface {
+	// GetRepositories returns a list of all Docker repositories for the provided registry.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-ListDockerRepositories
+	GetRepositories(registry string) (*Registry, *Response, error)
+
+	// GetRepositoriesWithContext returns a list of all Docker repositories for the provided registry using the provided context.
+	GetRepositoriesWithContext(ctx context.Context, registry string) (*Registry, *Response, error)
+
+	// GetTags returns a list of all tags for the provided Docker repository.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-ListDockerTags
+	GetTags(registry string, repository string) (*Tags, *Response, error)
+
+	// GetTagsWithContext returns a list of all tags for the provided Docker repository using the provided context.
+	GetTagsWithContext(ctx context.Context, registry string, repository string) (*Tags, *Response, error)
+
+	// PromoteImage promotes the provided Docker image(s) from the provided source repository to the provided destination repository.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-PromoteDockerImage
+	PromoteImage(registry string, promotion *ImagePromotion) (*string, *Response, error)
+
+	// PromoteImageWithContext promotes the provided Docker image(s) from the provided source repository to the provided destination repository using the provided context.
+	PromoteImageWithContext(ctx context.Context, registry string, promotion *ImagePromotion) (*string, *Response, error)
+}
+
+// DockerStub is an implementation of the DockerAPI for tests.
+// Every method calls the field of the same name with a Func suffix.
+// When it is nil, the method calls the field of its context variant,
+// or of the method it is the context variant of, and panics if that
+// one is nil too.
+type DockerStub struct {
+	GetRepositoriesFunc            func(registry string) (*Registry, *Response, error)
+	GetRepositoriesWithContextFunc func(ctx context.Context, registry string) (*Registry, *Response, error)
+	GetTagsFunc                    func(registry string, repository string) (*Tags, *Response, error)
+	GetTagsWithContextFunc         func(ctx context.Context, registry string, repository string) (*Tags, *Response, error)
+	PromoteImageFunc               func(registry string, promotion *ImagePromotion) (*string, *Response, error)
+	PromoteImageWithContextFunc    func(ctx context.Context, registry string, promotion *ImagePromotion) (*string, *Response, error)
+}
+
+// GetRepositories calls GetRepositoriesFunc.
+func (stub *DockerStub) GetRepositories(registry string) (*Registry, *Response, error) {
+	if stub.GetRepositoriesFunc != nil {
+		return stub.GetRepositoriesFunc(registry)
+	}
+	if stub.GetRepositoriesWithContextFunc != nil {
+		return stub.GetRepositoriesWithContextFunc(context.Background(), registry)
+	}
+	panic("DockerStub.GetRepositories not stubbed")
+}
+
+// GetRepositoriesWithContext calls GetRepositoriesWithContextFunc.
+func (stub *DockerStub) GetRepositoriesWithContext(ctx context.Context, registry string) (*Registry, *Response, error) {
+	if stub.GetRepositoriesWithContextFunc != nil {
+		return stub.GetRepositoriesWithContextFunc(ctx, registry)
+	}
+	if stub.GetRepositoriesFunc != nil {
+		return stub.GetRepositoriesFunc(registry)
+	}
+	panic("DockerStub.GetRepositoriesWithContext not stubbed")
+}
+
+// GetTags calls GetTagsFunc.
+func (stub *DockerStub) GetTags(registry string, repository string) (*Tags, *Response, error) {
+	if stub.GetTagsFunc != nil {
+		return stub.GetTagsFunc(registry, repository)
+	}
+	if stub.GetTagsWithContextFunc != nil {
+		return stub.GetTagsWithContextFunc(context.Background(), registry, repository)
+	}
+	panic("DockerStub.GetTags not stubbed")
+}
+
+// GetTagsWithContext calls GetTagsWithContextFunc.
+func (stub *DockerStub) GetTagsWithContext(ctx context.Context, registry string, repository string) (*Tags, *Response, error) {
+	if stub.GetTagsWithContextFunc != nil {
+		return stub.GetTagsWithContextFunc(ctx, registry, repository)
+	}
+	if stub.GetTagsFunc != nil {
+		return stub.GetTagsFunc(registry, repository)
+	}
+	panic("DockerStub.GetTagsWithContext not stubbed")
+}
+
+// PromoteImage calls PromoteImageFunc.
+func (stub *DockerStub) PromoteImage(registry string, promotion *ImagePromotion) (*string, *Response, error) {
+	if stub.PromoteImageFunc != nil {
+		return stub.PromoteImageFunc(registry, promotion)
+	}
+	if stub.PromoteImageWithContextFunc != nil {
+		return stub.PromoteImageWithContextFunc(context.Background(), registry, promotion)
+	}
+	panic("DockerStub.PromoteImage not stubbed")
+}
+
+// PromoteImageWithContext calls PromoteImageWithContextFunc.
+func (stub *DockerStub) PromoteImageWithContext(ctx context.Context, registry string, promotion *ImagePromotion) (*string, *Response, error) {
+	if stub.PromoteImageWithContextFunc != nil {
+		return stub.PromoteImageWithContextFunc(ctx, registry, promotion)
+	}
+	if stub.PromoteImageFunc != nil {
+		return stub.PromoteImageFunc(registry, promotion)
+	}
+	panic("DockerStub.PromoteImageWithContext not stubbed")
+}
+
+var (
+	_ DockerAPI = (*DockerService)(nil)
+	_ DockerAPI = (*DockerStub)(nil)
+)
+
+// GroupsAPI is the interface implemented by the GroupsService.
+// The field of the Client can be set to a fake, like a GroupsStub, in tests.
+type GroupsAPI interface {
+	// Create constructs a group with the provided details.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-CreateorReplaceGroup
+	Create(group *Group) (*string, *Response, error)
+
+	// CreateWithContext constructs a group with the provided details using the provided context.
+	CreateWithContext(ctx context.Context, group *Group) (*string, *Response, error)
+
+	// Delete removes the provided group.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-DeleteGroup
+	Delete(group string) (*string, *Response, error)
+
+	// DeleteWithContext removes the provided group using the provided context.
+	DeleteWithContext(ctx context.Context, group string) (*string, *Response, error)
+
+	// Get returns the provided group.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-GetGroupDetails
+	Get(groupRequest *GetGroupRequest) (*Group, *Response, error)
+
+	// GetAll returns a list of all groups.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-GetGroups
+	GetAll() (*[]Group, *Response, error)
+
+	// GetAllWithContext returns a list of all groups using the provided context.
+	GetAllWithContext(ctx context.Context) (*[]Group, *Response, error)
+
+	// GetWithContext returns the provided group using the provided context.
+	GetWithContext(ctx context.Context, groupRequest *GetGroupRequest) (*Group, *Response, error)
+
+	// Update modifies a group with the provided details.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-UpdateGroup
+	Update(group *Group) (*string, *Response, error)
+
+	// UpdateWithContext modifies a group with the provided details using the provided context.
+	UpdateWithContext(ctx context.Context, group *Group) (*string, *Response, error)
+}
+
+// GroupsStub is an implementation of the GroupsAPI for tests.
+// Every method calls the field of the same name with a Func suffix.
+// When it is nil, the method calls the field of its context variant,
+// or of the method it is the context variant of, and panics if that
+// one is nil too.
+type GroupsStub struct {
+	CreateFunc            func(group *Group) (*string, *Response, error)
+	CreateWithContextFunc func(ctx context.Context, group *Group) (*string, *Response, error)
+	DeleteFunc            func(group string) (*string, *Response, error)
+	DeleteWithContextFunc func(ctx context.Context, group string) (*string, *Response, error)
+	GetFunc               func(groupRequest *GetGroupRequest) (*Group, *Response, error)
+	GetAllFunc            func() (*[]Group, *Response, error)
+	GetAllWithContextFunc func(ctx context.Context) (*[]Group, *Response, error)
+	GetWithContextFunc    func(ctx context.Context, groupRequest *GetGroupRequest) (*Group, *Response, error)
+	UpdateFunc            func(group *Group) (*string, *Response, error)
+	UpdateWithContextFunc func(ctx context.Context, group *Group) (*string, *Response, error)
+}
+
+// Create calls CreateFunc.
+func (stub *GroupsStub) Create(group *Group) (*string, *Response, error) {
+	if stub.CreateFunc != nil {
+		return stub.CreateFunc(group)
+	}
+	if stub.CreateWithContextFunc != nil {
+		return stub.CreateWithContextFunc(context.Background(), group)
+	}
+	panic("GroupsStub.Create not stubbed")
+}
+
+// CreateWithContext calls CreateWithContextFunc.
+func (stub *GroupsStub) CreateWithContext(ctx context.Context, group *Group) (*string, *Response, error) {
+	if stub.CreateWithContextFunc != nil {
+		return stub.CreateWithContextFunc(ctx, group)
+	}
+	if stub.CreateFunc != nil {
+		return stub.CreateFunc(group)
+	}
+	panic("GroupsStub.CreateWithContext not stubbed")
+}
+
+// Delete calls DeleteFunc.
+func (stub *GroupsStub) Delete(group string) (*string, *Response, error) {
+	if stub.DeleteFunc != nil {
+		return stub.DeleteFunc(group)
+	}
+	if stub.DeleteWithContextFunc != nil {
+		return stub.DeleteWithContextFunc(context.Background(), group)
+	}
+	panic("GroupsStub.Delete not stubbed")
+}
+
+// DeleteWithContext calls DeleteWithContextFunc.
+func (stub *GroupsStub) DeleteWithContext(ctx context.Context, group string) (*string, *Response, error) {
+	if stub.DeleteWithContextFunc != nil {
+		return stub.DeleteWithContextFunc(ctx, group)
+	}
+	if stub.DeleteFunc != nil {
+		return stub.DeleteFunc(group)
+	}
+	panic("GroupsStub.DeleteWithContext not stubbed")
+}
+
+// Get calls GetFunc.
+func (stub *GroupsStub) Get(groupRequest *GetGroupRequest) (*Group, *Response, error) {
+	if stub.GetFunc != nil {
+		return stub.GetFunc(groupRequest)
+	}
+	if stub.GetWithContextFunc != nil {
+		return stub.GetWithContextFunc(context.Background(), groupRequest)
+	}
+	panic("GroupsStub.Get not stubbed")
+}
+
+// GetAll calls GetAllFunc.
+func (stub *GroupsStub) GetAll() (*[]Group, *Response, error) {
+	if stub.GetAllFunc != nil {
+		return stub.GetAllFunc()
+	}
+	if stub.GetAllWithContextFunc != nil {
+		return stub.GetAllWithContextFunc(context.Background())
+	}
+	panic("GroupsStub.GetAll not stubbed")
+}
+
+// GetAllWithContext calls GetAllWithContextFunc.
+func (stub *GroupsStub) GetAllWithContext(ctx context.Context) (*[]Group, *Response, error) {
+	if stub.GetAllWithContextFunc != nil {
+		return stub.GetAllWithContextFunc(ctx)
+	}
+	if stub.GetAllFunc != nil {
+		return stub.GetAllFunc()
+	}
+	panic("GroupsStub.GetAllWithContext not stubbed")
+}
+
+// GetWithContext calls GetWithContextFunc.
+func (stub *GroupsStub) GetWithContext(ctx context.Context, groupRequest *GetGroupRequest) (*Group, *Response, error) {
+	if stub.GetWithContextFunc != nil {
+		return stub.GetWithContextFunc(ctx, groupRequest)
+	}
+	if stub.GetFunc != nil {
+		return stub.GetFunc(groupRequest)
+	}
+	panic("GroupsStub.GetWithContext not stubbed")
+}
+
+// Update calls UpdateFunc.
+func (stub *GroupsStub) Update(group *Group) (*string, *Response, error) {
+	if stub.UpdateFunc != nil {
+		return stub.UpdateFunc(group)
+	}
+	if stub.UpdateWithContextFunc != nil {
+		return stub.UpdateWithContextFunc(context.Background(), group)
+	}
+	panic("GroupsStub.Update not stubbed")
+}
+
+// UpdateWithContext calls UpdateWithContextFunc.
+func (stub *GroupsStub) UpdateWithContext(ctx context.Context, group *Group) (*string, *Response, error) {
+	if stub.UpdateWithContextFunc != nil {
+		return stub.UpdateWithContextFunc(ctx, group)
+	}
+	if stub.UpdateFunc != nil {
+		return stub.UpdateFunc(group)
+	}
+	panic("GroupsStub.UpdateWithContext not stubbed")
+}
+
+var (
+	_ GroupsAPI = (*GroupsService)(nil)
+	_ GroupsAPI = (*GroupsStub)(nil)
+)
+
+// LicensesAPI is the interface implemented by the LicensesService.
+// The field of the Client can be set to a fake, like a LicensesStub, in tests.
+type LicensesAPI interface {
+	// DeleteHA removes the provided license key(s) from an HA cluster.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-DeleteHAClusterLicense
+	DeleteHA(hashes *LicenseRemoval) (*HALicenseResponse, *Response, error)
+
+	// DeleteHAWithContext removes the provided license key(s) from an HA cluster using the provided context.
+	DeleteHAWithContext(ctx context.Context, hashes *LicenseRemoval) (*HALicenseResponse, *Response, error)
+
+	// Get returns a single license.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-LicenseInformation
+	Get() (*License, *Response, error)
+
+	// GetHA returns a list of licenses for an HA cluster.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-HALicenseInformation
+	GetHA() (*HALicenses, *Response, error)
+
+	// GetHAWithContext returns a list of licenses for an HA cluster using the provided context.
+	GetHAWithContext(ctx context.Context) (*HALicenses, *Response, error)
+
+	// GetWithContext returns a single license using the provided context.
+	GetWithContext(ctx context.Context) (*License, *Response, error)
+
+	// Install deploys the provided license to the instance.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-InstallLicense
+	Install(license *LicenseRequest) (*LicenseResponse, *Response, error)
+
+	// InstallHA deploys the provided license(s) to an HA cluster.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-InstallHAClusterLicenses
+	InstallHA(licenses *[]LicenseRequest) (*HALicenseResponse, *Response, error)
+
+	// InstallHAWithContext deploys the provided license(s) to an HA cluster using the provided context.
+	InstallHAWithContext(ctx context.Context, licenses *[]LicenseRequest) (*HALicenseResponse, *Response, error)
+
+	// InstallWithContext deploys the provided license to the instance using the provided context.
+	InstallWithContext(ctx context.Context, license *LicenseRequest) (*LicenseResponse, *Response, error)
+}
+
+// LicensesStub is an implementation of the LicensesAPI for tests.
+// Every method calls the field of the same name with a Func suffix.
+// When it is nil, the method calls the field of its context variant,
+// or of the method it is the context variant of, and panics if that
+// one is nil too.
+type LicensesStub struct {
+	DeleteHAFunc             func(hashes *LicenseRemoval) (*HALicenseResponse, *Response, error)
+	DeleteHAWithContextFunc  func(ctx context.Context, hashes *LicenseRemoval) (*HALicenseResponse, *Response, error)
+	GetFunc                  func() (*License, *Response, error)
+	GetHAFunc                func() (*HALicenses, *Response, error)
+	GetHAWithContextFunc     func(ctx context.Context) (*HALicenses, *Response, error)
+	GetWithContextFunc       func(ctx context.Context) (*License, *Response, error)
+	InstallFunc              func(license *LicenseRequest) (*LicenseResponse, *Response, error)
+	InstallHAFunc            func(licenses *[]LicenseRequest) (*HALicenseResponse, *Response, error)
+	InstallHAWithContextFunc func(ctx context.Context, licenses *[]LicenseRequest) (*HALicenseResponse, *Response, error)
+	InstallWithContextFunc   func(ctx context.Context, license *LicenseRequest) (*LicenseResponse, *Response, error)
+}
+
+// DeleteHA calls DeleteHAFunc.
+func (stub *LicensesStub) DeleteHA(hashes *LicenseRemoval) (*HALicenseResponse, *Response, error) {
+	if stub.DeleteHAFunc != nil {
+		return stub.DeleteHAFunc(hashes)
+	}
+	if stub.DeleteHAWithContextFunc != nil {
+		return stub.DeleteHAWithContextFunc(context.Background(), hashes)
+	}
+	panic("LicensesStub.DeleteHA not stubbed")
+}
+
+// DeleteHAWithContext calls DeleteHAWithContextFunc.
+func (stub *LicensesStub) DeleteHAWithContext(ctx context.Context, hashes *LicenseRemoval) (*HALicenseResponse, *Response, error) {
+	if stub.DeleteHAWithContextFunc != nil {
+		return stub.DeleteHAWithContextFunc(ctx, hashes)
+	}
+	if stub.DeleteHAFunc != nil {
+		return stub.DeleteHAFunc(hashes)
+	}
+	panic("LicensesStub.DeleteHAWithContext not stubbed")
+}
+
+// Get calls GetFunc.
+func (stub *LicensesStub) Get() (*License, *Response, error) {
+	if stub.GetFunc != nil {
+		return stub.GetFunc()
+	}
+	if stub.GetWithContextFunc != nil {
+		return stub.GetWithContextFunc(context.Background())
+	}
+	panic("LicensesStub.Get not stubbed")
+}
+
+// GetHA calls GetHAFunc.
+func (stub *LicensesStub) GetHA() (*HALicenses, *Response, error) {
+	if stub.GetHAFunc != nil {
+		return stub.GetHAFunc()
+	}
+	if stub.GetHAWithContextFunc != nil {
+		return stub.GetHAWithContextFunc(context.Background())
+	}
+	panic("LicensesStub.GetHA not stubbed")
+}
+
+// GetHAWithContext calls GetHAWithContextFunc.
+func (stub *LicensesStub) GetHAWithContext(ctx context.Context) (*HALicenses, *Response, error) {
+	if stub.GetHAWithContextFunc != nil {
+		return stub.GetHAWithContextFunc(ctx)
+	}
+	if stub.GetHAFunc != nil {
+		return stub.GetHAFunc()
+	}
+	panic("LicensesStub.GetHAWithContext not stubbed")
+}
+
+// GetWithContext calls GetWithContextFunc.
+func (stub *LicensesStub) GetWithContext(ctx context.Context) (*License, *Response, error) {
+	if stub.GetWithContextFunc != nil {
+		return stub.GetWithContextFunc(ctx)
+	}
+	if stub.GetFunc != nil {
+		return stub.GetFunc()
+	}
+	panic("LicensesStub.GetWithContext not stubbed")
+}
+
+// Install calls InstallFunc.
+func (stub *LicensesStub) Install(license *LicenseRequest) (*LicenseResponse, *Response, error) {
+	if stub.InstallFunc != nil {
+		return stub.InstallFunc(license)
+	}
+	if stub.InstallWithContextFunc != nil {
+		return stub.InstallWithContextFunc(context.Background(), license)
+	}
+	panic("LicensesStub.Install not stubbed")
+}
+
+// InstallHA calls InstallHAFunc.
+func (stub *LicensesStub) InstallHA(licenses *[]LicenseRequest) (*HALicenseResponse, *Response, error) {
+	if stub.InstallHAFunc != nil {
+		return stub.InstallHAFunc(licenses)
+	}
+	if stub.InstallHAWithContextFunc != nil {
+		return stub.InstallHAWithContextFunc(context.Background(), licenses)
+	}
+	panic("LicensesStub.InstallHA not stubbed")
+}
+
+// InstallHAWithContext calls InstallHAWithContextFunc.
+func (stub *LicensesStub) InstallHAWithContext(ctx context.Context, licenses *[]LicenseRequest) (*HALicenseResponse, *Response, error) {
+	if stub.InstallHAWithContextFunc != nil {
+		return stub.InstallHAWithContextFunc(ctx, licenses)
+	}
+	if stub.InstallHAFunc != nil {
+		return stub.InstallHAFunc(licenses)
+	}
+	panic("LicensesStub.InstallHAWithContext not stubbed")
+}
+
+// InstallWithContext calls InstallWithContextFunc.
+func (stub *LicensesStub) InstallWithContext(ctx context.Context, license *LicenseRequest) (*LicenseResponse, *Response, error) {
+	if stub.InstallWithContextFunc != nil {
+		return stub.InstallWithContextFunc(ctx, license)
+	}
+	if stub.InstallFunc != nil {
+		return stub.InstallFunc(license)
+	}
+	panic("LicensesStub.InstallWithContext not stubbed")
+}
+
+var (
+	_ LicensesAPI = (*LicensesService)(nil)
+	_ LicensesAPI = (*LicensesStub)(nil)
+)
+
+// PermissionsAPI is the interface implemented by the PermissionsService.
+// The field of the Client can be set to a fake, like a PermissionsStub, in tests.
+type PermissionsAPI interface {
+	// Create constructs a permission target with the provided details.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-CreateorReplacePermissionTarget
+	Create(target *PermissionTarget) (*string, *Response, error)
+
+	// CreateWithContext constructs a permission target with the provided details using the provided context.
+	CreateWithContext(ctx context.Context, target *PermissionTarget) (*string, *Response, error)
+
+	// Delete removes the provided permission target.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-DeletePermissionTarget
+	Delete(target string) (*string, *Response, error)
+
+	// DeleteWithContext removes the provided permission target using the provided context.
+	DeleteWithContext(ctx context.Context, target string) (*string, *Response, error)
+
+	// Get returns the provided permission target.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-GetPermissionTargetDetails
+	Get(target string) (*PermissionTarget, *Response, error)
+
+	// GetAll returns a list of all permission targets.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-GetPermissionTargets
+	GetAll() (*[]PermissionTarget, *Response, error)
+
+	// GetAllWithContext returns a list of all permission targets using the provided context.
+	GetAllWithContext(ctx context.Context) (*[]PermissionTarget, *Response, error)
+
+	// GetWithContext returns the provided permission target using the provided context.
+	GetWithContext(ctx context.Context, target string) (*PermissionTarget, *Response, error)
+
+	// Update modifies a permission target with the provided details.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-CreateorReplacePermissionTarget
+	Update(target *PermissionTarget) (*string, *Response, error)
+
+	// UpdateWithContext modifies a permission target with the provided details using the provided context.
+	UpdateWithContext(ctx context.Context, target *PermissionTarget) (*string, *Response, error)
+}
+
+// PermissionsStub is an implementation of the PermissionsAPI for tests.
+// Every method calls the field of the same name with a Func suffix.
+// When it is nil, the method calls the field of its context variant,
+// or of the method it is the context variant of, and panics if that
+// one is nil too.
+type PermissionsStub struct {
+	CreateFunc            func(target *PermissionTarget) (*string, *Response, error)
+	CreateWithContextFunc func(ctx context.Context, target *PermissionTarget) (*string, *Response, error)
+	DeleteFunc            func(target string) (*string, *Response, error)
+	DeleteWithContextFunc func(ctx context.Context, target string) (*string, *Response, error)
+	GetFunc               func(target string) (*PermissionTarget, *Response, error)
+	GetAllFunc            func() (*[]PermissionTarget, *Response, error)
+	GetAllWithContextFunc func(ctx context.Context) (*[]PermissionTarget, *Response, error)
+	GetWithContextFunc    func(ctx context.Context, target string) (*PermissionTarget, *Response, error)
+	UpdateFunc            func(target *PermissionTarget) (*string, *Response, error)
+	UpdateWithContextFunc func(ctx context.Context, target *PermissionTarget) (*string, *Response, error)
+}
+
+// Create calls CreateFunc.
+func (stub *PermissionsStub) Create(target *PermissionTarget) (*string, *Response, error) {
+	if stub.CreateFunc != nil {
+		return stub.CreateFunc(target)
+	}
+	if stub.CreateWithContextFunc != nil {
+		return stub.CreateWithContextFunc(context.Background(), target)
+	}
+	panic("PermissionsStub.Create not stubbed")
+}
+
+// CreateWithContext calls CreateWithContextFunc.
+func (stub *PermissionsStub) CreateWithContext(ctx context.Context, target *PermissionTarget) (*string, *Response, error) {
+	if stub.CreateWithContextFunc != nil {
+		return stub.CreateWithContextFunc(ctx, target)
+	}
+	if stub.CreateFunc != nil {
+		return stub.CreateFunc(target)
+	}
+	panic("PermissionsStub.CreateWithContext not stubbed")
+}
+
+// Delete calls DeleteFunc.
+func (stub *PermissionsStub) Delete(target string) (*string, *Response, error) {
+	if stub.DeleteFunc != nil {
+		return stub.DeleteFunc(target)
+	}
+	if stub.DeleteWithContextFunc != nil {
+		return stub.DeleteWithContextFunc(context.Background(), target)
+	}
+	panic("PermissionsStub.Delete not stubbed")
+}
+
+// DeleteWithContext calls DeleteWithContextFunc.
+func (stub *PermissionsStub) DeleteWithContext(ctx context.Context, target string) (*string, *Response, error) {
+	if stub.DeleteWithContextFunc != nil {
+		return stub.DeleteWithContextFunc(ctx, target)
+	}
+	if stub.DeleteFunc != nil {
+		return stub.DeleteFunc(target)
+	}
+	panic("PermissionsStub.DeleteWithContext not stubbed")
+}
+
+// Get calls GetFunc.
+func (stub *PermissionsStub) Get(target string) (*PermissionTarget, *Response, error) {
+	if stub.GetFunc != nil {
+		return stub.GetFunc(target)
+	}
+	if stub.GetWithContextFunc != nil {
+		return stub.GetWithContextFunc(context.Background(), target)
+	}
+	panic("PermissionsStub.Get not stubbed")
+}
+
+// GetAll calls GetAllFunc.
+func (stub *PermissionsStub) GetAll() (*[]PermissionTarget, *Response, error) {
+	if stub.GetAllFunc != nil {
+		return stub.GetAllFunc()
+	}
+	if stub.GetAllWithContextFunc != nil {
+		return stub.GetAllWithContextFunc(context.Background())
+	}
+	panic("PermissionsStub.GetAll not stubbed")
+}
+
+// GetAllWithContext calls GetAllWithContextFunc.
+func (stub *PermissionsStub) GetAllWithContext(ctx context.Context) (*[]PermissionTarget, *Response, error) {
+	if stub.GetAllWithContextFunc != nil {
+		return stub.GetAllWithContextFunc(ctx)
+	}
+	if stub.GetAllFunc != nil {
+		return stub.GetAllFunc()
+	}
+	panic("PermissionsStub.GetAllWithContext not stubbed")
+}
+
+// GetWithContext calls GetWithContextFunc.
+func (stub *PermissionsStub) GetWithContext(ctx context.Context, target string) (*PermissionTarget, *Response, error) {
+	if stub.GetWithContextFunc != nil {
+		return stub.GetWithContextFunc(ctx, target)
+	}
+	if stub.GetFunc != nil {
+		return stub.GetFunc(target)
+	}
+	panic("PermissionsStub.GetWithContext not stubbed")
+}
+
+// Update calls UpdateFunc.
+func (stub *PermissionsStub) Update(target *PermissionTarget) (*string, *Response, error) {
+	if stub.UpdateFunc != nil {
+		return stub.UpdateFunc(target)
+	}
+	if stub.UpdateWithContextFunc != nil {
+		return stub.UpdateWithContextFunc(context.Background(), target)
+	}
+	panic("PermissionsStub.Update not stubbed")
+}
+
+// UpdateWithContext calls UpdateWithContextFunc.
+func (stub *PermissionsStub) UpdateWithContext(ctx context.Context, target *PermissionTarget) (*string, *Response, error) {
+	if stub.UpdateWithContextFunc != nil {
+		return stub.UpdateWithContextFunc(ctx, target)
+	}
+	if stub.UpdateFunc != nil {
+		return stub.UpdateFunc(target)
+	}
+	panic("PermissionsStub.UpdateWithContext not stubbed")
+}
+
+var (
+	_ PermissionsAPI = (*PermissionsService)(nil)
+	_ PermissionsAPI = (*PermissionsStub)(nil)
+)
+
+// PermissionsV2API is the interface implemented by the PermissionsServiceV2.
+// The field of the Client can be set to a fake, like a PermissionsV2Stub, in tests.
+type PermissionsV2API interface {
+	// Exists validates if the specific permission target exists.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API+V2#ArtifactoryRESTAPIV2-PermissionTargetexistencecheck
+	Exists(target string) (bool, error)
+
+	// ExistsWithContext validates if the specific permission target exists using the provided context.
+	ExistsWithContext(ctx context.Context, target string) (bool, error)
+
+	// Get returns the provided permission target.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/JFROG/Artifactory+REST+API+V2#ArtifactoryRESTAPIV2-GetPermissionTargetDetails
+	Get(target string) (*PermissionTargetV2, *Response, error)
+
+	// GetWithContext returns the provided permission target using the provided context.
+	GetWithContext(ctx context.Context, target string) (*PermissionTargetV2, *Response, error)
+
+	// Update creates a new permission target or replaces an existing permission target.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/JFROG/Artifactory+REST+API+V2#ArtifactoryRESTAPIV2-UpdatePermissionTarget
+	Update(target *PermissionTargetV2) (*string, *Response, error)
+
+	// UpdateWithContext creates a new permission target or replaces an existing permission target using the provided context.
+	UpdateWithContext(ctx context.Context, target *PermissionTargetV2) (*string, *Response, error)
+}
+
+// PermissionsV2Stub is an implementation of the PermissionsV2API for tests.
+// Every method calls the field of the same name with a Func suffix.
+// When it is nil, the method calls the field of its context variant,
+// or of the method it is the context variant of, and panics if that
+// one is nil too.
+type PermissionsV2Stub struct {
+	ExistsFunc            func(target string) (bool, error)
+	ExistsWithContextFunc func(ctx context.Context, target string) (bool, error)
+	GetFunc               func(target string) (*PermissionTargetV2, *Response, error)
+	GetWithContextFunc    func(ctx context.Context, target string) (*PermissionTargetV2, *Response, error)
+	UpdateFunc            func(target *PermissionTargetV2) (*string, *Response, error)
+	UpdateWithContextFunc func(ctx context.Context, target *PermissionTargetV2) (*string, *Response, error)
+}
+
+// Exists calls ExistsFunc.
+func (stub *PermissionsV2Stub) Exists(target string) (bool, error) {
+	if stub.ExistsFunc != nil {
+		return stub.ExistsFunc(target)
+	}
+	if stub.ExistsWithContextFunc != nil {
+		return stub.ExistsWithContextFunc(context.Background(), target)
+	}
+	panic("PermissionsV2Stub.Exists not stubbed")
+}
+
+// ExistsWithContext calls ExistsWithContextFunc.
+func (stub *PermissionsV2Stub) ExistsWithContext(ctx context.Context, target string) (bool, error) {
+	if stub.ExistsWithContextFunc != nil {
+		return stub.ExistsWithContextFunc(ctx, target)
+	}
+	if stub.ExistsFunc != nil {
+		return stub.ExistsFunc(target)
+	}
+	panic("PermissionsV2Stub.ExistsWithContext not stubbed")
+}
+
+// Get calls GetFunc.
+func (stub *PermissionsV2Stub) Get(target string) (*PermissionTargetV2, *Response, error) {
+	if stub.GetFunc != nil {
+		return stub.GetFunc(target)
+	}
+	if stub.GetWithContextFunc != nil {
+		return stub.GetWithContextFunc(context.Background(), target)
+	}
+	panic("PermissionsV2Stub.Get not stubbed")
+}
+
+// GetWithContext calls GetWithContextFunc.
+func (stub *PermissionsV2Stub) GetWithContext(ctx context.Context, target string) (*PermissionTargetV2, *Response, error) {
+	if stub.GetWithContextFunc != nil {
+		return stub.GetWithContextFunc(ctx, target)
+	}
+	if stub.GetFunc != nil {
+		return stub.GetFunc(target)
+	}
+	panic("PermissionsV2Stub.GetWithContext not stubbed")
+}
+
+// Update calls UpdateFunc.
+func (stub *PermissionsV2Stub) Update(target *PermissionTargetV2) (*string, *Response, error) {
+	if stub.UpdateFunc != nil {
+		return stub.UpdateFunc(target)
+	}
+	if stub.UpdateWithContextFunc != nil {
+		return stub.UpdateWithContextFunc(context.Background(), target)
+	}
+	panic("PermissionsV2Stub.Update not stubbed")
+}
+
+// UpdateWithContext calls UpdateWithContextFunc.
+func (stub *PermissionsV2Stub) UpdateWithContext(ctx context.Context, target *PermissionTargetV2) (*string, *Response, error) {
+	if stub.UpdateWithContextFunc != nil {
+		return stub.UpdateWithContextFunc(ctx, target)
+	}
+	if stub.UpdateFunc != nil {
+		return stub.UpdateFunc(target)
+	}
+	panic("PermissionsV2Stub.UpdateWithContext not stubbed")
+}
+
+var (
+	_ PermissionsV2API = (*PermissionsServiceV2)(nil)
+	_ PermissionsV2API = (*PermissionsV2Stub)(nil)
+)
+
+// ReplicationsAPI is the interface implemented by the ReplicationsService.
+// The field of the Client can be set to a fake, like a ReplicationsStub, in tests.
+type ReplicationsAPI interface {
+	// Create constructs a single replication for the provided repository.
+	// If multiple push replications are required CreateMultiPush needs to be used
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-CreateRepository
+	Create(repo string, replication *Replication) (*string, *Response, error)
+
+	// CreateMultiPush constructs a Local Multi-push replication for the provided repository.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-CreateorReplaceLocalMulti-pushReplication
+	CreateMultiPush(repo string, replications *MultiPushReplication) (*string, *Response, error)
+
+	// CreateMultiPushWithContext constructs a Local Multi-push replication for the provided repository using the provided context.
+	CreateMultiPushWithContext(ctx context.Context, repo string, replications *MultiPushReplication) (*string, *Response, error)
+
+	// CreateWithContext constructs a single replication for the provided repository using the provided context.
+	CreateWithContext(ctx context.Context, repo string, replication *Replication) (*string, *Response, error)
+
+	// Delete deletes the existing replication configuration for the provided repository.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-DeleteRepositoryReplicationConfiguration
+	Delete(repo string) (*string, *Response, error)
+
+	// DeleteMultiPush deletes replication configuration at the provided URL for the provided repository.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-DeleteRepositoryReplicationConfiguration
+	DeleteMultiPush(repo string, replicationURL string) (*string, *Response, error)
+
+	// DeleteMultiPushWithContext deletes replication configuration at the provided URL for the provided repository using the provided context.
+	DeleteMultiPushWithContext(ctx context.Context, repo string, replicationURL string) (*string, *Response, error)
+
+	// DeleteWithContext deletes the existing replication configuration for the provided repository using the provided context.
+	DeleteWithContext(ctx context.Context, repo string) (*string, *Response, error)
+
+	// Get returns replications for the provided repository.
+	// Artifactory returns a JSON array for a local replication or a JSON object for a remote replication.
+	// This method returns a slice to maintain consistency.
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-GetRepositoryReplicationConfiguration
+	Get(repo string) (*[]Replication, *Response, error)
+
+	// GetAll returns a list of all replications.
+	//
+	// Docs: This endpoint is currently undocumented by JFrog
+	GetAll() (*[]Replications, *Response, error)
+
+	// GetAllWithContext returns a list of all replications using the provided context.
+	GetAllWithContext(ctx context.Context) (*[]Replications, *Response, error)
+
+	// GetWithContext returns replications for the provided repository using the provided context.
+	GetWithContext(ctx context.Context, repo string) (*[]Replication, *Response, error)
+
+	// Update updates a single replication for the provided repository. If updates are required
+	// for a local repository with multiple push replications UpdateMultiPush needs to be used
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-UpdateRepositoryReplicationConfiguration
+	Update(repo string, replication *Replication) (*string, *Response, error)
+
+	// UpdateMultiPush updates a Local Multi-push replication for the provided repository
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-UpdateLocalMulti-pushReplication
+	UpdateMultiPush(repo string, replications *MultiPushReplication) (*string, *Response, error)
+
+	// UpdateMultiPushWithContext updates a Local Multi-push replication for the provided repository using the provided context.
+	UpdateMultiPushWithContext(ctx context.Context, repo string, replications *MultiPushReplication) (*string, *Response, error)
+
+	// UpdateWithContext updates a single replication for the provided repository using the provided context.
+	UpdateWithContext(ctx context.Context, repo string, replication *Replication) (*string, *Response, error)
+}
+
+// ReplicationsStub is an implementation of the ReplicationsAPI for tests.
+// Every method calls the field of the same name with a Func suffix.
+// When it is nil, the method calls the field of its context variant,
+// or of the method it is the context variant of, and panics if that
+// one is nil too.
+type ReplicationsStub struct {
+	CreateFunc                     func(repo string, replication *Replication) (*string, *Response, error)
+	CreateMultiPushFunc            func(repo string, replications *MultiPushReplication) (*string, *Response, error)
+	CreateMultiPushWithContextFunc func(ctx context.Context, repo string, replications *MultiPushReplication) (*string, *Response, error)
+	CreateWithContextFunc          func(ctx context.Context, repo string, replication *Replication) (*string, *Response, error)
+	DeleteFunc                     func(repo string) (*string, *Response, error)
+	DeleteMultiPushFunc            func(repo string, replicationURL string) (*string, *Response, error)
+	DeleteMultiPushWithContextFunc func(ctx context.Context, repo string, replicationURL string) (*string, *Response, error)
+	DeleteWithContextFunc          func(ctx context.Context, repo string) (*string, *Response, error)
+	GetFunc                        func(repo string) (*[]Replication, *Response, error)
+	GetAllFunc                     func() (*[]Replications, *Response, error)
+	GetAllWithContextFunc          func(ctx context.Context) (*[]Replications, *Response, error)
+	GetWithContextFunc             func(ctx context.Context, repo string) (*[]Replication, *Response, error)
+	UpdateFunc                     func(repo string, replication *Replication) (*string, *Response, error)
+	UpdateMultiPushFunc            func(repo string, replications *MultiPushReplication) (*string, *Response, error)
+	UpdateMultiPushWithContextFunc func(ctx context.Context, repo string, replications *MultiPushReplication) (*string, *Response, error)
+	UpdateWithContextFunc          func(ctx context.Context, repo string, replication *Replication) (*string, *Response, error)
+}
+
+// Create calls CreateFunc.
+func (stub *ReplicationsStub) Create(repo string, replication *Replication) (*string, *Response, error) {
+	if stub.CreateFunc != nil {
+		return stub.CreateFunc(repo, replication)
+	}
+	if stub.CreateWithContextFunc != nil {
+		return stub.CreateWithContextFunc(context.Background(), repo, replication)
+	}
+	panic("ReplicationsStub.Create not stubbed")
+}
+
+// CreateMultiPush calls CreateMultiPushFunc.
+func (stub *ReplicationsStub) CreateMultiPush(repo string, replications *MultiPushReplication) (*string, *Response, error) {
+	if stub.CreateMultiPushFunc != nil {
+		return stub.CreateMultiPushFunc(repo, replications)
+	}
+	if stub.CreateMultiPushWithContextFunc != nil {
+		return stub.CreateMultiPushWithContextFunc(context.Background(), repo, replications)
+	}
+	panic("ReplicationsStub.CreateMultiPush not stubbed")
+}
+
+// CreateMultiPushWithContext calls CreateMultiPushWithContextFunc.
+func (stub *ReplicationsStub) CreateMultiPushWithContext(ctx context.Context, repo string, replications *MultiPushReplication) (*string, *Response, error) {
+	if stub.CreateMultiPushWithContextFunc != nil {
+		return stub.CreateMultiPushWithContextFunc(ctx, repo, replications)
+	}
+	if stub.CreateMultiPushFunc != nil {
+		return stub.CreateMultiPushFunc(repo, replications)
+	}
+	panic("ReplicationsStub.CreateMultiPushWithContext not stubbed")
+}
+
+// CreateWithContext calls CreateWithContextFunc.
+func (stub *ReplicationsStub) CreateWithContext(ctx context.Context, repo string, replication *Replication) (*string, *Response, error) {
+	if stub.CreateWithContextFunc != nil {
+		return stub.CreateWithContextFunc(ctx, repo, replication)
+	}
+	if stub.CreateFunc != nil {
+		return stub.CreateFunc(repo, replication)
+	}
+	panic("ReplicationsStub.CreateWithContext not stubbed")
+}
+
+// Delete calls DeleteFunc.
+func (stub *ReplicationsStub) Delete(repo string) (*string, *Response, error) {
+	if stub.DeleteFunc != nil {
+		return stub.DeleteFunc(repo)
+	}
+	if stub.DeleteWithContextFunc != nil {
+		return stub.DeleteWithContextFunc(context.Background(), repo)
+	}
+	panic("ReplicationsStub.Delete not stubbed")
+}
+
+// DeleteMultiPush calls DeleteMultiPushFunc.
+func (stub *ReplicationsStub) DeleteMultiPush(repo string, replicationURL string) (*string, *Response, error) {
+	if stub.DeleteMultiPushFunc != nil {
+		return stub.DeleteMultiPushFunc(repo, replicationURL)
+	}
+	if stub.DeleteMultiPushWithContextFunc != nil {
+		return stub.DeleteMultiPushWithContextFunc(context.Background(), repo, replicationURL)
+	}
+	panic("ReplicationsStub.DeleteMultiPush not stubbed")
+}
+
+// DeleteMultiPushWithContext calls DeleteMultiPushWithContextFunc.
+func (stub *ReplicationsStub) DeleteMultiPushWithContext(ctx context.Context, repo string, replicationURL string) (*string, *Response, error) {
+	if stub.DeleteMultiPushWithContextFunc != nil {
+		return stub.DeleteMultiPushWithContextFunc(ctx, repo, replicationURL)
+	}
+	if stub.DeleteMultiPushFunc != nil {
+		return stub.DeleteMultiPushFunc(repo, replicationURL)
+	}
+	panic("ReplicationsStub.DeleteMultiPushWithContext not stubbed")
+}
+
+// DeleteWithContext calls DeleteWithContextFunc.
+func (stub *ReplicationsStub) DeleteWithContext(ctx context.Context, repo string) (*string, *Response, error) {
+	if stub.DeleteWithContextFunc != nil {
+		return stub.DeleteWithContextFunc(ctx, repo)
+	}
+	if stub.DeleteFunc != nil {
+		return stub.DeleteFunc(repo)
+	}
+	panic("ReplicationsStub.DeleteWithContext not stubbed")
+}
+
+// Get calls GetFunc.
+func (stub *ReplicationsStub) Get(repo string) (*[]Replication, *Response, error) {
+	if stub.GetFunc != nil {
+		return stub.GetFunc(repo)
+	}
+	if stub.GetWithContextFunc != nil {
+		return stub.GetWithContextFunc(context.Background(), repo)
+	}
+	panic("ReplicationsStub.Get not stubbed")
+}
+
+// GetAll calls GetAllFunc.
+func (stub *ReplicationsStub) GetAll() (*[]Replications, *Response, error) {
+	if stub.GetAllFunc != nil {
+		return stub.GetAllFunc()
+	}
+	if stub.GetAllWithContextFunc != nil {
+		return stub.GetAllWithContextFunc(context.Background())
+	}
+	panic("ReplicationsStub.GetAll not stubbed")
+}
+
+// GetAllWithContext calls GetAllWithContextFunc.
+func (stub *ReplicationsStub) GetAllWithContext(ctx context.Context) (*[]Replications, *Response, error) {
+	if stub.GetAllWithContextFunc != nil {
+		return stub.GetAllWithContextFunc(ctx)
+	}
+	if stub.GetAllFunc != nil {
+		return stub.GetAllFunc()
+	}
+	panic("ReplicationsStub.GetAllWithContext not stubbed")
+}
+
+// GetWithContext calls GetWithContextFunc.
+func (stub *ReplicationsStub) GetWithContext(ctx context.Context, repo string) (*[]Replication, *Response, error) {
+	if stub.GetWithContextFunc != nil {
+		return stub.GetWithContextFunc(ctx, repo)
+	}
+	if stub.GetFunc != nil {
+		return stub.GetFunc(repo)
+	}
+	panic("ReplicationsStub.GetWithContext not stubbed")
+}
+
+// Update calls UpdateFunc.
+func (stub *ReplicationsStub) Update(repo string, replication *Replication) (*string, *Response, error) {
+	if stub.UpdateFunc != nil {
+		return stub.UpdateFunc(repo, replication)
+	}
+	if stub.UpdateWithContextFunc != nil {
+		return stub.UpdateWithContextFunc(context.Background(), repo, replication)
+	}
+	panic("ReplicationsStub.Update not stubbed")
+}
+
+// UpdateMultiPush calls UpdateMultiPushFunc.
+func (stub *ReplicationsStub) UpdateMultiPush(repo string, replications *MultiPushReplication) (*string, *Response, error) {
+	if stub.UpdateMultiPushFunc != nil {
+		return stub.UpdateMultiPushFunc(repo, replications)
+	}
+	if stub.UpdateMultiPushWithContextFunc != nil {
+		return stub.UpdateMultiPushWithContextFunc(context.Background(), repo, replications)
+	}
+	panic("ReplicationsStub.UpdateMultiPush not stubbed")
+}
+
+// UpdateMultiPushWithContext calls UpdateMultiPushWithContextFunc.
+func (stub *ReplicationsStub) UpdateMultiPushWithContext(ctx context.Context, repo string, replications *MultiPushReplication) (*string, *Response, error) {
+	if stub.UpdateMultiPushWithContextFunc != nil {
+		return stub.UpdateMultiPushWithContextFunc(ctx, repo, replications)
+	}
+	if stub.UpdateMultiPushFunc != nil {
+		return stub.UpdateMultiPushFunc(repo, replications)
+	}
+	panic("ReplicationsStub.UpdateMultiPushWithContext not stubbed")
+}
+
+// UpdateWithContext calls UpdateWithContextFunc.
+func (stub *ReplicationsStub) UpdateWithContext(ctx context.Context, repo string, replication *Replication) (*string, *Response, error) {
+	if stub.UpdateWithContextFunc != nil {
+		return stub.UpdateWithContextFunc(ctx, repo, replication)
+	}
+	if stub.UpdateFunc != nil {
+		return stub.UpdateFunc(repo, replication)
+	}
+	panic("ReplicationsStub.UpdateWithContext not stubbed")
+}
+
+var (
+	_ ReplicationsAPI = (*ReplicationsService)(nil)
+	_ ReplicationsAPI = (*ReplicationsStub)(nil)
+)
+
+// RepositoriesAPI is the interface implemented by the RepositoriesService.
+// The field of the Client can be set to a fake, like a RepositoriesStub, in tests.
+type RepositoriesAPI interface {
+	// Create constructs a repository with the provided details.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-CreateRepository
+	Create(repo string, body interface{}) (*string, *Response, error)
+
+	// CreateWithContext constructs a repository with the provided details using the provided context.
+	CreateWithContext(ctx context.Context, repo string, body interface{}) (*string, *Response, error)
+
+	// Delete removes the provided repository.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-DeleteRepository
+	Delete(repo string) (*string, *Response, error)
+
+	// DeleteWithContext removes the provided repository using the provided context.
+	DeleteWithContext(ctx context.Context, repo string) (*string, *Response, error)
+
+	// Get returns the provided repository.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-RepositoryConfiguration
+	Get(repo string) (interface{}, *Response, error)
+
+	// GetAll returns a list of all repositories.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-GetRepositories
+	GetAll() (*[]Repository, *Response, error)
+
+	// GetAllWithContext returns a list of all repositories using the provided context.
+	GetAllWithContext(ctx context.Context) (*[]Repository, *Response, error)
+
+	// GetWithContext returns the provided repository using the provided context.
+	GetWithContext(ctx context.Context, repo string) (interface{}, *Response, error)
+
+	// Update modifies a repository with the provided details.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-UpdateRepositoryConfiguration
+	Update(repo string, body interface{}) (*string, *Response, error)
+
+	// UpdateWithContext modifies a repository with the provided details using the provided context.
+	UpdateWithContext(ctx context.Context, repo string, body interface{}) (*string, *Response, error)
+}
+
+// RepositoriesStub is an implementation of the RepositoriesAPI for tests.
+// Every method calls the field of the same name with a Func suffix.
+// When it is nil, the method calls the field of its context variant,
+// or of the method it is the context variant of, and panics if that
+// one is nil too.
+type RepositoriesStub struct {
+	CreateFunc            func(repo string, body interface{}) (*string, *Response, error)
+	CreateWithContextFunc func(ctx context.Context, repo string, body interface{}) (*string, *Response, error)
+	DeleteFunc            func(repo string) (*string, *Response, error)
+	DeleteWithContextFunc func(ctx context.Context, repo string) (*string, *Response, error)
+	GetFunc               func(repo string) (interface{}, *Response, error)
+	GetAllFunc            func() (*[]Repository, *Response, error)
+	GetAllWithContextFunc func(ctx context.Context) (*[]Repository, *Response, error)
+	GetWithContextFunc    func(ctx context.Context, repo string) (interface{}, *Response, error)
+	UpdateFunc            func(repo string, body interface{}) (*string, *Response, error)
+	UpdateWithContextFunc func(ctx context.Context, repo string, body interface{}) (*string, *Response, error)
+}
+
+// Create calls CreateFunc.
+func (stub *RepositoriesStub) Create(repo string, body interface{}) (*string, *Response, error) {
+	if stub.CreateFunc != nil {
+		return stub.CreateFunc(repo, body)
+	}
+	if stub.CreateWithContextFunc != nil {
+		return stub.CreateWithContextFunc(context.Background(), repo, body)
+	}
+	panic("RepositoriesStub.Create not stubbed")
+}
+
+// CreateWithContext calls CreateWithContextFunc.
+func (stub *RepositoriesStub) CreateWithContext(ctx context.Context, repo string, body interface{}) (*string, *Response, error) {
+	if stub.CreateWithContextFunc != nil {
+		return stub.CreateWithContextFunc(ctx, repo, body)
+	}
+	if stub.CreateFunc != nil {
+		return stub.CreateFunc(repo, body)
+	}
+	panic("RepositoriesStub.CreateWithContext not stubbed")
+}
+
+// Delete calls DeleteFunc.
+func (stub *RepositoriesStub) Delete(repo string) (*string, *Response, error) {
+	if stub.DeleteFunc != nil {
+		return stub.DeleteFunc(repo)
+	}
+	if stub.DeleteWithContextFunc != nil {
+		return stub.DeleteWithContextFunc(context.Background(), repo)
+	}
+	panic("RepositoriesStub.Delete not stubbed")
+}
+
+// DeleteWithContext calls DeleteWithContextFunc.
+func (stub *RepositoriesStub) DeleteWithContext(ctx context.Context, repo string) (*string, *Response, error) {
+	if stub.DeleteWithContextFunc != nil {
+		return stub.DeleteWithContextFunc(ctx, repo)
+	}
+	if stub.DeleteFunc != nil {
+		return stub.DeleteFunc(repo)
+	}
+	panic("RepositoriesStub.DeleteWithContext not stubbed")
+}
+
+// Get calls GetFunc.
+func (stub *RepositoriesStub) Get(repo string) (interface{}, *Response, error) {
+	if stub.GetFunc != nil {
+		return stub.GetFunc(repo)
+	}
+	if stub.GetWithContextFunc != nil {
+		return stub.GetWithContextFunc(context.Background(), repo)
+	}
+	panic("RepositoriesStub.Get not stubbed")
+}
+
+// GetAll calls GetAllFunc.
+func (stub *RepositoriesStub) GetAll() (*[]Repository, *Response, error) {
+	if stub.GetAllFunc != nil {
+		return stub.GetAllFunc()
+	}
+	if stub.GetAllWithContextFunc != nil {
+		return stub.GetAllWithContextFunc(context.Background())
+	}
+	panic("RepositoriesStub.GetAll not stubbed")
+}
+
+// GetAllWithContext calls GetAllWithContextFunc.
+func (stub *RepositoriesStub) GetAllWithContext(ctx context.Context) (*[]Repository, *Response, error) {
+	if stub.GetAllWithContextFunc != nil {
+		return stub.GetAllWithContextFunc(ctx)
+	}
+	if stub.GetAllFunc != nil {
+		return stub.GetAllFunc()
+	}
+	panic("RepositoriesStub.GetAllWithContext not stubbed")
+}
+
+// GetWithContext calls GetWithContextFunc.
+func (stub *RepositoriesStub) GetWithContext(ctx context.Context, repo string) (interface{}, *Response, error) {
+	if stub.GetWithContextFunc != nil {
+		return stub.GetWithContextFunc(ctx, repo)
+	}
+	if stub.GetFunc != nil {
+		return stub.GetFunc(repo)
+	}
+	panic("RepositoriesStub.GetWithContext not stubbed")
+}
+
+// Update calls UpdateFunc.
+func (stub *RepositoriesStub) Update(repo string, body interface{}) (*string, *Response, error) {
+	if stub.UpdateFunc != nil {
+		return stub.UpdateFunc(repo, body)
+	}
+	if stub.UpdateWithContextFunc != nil {
+		return stub.UpdateWithContextFunc(context.Background(), repo, body)
+	}
+	panic("RepositoriesStub.Update not stubbed")
+}
+
+// UpdateWithContext calls UpdateWithContextFunc.
+func (stub *RepositoriesStub) UpdateWithContext(ctx context.Context, repo string, body interface{}) (*string, *Response, error) {
+	if stub.UpdateWithContextFunc != nil {
+		return stub.UpdateWithContextFunc(ctx, repo, body)
+	}
+	if stub.UpdateFunc != nil {
+		return stub.UpdateFunc(repo, body)
+	}
+	panic("RepositoriesStub.UpdateWithContext not stubbed")
+}
+
+var (
+	_ RepositoriesAPI = (*RepositoriesService)(nil)
+	_ RepositoriesAPI = (*RepositoriesStub)(nil)
+)
+
+// SearchAPI is the interface implemented by the SearchService.
+// The field of the Client can be set to a fake, like a SearchStub, in tests.
+type SearchAPI interface {
+	// GAVC returns the list of artifacts from the Maven search.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-GAVCSearch
+	GAVC(coords *GAVCRequest) (*GAVCResponse, *Response, error)
+
+	// GAVCWithContext returns the list of artifacts from the Maven search using the provided context.
+	GAVCWithContext(ctx context.Context, coords *GAVCRequest) (*GAVCResponse, *Response, error)
+}
+
+// SearchStub is an implementation of the SearchAPI for tests.
+// Every method calls the field of the same name with a Func suffix.
+// When it is nil, the method calls the field of its context variant,
+// or of the method it is the context variant of, and panics if that
+// one is nil too.
+type SearchStub struct {
+	GAVCFunc            func(coords *GAVCRequest) (*GAVCResponse, *Response, error)
+	GAVCWithContextFunc func(ctx context.Context, coords *GAVCRequest) (*GAVCResponse, *Response, error)
+}
+
+// GAVC calls GAVCFunc.
+func (stub *SearchStub) GAVC(coords *GAVCRequest) (*GAVCResponse, *Response, error) {
+	if stub.GAVCFunc != nil {
+		return stub.GAVCFunc(coords)
+	}
+	if stub.GAVCWithContextFunc != nil {
+		return stub.GAVCWithContextFunc(context.Background(), coords)
+	}
+	panic("SearchStub.GAVC not stubbed")
+}
+
+// GAVCWithContext calls GAVCWithContextFunc.
+func (stub *SearchStub) GAVCWithContext(ctx context.Context, coords *GAVCRequest) (*GAVCResponse, *Response, error) {
+	if stub.GAVCWithContextFunc != nil {
+		return stub.GAVCWithContextFunc(ctx, coords)
+	}
+	if stub.GAVCFunc != nil {
+		return stub.GAVCFunc(coords)
+	}
+	panic("SearchStub.GAVCWithContext not stubbed")
+}
+
+var (
+	_ SearchAPI = (*SearchService)(nil)
+	_ SearchAPI = (*SearchStub)(nil)
+)
+
+// StorageAPI is the interface implemented by the StorageService.
+// The field of the Client can be set to a fake, like a StorageStub, in tests.
+type StorageAPI interface {
+	// DeleteItemProperties removes the provided properties from the provided item.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-DeleteItemProperties
+	DeleteItemProperties(repo string, path string, properties []string) (*Response, error)
+
+	// DeleteItemPropertiesWithContext removes the provided properties from the provided item using the provided context.
+	DeleteItemPropertiesWithContext(ctx context.Context, repo string, path string, properties []string) (*Response, error)
+
+	// GetEffectiveItemPermissions returns the effective item permissions for a file or folder.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-EffectiveItemPermissions
+	GetEffectiveItemPermissions(repo string, path string) (*EffectiveItemPermissions, *Response, error)
+
+	// GetEffectiveItemPermissionsWithContext returns the effective item permissions for a file or folder using the provided context.
+	GetEffectiveItemPermissionsWithContext(ctx context.Context, repo string, path string) (*EffectiveItemPermissions, *Response, error)
+
+	// GetFile returns the provided file.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-FileInfo
+	GetFile(repo string, path string) (*File, *Response, error)
+
+	// GetFileList lists all files in the provided repo.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-FileList
+	GetFileList(repo string, path string) (*FileList, *Response, error)
+
+	// GetFileListWithContext lists all files in the provided repo using the provided context.
+	GetFileListWithContext(ctx context.Context, repo string, path string) (*FileList, *Response, error)
+
+	// GetFileStatistics returns download statistics for the provided file.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-FileStatistics
+	GetFileStatistics(repo string, path string) (*FileStatistics, *Response, error)
+
+	// GetFileStatisticsWithContext returns download statistics for the provided file using the provided context.
+	GetFileStatisticsWithContext(ctx context.Context, repo string, path string) (*FileStatistics, *Response, error)
+
+	// GetFileWithContext returns the provided file using the provided context.
+	GetFileWithContext(ctx context.Context, repo string, path string) (*File, *Response, error)
+
+	// GetFolder returns the provided folder.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-FolderInfo
+	GetFolder(repo string, path string) (*Folder, *Response, error)
+
+	// GetFolderWithContext returns the provided folder using the provided context.
+	GetFolderWithContext(ctx context.Context, repo string, path string) (*Folder, *Response, error)
+
+	// GetItemLastModified returns the ISO8601 timestamp of the provided item's last modified date.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-ItemLastModified
+	GetItemLastModified(repo string, path string) (*ItemLastModified, *Response, error)
+
+	// GetItemLastModifiedWithContext returns the ISO8601 timestamp of the provided item's last modified date using the provided context.
+	GetItemLastModifiedWithContext(ctx context.Context, repo string, path string) (*ItemLastModified, *Response, error)
+
+	// GetItemProperties returns properties on the provided item.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-ItemProperties
+	GetItemProperties(repo string, path string) (*ItemProperties, *Response, error)
+
+	// GetItemPropertiesWithContext returns properties on the provided item using the provided context.
+	GetItemPropertiesWithContext(ctx context.Context, repo string, path string) (*ItemProperties, *Response, error)
+
+	// GetStorageSummary returns the storage summary information.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-GetStorageSummaryInfo
+	GetStorageSummary() (*StorageSummary, *Response, error)
+
+	// GetStorageSummaryWithContext returns the storage summary information using the provided context.
+	GetStorageSummaryWithContext(ctx context.Context) (*StorageSummary, *Response, error)
+
+	// SetItemProperties attaches the provided properties to the provided item.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-SetItemProperties
+	SetItemProperties(repo string, path string, properties map[string][]string) (*Response, error)
+
+	// SetItemPropertiesWithContext attaches the provided properties to the provided item using the provided context.
+	SetItemPropertiesWithContext(ctx context.Context, repo string, path string, properties map[string][]string) (*Response, error)
+}
+
+// StorageStub is an implementation of the StorageAPI for tests.
+// Every method calls the field of the same name with a Func suffix.
+// When it is nil, the method calls the field of its context variant,
+// or of the method it is the context variant of, and panics if that
+// one is nil too.
+type StorageStub struct {
+	DeleteItemPropertiesFunc                   func(repo string, path string, properties []string) (*Response, error)
+	DeleteItemPropertiesWithContextFunc        func(ctx context.Context, repo string, path string, properties []string) (*Response, error)
+	GetEffectiveItemPermissionsFunc            func(repo string, path string) (*EffectiveItemPermissions, *Response, error)
+	GetEffectiveItemPermissionsWithContextFunc func(ctx context.Context, repo string, path string) (*EffectiveItemPermissions, *Response, error)
+	GetFileFunc                                func(repo string, path string) (*File, *Response, error)
+	GetFileListFunc                            func(repo string, path string) (*FileList, *Response, error)
+	GetFileListWithContextFunc                 func(ctx context.Context, repo string, path string) (*FileList, *Response, error)
+	GetFileStatisticsFunc                      func(repo string, path string) (*FileStatistics, *Response, error)
+	GetFileStatisticsWithContextFunc           func(ctx context.Context, repo string, path string) (*FileStatistics, *Response, error)
+	GetFileWithContextFunc                     func(ctx context.Context, repo string, path string) (*File, *Response, error)
+	GetFolderFunc                              func(repo string, path string) (*Folder, *Response, error)
+	GetFolderWithContextFunc                   func(ctx context.Context, repo string, path string) (*Folder, *Response, error)
+	GetItemLastModifiedFunc                    func(repo string, path string) (*ItemLastModified, *Response, error)
+	GetItemLastModifiedWithContextFunc         func(ctx context.Context, repo string, path string) (*ItemLastModified, *Response, error)
+	GetItemPropertiesFunc                      func(repo string, path string) (*ItemProperties, *Response, error)
+	GetItemPropertiesWithContextFunc           func(ctx context.Context, repo string, path string) (*ItemProperties, *Response, error)
+	GetStorageSummaryFunc                      func() (*StorageSummary, *Response, error)
+	GetStorageSummaryWithContextFunc           func(ctx context.Context) (*StorageSummary, *Response, error)
+	SetItemPropertiesFunc                      func(repo string, path string, properties map[string][]string) (*Response, error)
+	SetItemPropertiesWithContextFunc           func(ctx context.Context, repo string, path string, properties map[string][]string) (*Response, error)
+}
+
+// DeleteItemProperties calls DeleteItemPropertiesFunc.
+func (stub *StorageStub) DeleteItemProperties(repo string, path string, properties []string) (*Response, error) {
+	if stub.DeleteItemPropertiesFunc != nil {
+		return stub.DeleteItemPropertiesFunc(repo, path, properties)
+	}
+	if stub.DeleteItemPropertiesWithContextFunc != nil {
+		return stub.DeleteItemPropertiesWithContextFunc(context.Background(), repo, path, properties)
+	}
+	panic("StorageStub.DeleteItemProperties not stubbed")
+}
+
+// DeleteItemPropertiesWithContext calls DeleteItemPropertiesWithContextFunc.
+func (stub *StorageStub) DeleteItemPropertiesWithContext(ctx context.Context, repo string, path string, properties []string) (*Response, error) {
+	if stub.DeleteItemPropertiesWithContextFunc != nil {
+		return stub.DeleteItemPropertiesWithContextFunc(ctx, repo, path, properties)
+	}
+	if stub.DeleteItemPropertiesFunc != nil {
+		return stub.DeleteItemPropertiesFunc(repo, path, properties)
+	}
+	panic("StorageStub.DeleteItemPropertiesWithContext not stubbed")
+}
+
+// GetEffectiveItemPermissions calls GetEffectiveItemPermissionsFunc.
+func (stub *StorageStub) GetEffectiveItemPermissions(repo string, path string) (*EffectiveItemPermissions, *Response, error) {
+	if stub.GetEffectiveItemPermissionsFunc != nil {
+		return stub.GetEffectiveItemPermissionsFunc(repo, path)
+	}
+	if stub.GetEffectiveItemPermissionsWithContextFunc != nil {
+		return stub.GetEffectiveItemPermissionsWithContextFunc(context.Background(), repo, path)
+	}
+	panic("StorageStub.GetEffectiveItemPermissions not stubbed")
+}
+
+// GetEffectiveItemPermissionsWithContext calls GetEffectiveItemPermissionsWithContextFunc.
+func (stub *StorageStub) GetEffectiveItemPermissionsWithContext(ctx context.Context, repo string, path string) (*EffectiveItemPermissions, *Response, error) {
+	if stub.GetEffectiveItemPermissionsWithContextFunc != nil {
+		return stub.GetEffectiveItemPermissionsWithContextFunc(ctx, repo, path)
+	}
+	if stub.GetEffectiveItemPermissionsFunc != nil {
+		return stub.GetEffectiveItemPermissionsFunc(repo, path)
+	}
+	panic("StorageStub.GetEffectiveItemPermissionsWithContext not stubbed")
+}
+
+// GetFile calls GetFileFunc.
+func (stub *StorageStub) GetFile(repo string, path string) (*File, *Response, error) {
+	if stub.GetFileFunc != nil {
+		return stub.GetFileFunc(repo, path)
+	}
+	if stub.GetFileWithContextFunc != nil {
+		return stub.GetFileWithContextFunc(context.Background(), repo, path)
+	}
+	panic("StorageStub.GetFile not stubbed")
+}
+
+// GetFileList calls GetFileListFunc.
+func (stub *StorageStub) GetFileList(repo string, path string) (*FileList, *Response, error) {
+	if stub.GetFileListFunc != nil {
+		return stub.GetFileListFunc(repo, path)
+	}
+	if stub.GetFileListWithContextFunc != nil {
+		return stub.GetFileListWithContextFunc(context.Background(), repo, path)
+	}
+	panic("StorageStub.GetFileList not stubbed")
+}
+
+// GetFileListWithContext calls GetFileListWithContextFunc.
+func (stub *StorageStub) GetFileListWithContext(ctx context.Context, repo string, path string) (*FileList, *Response, error) {
+	if stub.GetFileListWithContextFunc != nil {
+		return stub.GetFileListWithContextFunc(ctx, repo, path)
+	}
+	if stub.GetFileListFunc != nil {
+		return stub.GetFileListFunc(repo, path)
+	}
+	panic("StorageStub.GetFileListWithContext not stubbed")
+}
+
+// GetFileStatistics calls GetFileStatisticsFunc.
+func (stub *StorageStub) GetFileStatistics(repo string, path string) (*FileStatistics, *Response, error) {
+	if stub.GetFileStatisticsFunc != nil {
+		return stub.GetFileStatisticsFunc(repo, path)
+	}
+	if stub.GetFileStatisticsWithContextFunc != nil {
+		return stub.GetFileStatisticsWithContextFunc(context.Background(), repo, path)
+	}
+	panic("StorageStub.GetFileStatistics not stubbed")
+}
+
+// GetFileStatisticsWithContext calls GetFileStatisticsWithContextFunc.
+func (stub *StorageStub) GetFileStatisticsWithContext(ctx context.Context, repo string, path string) (*FileStatistics, *Response, error) {
+	if stub.GetFileStatisticsWithContextFunc != nil {
+		return stub.GetFileStatisticsWithContextFunc(ctx, repo, path)
+	}
+	if stub.GetFileStatisticsFunc != nil {
+		return stub.GetFileStatisticsFunc(repo, path)
+	}
+	panic("StorageStub.GetFileStatisticsWithContext not stubbed")
+}
+
+// GetFileWithContext calls GetFileWithContextFunc.
+func (stub *StorageStub) GetFileWithContext(ctx context.Context, repo string, path string) (*File, *Response, error) {
+	if stub.GetFileWithContextFunc != nil {
+		return stub.GetFileWithContextFunc(ctx, repo, path)
+	}
+	if stub.GetFileFunc != nil {
+		return stub.GetFileFunc(repo, path)
+	}
+	panic("StorageStub.GetFileWithContext not stubbed")
+}
+
+// GetFolder calls GetFolderFunc.
+func (stub *StorageStub) GetFolder(repo string, path string) (*Folder, *Response, error) {
+	if stub.GetFolderFunc != nil {
+		return stub.GetFolderFunc(repo, path)
+	}
+	if stub.GetFolderWithContextFunc != nil {
+		return stub.GetFolderWithContextFunc(context.Background(), repo, path)
+	}
+	panic("StorageStub.GetFolder not stubbed")
+}
+
+// GetFolderWithContext calls GetFolderWithContextFunc.
+func (stub *StorageStub) GetFolderWithContext(ctx context.Context, repo string, path string) (*Folder, *Response, error) {
+	if stub.GetFolderWithContextFunc != nil {
+		return stub.GetFolderWithContextFunc(ctx, repo, path)
+	}
+	if stub.GetFolderFunc != nil {
+		return stub.GetFolderFunc(repo, path)
+	}
+	panic("StorageStub.GetFolderWithContext not stubbed")
+}
+
+// GetItemLastModified calls GetItemLastModifiedFunc.
+func (stub *StorageStub) GetItemLastModified(repo string, path string) (*ItemLastModified, *Response, error) {
+	if stub.GetItemLastModifiedFunc != nil {
+		return stub.GetItemLastModifiedFunc(repo, path)
+	}
+	if stub.GetItemLastModifiedWithContextFunc != nil {
+		return stub.GetItemLastModifiedWithContextFunc(context.Background(), repo, path)
+	}
+	panic("StorageStub.GetItemLastModified not stubbed")
+}
+
+// GetItemLastModifiedWithContext calls GetItemLastModifiedWithContextFunc.
+func (stub *StorageStub) GetItemLastModifiedWithContext(ctx context.Context, repo string, path string) (*ItemLastModified, *Response, error) {
+	if stub.GetItemLastModifiedWithContextFunc != nil {
+		return stub.GetItemLastModifiedWithContextFunc(ctx, repo, path)
+	}
+	if stub.GetItemLastModifiedFunc != nil {
+		return stub.GetItemLastModifiedFunc(repo, path)
+	}
+	panic("StorageStub.GetItemLastModifiedWithContext not stubbed")
+}
+
+// GetItemProperties calls GetItemPropertiesFunc.
+func (stub *StorageStub) GetItemProperties(repo string, path string) (*ItemProperties, *Response, error) {
+	if stub.GetItemPropertiesFunc != nil {
+		return stub.GetItemPropertiesFunc(repo, path)
+	}
+	if stub.GetItemPropertiesWithContextFunc != nil {
+		return stub.GetItemPropertiesWithContextFunc(context.Background(), repo, path)
+	}
+	panic("StorageStub.GetItemProperties not stubbed")
+}
+
+// GetItemPropertiesWithContext calls GetItemPropertiesWithContextFunc.
+func (stub *StorageStub) GetItemPropertiesWithContext(ctx context.Context, repo string, path string) (*ItemProperties, *Response, error) {
+	if stub.GetItemPropertiesWithContextFunc != nil {
+		return stub.GetItemPropertiesWithContextFunc(ctx, repo, path)
+	}
+	if stub.GetItemPropertiesFunc != nil {
+		return stub.GetItemPropertiesFunc(repo, path)
+	}
+	panic("StorageStub.GetItemPropertiesWithContext not stubbed")
+}
+
+// GetStorageSummary calls GetStorageSummaryFunc.
+func (stub *StorageStub) GetStorageSummary() (*StorageSummary, *Response, error) {
+	if stub.GetStorageSummaryFunc != nil {
+		return stub.GetStorageSummaryFunc()
+	}
+	if stub.GetStorageSummaryWithContextFunc != nil {
+		return stub.GetStorageSummaryWithContextFunc(context.Background())
+	}
+	panic("StorageStub.GetStorageSummary not stubbed")
+}
+
+// GetStorageSummaryWithContext calls GetStorageSummaryWithContextFunc.
+func (stub *StorageStub) GetStorageSummaryWithContext(ctx context.Context) (*StorageSummary, *Response, error) {
+	if stub.GetStorageSummaryWithContextFunc != nil {
+		return stub.GetStorageSummaryWithContextFunc(ctx)
+	}
+	if stub.GetStorageSummaryFunc != nil {
+		return stub.GetStorageSummaryFunc()
+	}
+	panic("StorageStub.GetStorageSummaryWithContext not stubbed")
+}
+
+// SetItemProperties calls SetItemPropertiesFunc.
+func (stub *StorageStub) SetItemProperties(repo string, path string, properties map[string][]string) (*Response, error) {
+	if stub.SetItemPropertiesFunc != nil {
+		return stub.SetItemPropertiesFunc(repo, path, properties)
+	}
+	if stub.SetItemPropertiesWithContextFunc != nil {
+		return stub.SetItemPropertiesWithContextFunc(context.Background(), repo, path, properties)
+	}
+	panic("StorageStub.SetItemProperties not stubbed")
+}
+
+// SetItemPropertiesWithContext calls SetItemPropertiesWithContextFunc.
+func (stub *StorageStub) SetItemPropertiesWithContext(ctx context.Context, repo string, path string, properties map[string][]string) (*Response, error) {
+	if stub.SetItemPropertiesWithContextFunc != nil {
+		return stub.SetItemPropertiesWithContextFunc(ctx, repo, path, properties)
+	}
+	if stub.SetItemPropertiesFunc != nil {
+		return stub.SetItemPropertiesFunc(repo, path, properties)
+	}
+	panic("StorageStub.SetItemPropertiesWithContext not stubbed")
+}
+
+var (
+	_ StorageAPI = (*StorageService)(nil)
+	_ StorageAPI = (*StorageStub)(nil)
+)
+
+// SystemAPI is the interface implemented by the SystemService.
+// The field of the Client can be set to a fake, like a SystemStub, in tests.
+type SystemAPI interface {
+	// Get returns the general system information.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-SystemInfo
+	Get() (*string, *Response, error)
+
+	// GetConfiguration returns the Global Artifactory Configuration Descriptor (artifactory.config.xml).
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-GeneralConfiguration
+	GetConfiguration() (*GlobalConfig, *Response, error)
+
+	// GetConfigurationWithContext returns the Global Artifactory Configuration Descriptor (artifactory.config.xml) using the provided context.
+	GetConfigurationWithContext(ctx context.Context) (*GlobalConfig, *Response, error)
+
+	// GetVersionAndAddOns returns information about the current version, revision, and installed add-ons.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-VersionandAdd-onsinformation
+	GetVersionAndAddOns() (*Versions, *Response, error)
+
+	// GetVersionAndAddOnsWithContext returns information about the current version, revision, and installed add-ons using the provided context.
+	GetVersionAndAddOnsWithContext(ctx context.Context) (*Versions, *Response, error)
+
+	// GetWithContext returns the general system information using the provided context.
+	GetWithContext(ctx context.Context) (*string, *Response, error)
+
+	// Ping returns a simple status response.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-SystemHealthPing
+	Ping() (*string, *Response, error)
+
+	// PingWithContext returns a simple status response using the provided context.
+	PingWithContext(ctx context.Context) (*string, *Response, error)
+
+	// UpdateConfiguration applies the provided Global system configuration to Artifactory.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-GeneralConfiguration
+	//
+	// 	https://www.jfrog.com/confluence/display/RTF/YAML+Configuration+File#YAMLConfigurationFile-Advanced
+	UpdateConfiguration(config GlobalConfig) (*string, *Response, error)
+
+	// UpdateConfigurationWithContext applies the provided Global system configuration to Artifactory using the provided context.
+	UpdateConfigurationWithContext(ctx context.Context, config GlobalConfig) (*string, *Response, error)
+}
+
+// SystemStub is an implementation of the SystemAPI for tests.
+// Every method calls the field of the same name with a Func suffix.
+// When it is nil, the method calls the field of its context variant,
+// or of the method it is the context variant of, and panics if that
+// one is nil too.
+type SystemStub struct {
+	GetFunc                            func() (*string, *Response, error)
+	GetConfigurationFunc               func() (*GlobalConfig, *Response, error)
+	GetConfigurationWithContextFunc    func(ctx context.Context) (*GlobalConfig, *Response, error)
+	GetVersionAndAddOnsFunc            func() (*Versions, *Response, error)
+	GetVersionAndAddOnsWithContextFunc func(ctx context.Context) (*Versions, *Response, error)
+	GetWithContextFunc                 func(ctx context.Context) (*string, *Response, error)
+	PingFunc                           func() (*string, *Response, error)
+	PingWithContextFunc                func(ctx context.Context) (*string, *Response, error)
+	UpdateConfigurationFunc            func(config GlobalConfig) (*string, *Response, error)
+	UpdateConfigurationWithContextFunc func(ctx context.Context, config GlobalConfig) (*string, *Response, error)
+}
+
+// Get calls GetFunc.
+func (stub *SystemStub) Get() (*string, *Response, error) {
+	if stub.GetFunc != nil {
+		return stub.GetFunc()
+	}
+	if stub.GetWithContextFunc != nil {
+		return stub.GetWithContextFunc(context.Background())
+	}
+	panic("SystemStub.Get not stubbed")
+}
+
+// GetConfiguration calls GetConfigurationFunc.
+func (stub *SystemStub) GetConfiguration() (*GlobalConfig, *Response, error) {
+	if stub.GetConfigurationFunc != nil {
+		return stub.GetConfigurationFunc()
+	}
+	if stub.GetConfigurationWithContextFunc != nil {
+		return stub.GetConfigurationWithContextFunc(context.Background())
+	}
+	panic("SystemStub.GetConfiguration not stubbed")
+}
+
+// GetConfigurationWithContext calls GetConfigurationWithContextFunc.
+func (stub *SystemStub) GetConfigurationWithContext(ctx context.Context) (*GlobalConfig, *Response, error) {
+	if stub.GetConfigurationWithContextFunc != nil {
+		return stub.GetConfigurationWithContextFunc(ctx)
+	}
+	if stub.GetConfigurationFunc != nil {
+		return stub.GetConfigurationFunc()
+	}
+	panic("SystemStub.GetConfigurationWithContext not stubbed")
+}
+
+// GetVersionAndAddOns calls GetVersionAndAddOnsFunc.
+func (stub *SystemStub) GetVersionAndAddOns() (*Versions, *Response, error) {
+	if stub.GetVersionAndAddOnsFunc != nil {
+		return stub.GetVersionAndAddOnsFunc()
+	}
+	if stub.GetVersionAndAddOnsWithContextFunc != nil {
+		return stub.GetVersionAndAddOnsWithContextFunc(context.Background())
+	}
+	panic("SystemStub.GetVersionAndAddOns not stubbed")
+}
+
+// GetVersionAndAddOnsWithContext calls GetVersionAndAddOnsWithContextFunc.
+func (stub *SystemStub) GetVersionAndAddOnsWithContext(ctx context.Context) (*Versions, *Response, error) {
+	if stub.GetVersionAndAddOnsWithContextFunc != nil {
+		return stub.GetVersionAndAddOnsWithContextFunc(ctx)
+	}
+	if stub.GetVersionAndAddOnsFunc != nil {
+		return stub.GetVersionAndAddOnsFunc()
+	}
+	panic("SystemStub.GetVersionAndAddOnsWithContext not stubbed")
+}
+
+// GetWithContext calls GetWithContextFunc.
+func (stub *SystemStub) GetWithContext(ctx context.Context) (*string, *Response, error) {
+	if stub.GetWithContextFunc != nil {
+		return stub.GetWithContextFunc(ctx)
+	}
+	if stub.GetFunc != nil {
+		return stub.GetFunc()
+	}
+	panic("SystemStub.GetWithContext not stubbed")
+}
+
+// Ping calls PingFunc.
+func (stub *SystemStub) Ping() (*string, *Response, error) {
+	if stub.PingFunc != nil {
+		return stub.PingFunc()
+	}
+	if stub.PingWithContextFunc != nil {
+		return stub.PingWithContextFunc(context.Background())
+	}
+	panic("SystemStub.Ping not stubbed")
+}
+
+// PingWithContext calls PingWithContextFunc.
+func (stub *SystemStub) PingWithContext(ctx context.Context) (*string, *Response, error) {
+	if stub.PingWithContextFunc != nil {
+		return stub.PingWithContextFunc(ctx)
+	}
+	if stub.PingFunc != nil {
+		return stub.PingFunc()
+	}
+	panic("SystemStub.PingWithContext not stubbed")
+}
+
+// UpdateConfiguration calls UpdateConfigurationFunc.
+func (stub *SystemStub) UpdateConfiguration(config GlobalConfig) (*string, *Response, error) {
+	if stub.UpdateConfigurationFunc != nil {
+		return stub.UpdateConfigurationFunc(config)
+	}
+	if stub.UpdateConfigurationWithContextFunc != nil {
+		return stub.UpdateConfigurationWithContextFunc(context.Background(), config)
+	}
+	panic("SystemStub.UpdateConfiguration not stubbed")
+}
+
+// UpdateConfigurationWithContext calls UpdateConfigurationWithContextFunc.
+func (stub *SystemStub) UpdateConfigurationWithContext(ctx context.Context, config GlobalConfig) (*string, *Response, error) {
+	if stub.UpdateConfigurationWithContextFunc != nil {
+		return stub.UpdateConfigurationWithContextFunc(ctx, config)
+	}
+	if stub.UpdateConfigurationFunc != nil {
+		return stub.UpdateConfigurationFunc(config)
+	}
+	panic("SystemStub.UpdateConfigurationWithContext not stubbed")
+}
+
+var (
+	_ SystemAPI = (*SystemService)(nil)
+	_ SystemAPI = (*SystemStub)(nil)
+)
+
+// UsersAPI is the interface implemented by the UsersService.
+// The field of the Client can be set to a fake, like a UsersStub, in tests.
+type UsersAPI interface {
+	// CreateAPIKey constructs an api key for the authenticated user.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-CreateAPIKey
+	CreateAPIKey() (*APIKey, *Response, error)
+
+	// CreateAPIKeyWithContext constructs an api key for the authenticated user using the provided context.
+	CreateAPIKeyWithContext(ctx context.Context) (*APIKey, *Response, error)
+
+	// CreateSecurity constructs a user with the provided details.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-CreateorReplaceUser
+	CreateSecurity(user *SecurityUser) (*string, *Response, error)
+
+	// CreateSecurityWithContext constructs a user with the provided details using the provided context.
+	CreateSecurityWithContext(ctx context.Context, user *SecurityUser) (*string, *Response, error)
+
+	// DeleteAPIKey removes an api key for the authenticated user.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-RevokeAPIKey
+	DeleteAPIKey() (*DeleteAPIKey, *Response, error)
+
+	// DeleteAPIKeyWithContext removes an api key for the authenticated user using the provided context.
+	DeleteAPIKeyWithContext(ctx context.Context) (*DeleteAPIKey, *Response, error)
+
+	// DeleteAllAPIKeys removes all api keys.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-RevokeAllAPIKeys
+	DeleteAllAPIKeys() (*DeleteAPIKey, *Response, error)
+
+	// DeleteAllAPIKeysWithContext removes all api keys using the provided context.
+	DeleteAllAPIKeysWithContext(ctx context.Context) (*DeleteAPIKey, *Response, error)
+
+	// DeleteSecurity removes the provided user.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-DeleteUser
+	DeleteSecurity(user string) (*string, *Response, error)
+
+	// DeleteSecurityWithContext removes the provided user using the provided context.
+	DeleteSecurityWithContext(ctx context.Context, user string) (*string, *Response, error)
+
+	// DeleteUserAPIKey removes an api key for the provided user.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-RevokeUserAPIKey
+	DeleteUserAPIKey(user string) (*DeleteAPIKey, *Response, error)
+
+	// DeleteUserAPIKeyWithContext removes an api key for the provided user using the provided context.
+	DeleteUserAPIKeyWithContext(ctx context.Context, user string) (*DeleteAPIKey, *Response, error)
+
+	// GetAPIKey returns the api key of the authenticated user.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-GetAPIKey
+	GetAPIKey() (*APIKey, *Response, error)
+
+	// GetAPIKeyWithContext returns the api key of the authenticated user using the provided context.
+	GetAPIKeyWithContext(ctx context.Context) (*APIKey, *Response, error)
+
+	// GetAll returns a list of all users.
+	//
+	// Docs: This endpoint is currently undocumented by JFrog
+	GetAll() (*[]User, *Response, error)
+
+	// GetAllSecurity returns a list of all users.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-GetUsers
+	GetAllSecurity() (*[]SecurityUser, *Response, error)
+
+	// GetAllSecurityWithContext returns a list of all users using the provided context.
+	GetAllSecurityWithContext(ctx context.Context) (*[]SecurityUser, *Response, error)
+
+	// GetAllWithContext returns a list of all users using the provided context.
+	GetAllWithContext(ctx context.Context) (*[]User, *Response, error)
+
+	// GetEncryptedPassword returns the encrypted password of the authenticated user.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-GetUserEncryptedPassword
+	GetEncryptedPassword() (*string, *Response, error)
+
+	// GetEncryptedPasswordWithContext returns the encrypted password of the authenticated user using the provided context.
+	GetEncryptedPasswordWithContext(ctx context.Context) (*string, *Response, error)
+
+	// GetSecurity returns the provided user.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-GetUserDetails
+	GetSecurity(user string) (*SecurityUser, *Response, error)
+
+	// GetSecurityWithContext returns the provided user using the provided context.
+	GetSecurityWithContext(ctx context.Context, user string) (*SecurityUser, *Response, error)
+
+	// RegenerateAPIKey recreates an api key for the authenticated user.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-RegenerateAPIKey
+	RegenerateAPIKey() (*APIKey, *Response, error)
+
+	// RegenerateAPIKeyWithContext recreates an api key for the authenticated user using the provided context.
+	RegenerateAPIKeyWithContext(ctx context.Context) (*APIKey, *Response, error)
+
+	// UpdateSecurity modifies a user with the provided details.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-UpdateUser
+	UpdateSecurity(user *SecurityUser) (*string, *Response, error)
+
+	// UpdateSecurityWithContext modifies a user with the provided details using the provided context.
+	UpdateSecurityWithContext(ctx context.Context, user *SecurityUser) (*string, *Response, error)
+}
+
+// UsersStub is an implementation of the UsersAPI for tests.
+// Every method calls the field of the same name with a Func suffix.
+// When it is nil, the method calls the field of its context variant,
+// or of the method it is the context variant of, and panics if that
+// one is nil too.
+type UsersStub struct {
+	CreateAPIKeyFunc                    func() (*APIKey, *Response, error)
+	CreateAPIKeyWithContextFunc         func(ctx context.Context) (*APIKey, *Response, error)
+	CreateSecurityFunc                  func(user *SecurityUser) (*string, *Response, error)
+	CreateSecurityWithContextFunc       func(ctx context.Context, user *SecurityUser) (*string, *Response, error)
+	DeleteAPIKeyFunc                    func() (*DeleteAPIKey, *Response, error)
+	DeleteAPIKeyWithContextFunc         func(ctx context.Context) (*DeleteAPIKey, *Response, error)
+	DeleteAllAPIKeysFunc                func() (*DeleteAPIKey, *Response, error)
+	DeleteAllAPIKeysWithContextFunc     func(ctx context.Context) (*DeleteAPIKey, *Response, error)
+	DeleteSecurityFunc                  func(user string) (*string, *Response, error)
+	DeleteSecurityWithContextFunc       func(ctx context.Context, user string) (*string, *Response, error)
+	DeleteUserAPIKeyFunc                func(user string) (*DeleteAPIKey, *Response, error)
+	DeleteUserAPIKeyWithContextFunc     func(ctx context.Context, user string) (*DeleteAPIKey, *Response, error)
+	GetAPIKeyFunc                       func() (*APIKey, *Response, error)
+	GetAPIKeyWithContextFunc            func(ctx context.Context) (*APIKey, *Response, error)
+	GetAllFunc                          func() (*[]User, *Response, error)
+	GetAllSecurityFunc                  func() (*[]SecurityUser, *Response, error)
+	GetAllSecurityWithContextFunc       func(ctx context.Context) (*[]SecurityUser, *Response, error)
+	GetAllWithContextFunc               func(ctx context.Context) (*[]User, *Response, error)
+	GetEncryptedPasswordFunc            func() (*string, *Response, error)
+	GetEncryptedPasswordWithContextFunc func(ctx context.Context) (*string, *Response, error)
+	GetSecurityFunc                     func(user string) (*SecurityUser, *Response, error)
+	GetSecurityWithContextFunc          func(ctx context.Context, user string) (*SecurityUser, *Response, error)
+	RegenerateAPIKeyFunc                func() (*APIKey, *Response, error)
+	RegenerateAPIKeyWithContextFunc     func(ctx context.Context) (*APIKey, *Response, error)
+	UpdateSecurityFunc                  func(user *SecurityUser) (*string, *Response, error)
+	UpdateSecurityWithContextFunc       func(ctx context.Context, user *SecurityUser) (*string, *Response, error)
+}
+
+// CreateAPIKey calls CreateAPIKeyFunc.
+func (stub *UsersStub) CreateAPIKey() (*APIKey, *Response, error) {
+	if stub.CreateAPIKeyFunc != nil {
+		return stub.CreateAPIKeyFunc()
+	}
+	if stub.CreateAPIKeyWithContextFunc != nil {
+		return stub.CreateAPIKeyWithContextFunc(context.Background())
+	}
+	panic("UsersStub.CreateAPIKey not stubbed")
+}
+
+// CreateAPIKeyWithContext calls CreateAPIKeyWithContextFunc.
+func (stub *UsersStub) CreateAPIKeyWithContext(ctx context.Context) (*APIKey, *Response, error) {
+	if stub.CreateAPIKeyWithContextFunc != nil {
+		return stub.CreateAPIKeyWithContextFunc(ctx)
+	}
+	if stub.CreateAPIKeyFunc != nil {
+		return stub.CreateAPIKeyFunc()
+	}
+	panic("UsersStub.CreateAPIKeyWithContext not stubbed")
+}
+
+// CreateSecurity calls CreateSecurityFunc.
+func (stub *UsersStub) CreateSecurity(user *SecurityUser) (*string, *Response, error) {
+	if stub.CreateSecurityFunc != nil {
+		return stub.CreateSecurityFunc(user)
+	}
+	if stub.CreateSecurityWithContextFunc != nil {
+		return stub.CreateSecurityWithContextFunc(context.Background(), user)
+	}
+	panic("UsersStub.CreateSecurity not stubbed")
+}
+
+// CreateSecurityWithContext calls CreateSecurityWithContextFunc.
+func (stub *UsersStub) CreateSecurityWithContext(ctx context.Context, user *SecurityUser) (*string, *Response, error) {
+	if stub.CreateSecurityWithContextFunc != nil {
+		return stub.CreateSecurityWithContextFunc(ctx, user)
+	}
+	if stub.CreateSecurityFunc != nil {
+		return stub.CreateSecurityFunc(user)
+	}
+	panic("UsersStub.CreateSecurityWithContext not stubbed")
+}
+
+// DeleteAPIKey calls DeleteAPIKeyFunc.
+func (stub *UsersStub) DeleteAPIKey() (*DeleteAPIKey, *Response, error) {
+	if stub.DeleteAPIKeyFunc != nil {
+		return stub.DeleteAPIKeyFunc()
+	}
+	if stub.DeleteAPIKeyWithContextFunc != nil {
+		return stub.DeleteAPIKeyWithContextFunc(context.Background())
+	}
+	panic("UsersStub.DeleteAPIKey not stubbed")
+}
+
+// DeleteAPIKeyWithContext calls DeleteAPIKeyWithContextFunc.
+func (stub *UsersStub) DeleteAPIKeyWithContext(ctx context.Context) (*DeleteAPIKey, *Response, error) {
+	if stub.DeleteAPIKeyWithContextFunc != nil {
+		return stub.DeleteAPIKeyWithContextFunc(ctx)
+	}
+	if stub.DeleteAPIKeyFunc != nil {
+		return stub.DeleteAPIKeyFunc()
+	}
+	panic("UsersStub.DeleteAPIKeyWithContext not stubbed")
+}
+
+// DeleteAllAPIKeys calls DeleteAllAPIKeysFunc.
+func (stub *UsersStub) DeleteAllAPIKeys() (*DeleteAPIKey, *Response, error) {
+	if stub.DeleteAllAPIKeysFunc != nil {
+		return stub.DeleteAllAPIKeysFunc()
+	}
+	if stub.DeleteAllAPIKeysWithContextFunc != nil {
+		return stub.DeleteAllAPIKeysWithContextFunc(context.Background())
+	}
+	panic("UsersStub.DeleteAllAPIKeys not stubbed")
+}
+
+// DeleteAllAPIKeysWithContext calls DeleteAllAPIKeysWithContextFunc.
+func (stub *UsersStub) DeleteAllAPIKeysWithContext(ctx context.Context) (*DeleteAPIKey, *Response, error) {
+	if stub.DeleteAllAPIKeysWithContextFunc != nil {
+		return stub.DeleteAllAPIKeysWithContextFunc(ctx)
+	}
+	if stub.DeleteAllAPIKeysFunc != nil {
+		return stub.DeleteAllAPIKeysFunc()
+	}
+	panic("UsersStub.DeleteAllAPIKeysWithContext not stubbed")
+}
+
+// DeleteSecurity calls DeleteSecurityFunc.
+func (stub *UsersStub) DeleteSecurity(user string) (*string, *Response, error) {
+	if stub.DeleteSecurityFunc != nil {
+		return stub.DeleteSecurityFunc(user)
+	}
+	if stub.DeleteSecurityWithContextFunc != nil {
+		return stub.DeleteSecurityWithContextFunc(context.Background(), user)
+	}
+	panic("UsersStub.DeleteSecurity not stubbed")
+}
+
+// DeleteSecurityWithContext calls DeleteSecurityWithContextFunc.
+func (stub *UsersStub) DeleteSecurityWithContext(ctx context.Context, user string) (*string, *Response, error) {
+	if stub.DeleteSecurityWithContextFunc != nil {
+		return stub.DeleteSecurityWithContextFunc(ctx, user)
+	}
+	if stub.DeleteSecurityFunc != nil {
+		return stub.DeleteSecurityFunc(user)
+	}
+	panic("UsersStub.DeleteSecurityWithContext not stubbed")
+}
+
+// DeleteUserAPIKey calls DeleteUserAPIKeyFunc.
+func (stub *UsersStub) DeleteUserAPIKey(user string) (*DeleteAPIKey, *Response, error) {
+	if stub.DeleteUserAPIKeyFunc != nil {
+		return stub.DeleteUserAPIKeyFunc(user)
+	}
+	if stub.DeleteUserAPIKeyWithContextFunc != nil {
+		return stub.DeleteUserAPIKeyWithContextFunc(context.Background(), user)
+	}
+	panic("UsersStub.DeleteUserAPIKey not stubbed")
+}
+
+// DeleteUserAPIKeyWithContext calls DeleteUserAPIKeyWithContextFunc.
+func (stub *UsersStub) DeleteUserAPIKeyWithContext(ctx context.Context, user string) (*DeleteAPIKey, *Response, error) {
+	if stub.DeleteUserAPIKeyWithContextFunc != nil {
+		return stub.DeleteUserAPIKeyWithContextFunc(ctx, user)
+	}
+	if stub.DeleteUserAPIKeyFunc != nil {
+		return stub.DeleteUserAPIKeyFunc(user)
+	}
+	panic("UsersStub.DeleteUserAPIKeyWithContext not stubbed")
+}
+
+// GetAPIKey calls GetAPIKeyFunc.
+func (stub *UsersStub) GetAPIKey() (*APIKey, *Response, error) {
+	if stub.GetAPIKeyFunc != nil {
+		return stub.GetAPIKeyFunc()
+	}
+	if stub.GetAPIKeyWithContextFunc != nil {
+		return stub.GetAPIKeyWithContextFunc(context.Background())
+	}
+	panic("UsersStub.GetAPIKey not stubbed")
+}
+
+// GetAPIKeyWithContext calls GetAPIKeyWithContextFunc.
+func (stub *UsersStub) GetAPIKeyWithContext(ctx context.Context) (*APIKey, *Response, error) {
+	if stub.GetAPIKeyWithContextFunc != nil {
+		return stub.GetAPIKeyWithContextFunc(ctx)
+	}
+	if stub.GetAPIKeyFunc != nil {
+		return stub.GetAPIKeyFunc()
+	}
+	panic("UsersStub.GetAPIKeyWithContext not stubbed")
+}
+
+// GetAll calls GetAllFunc.
+func (stub *UsersStub) GetAll() (*[]User, *Response, error) {
+	if stub.GetAllFunc != nil {
+		return stub.GetAllFunc()
+	}
+	if stub.GetAllWithContextFunc != nil {
+		return stub.GetAllWithContextFunc(context.Background())
+	}
+	panic("UsersStub.GetAll not stubbed")
+}
+
+// GetAllSecurity calls GetAllSecurityFunc.
+func (stub *UsersStub) GetAllSecurity() (*[]SecurityUser, *Response, error) {
+	if stub.GetAllSecurityFunc != nil {
+		return stub.GetAllSecurityFunc()
+	}
+	if stub.GetAllSecurityWithContextFunc != nil {
+		return stub.GetAllSecurityWithContextFunc(context.Background())
+	}
+	panic("UsersStub.GetAllSecurity not stubbed")
+}
+
+// GetAllSecurityWithContext calls GetAllSecurityWithContextFunc.
+func (stub *UsersStub) GetAllSecurityWithContext(ctx context.Context) (*[]SecurityUser, *Response, error) {
+	if stub.GetAllSecurityWithContextFunc != nil {
+		return stub.GetAllSecurityWithContextFunc(ctx)
+	}
+	if stub.GetAllSecurityFunc != nil {
+		return stub.GetAllSecurityFunc()
+	}
+	panic("UsersStub.GetAllSecurityWithContext not stubbed")
+}
+
+// GetAllWithContext calls GetAllWithContextFunc.
+func (stub *UsersStub) GetAllWithContext(ctx context.Context) (*[]User, *Response, error) {
+	if stub.GetAllWithContextFunc != nil {
+		return stub.GetAllWithContextFunc(ctx)
+	}
+	if stub.GetAllFunc != nil {
+		return stub.GetAllFunc()
+	}
+	panic("UsersStub.GetAllWithContext not stubbed")
+}
+
+// GetEncryptedPassword calls GetEncryptedPasswordFunc.
+func (stub *UsersStub) GetEncryptedPassword() (*string, *Response, error) {
+	if stub.GetEncryptedPasswordFunc != nil {
+		return stub.GetEncryptedPasswordFunc()
+	}
+	if stub.GetEncryptedPasswordWithContextFunc != nil {
+		return stub.GetEncryptedPasswordWithContextFunc(context.Background())
+	}
+	panic("UsersStub.GetEncryptedPassword not stubbed")
+}
+
+// GetEncryptedPasswordWithContext calls GetEncryptedPasswordWithContextFunc.
+func (stub *UsersStub) GetEncryptedPasswordWithContext(ctx context.Context) (*string, *Response, error) {
+	if stub.GetEncryptedPasswordWithContextFunc != nil {
+		return stub.GetEncryptedPasswordWithContextFunc(ctx)
+	}
+	if stub.GetEncryptedPasswordFunc != nil {
+		return stub.GetEncryptedPasswordFunc()
+	}
+	panic("UsersStub.GetEncryptedPasswordWithContext not stubbed")
+}
+
+// GetSecurity calls GetSecurityFunc.
+func (stub *UsersStub) GetSecurity(user string) (*SecurityUser, *Response, error) {
+	if stub.GetSecurityFunc != nil {
+		return stub.GetSecurityFunc(user)
+	}
+	if stub.GetSecurityWithContextFunc != nil {
+		return stub.GetSecurityWithContextFunc(context.Background(), user)
+	}
+	panic("UsersStub.GetSecurity not stubbed")
+}
+
+// GetSecurityWithContext calls GetSecurityWithContextFunc.
+func (stub *UsersStub) GetSecurityWithContext(ctx context.Context, user string) (*SecurityUser, *Response, error) {
+	if stub.GetSecurityWithContextFunc != nil {
+		return stub.GetSecurityWithContextFunc(ctx, user)
+	}
+	if stub.GetSecurityFunc != nil {
+		return stub.GetSecurityFunc(user)
+	}
+	panic("UsersStub.GetSecurityWithContext not stubbed")
+}
+
+// RegenerateAPIKey calls RegenerateAPIKeyFunc.
+func (stub *UsersStub) RegenerateAPIKey() (*APIKey, *Response, error) {
+	if stub.RegenerateAPIKeyFunc != nil {
+		return stub.RegenerateAPIKeyFunc()
+	}
+	if stub.RegenerateAPIKeyWithContextFunc != nil {
+		return stub.RegenerateAPIKeyWithContextFunc(context.Background())
+	}
+	panic("UsersStub.RegenerateAPIKey not stubbed")
+}
+
+// RegenerateAPIKeyWithContext calls RegenerateAPIKeyWithContextFunc.
+func (stub *UsersStub) RegenerateAPIKeyWithContext(ctx context.Context) (*APIKey, *Response, error) {
+	if stub.RegenerateAPIKeyWithContextFunc != nil {
+		return stub.RegenerateAPIKeyWithContextFunc(ctx)
+	}
+	if stub.RegenerateAPIKeyFunc != nil {
+		return stub.RegenerateAPIKeyFunc()
+	}
+	panic("UsersStub.RegenerateAPIKeyWithContext not stubbed")
+}
+
+// UpdateSecurity calls UpdateSecurityFunc.
+func (stub *UsersStub) UpdateSecurity(user *SecurityUser) (*string, *Response, error) {
+	if stub.UpdateSecurityFunc != nil {
+		return stub.UpdateSecurityFunc(user)
+	}
+	if stub.UpdateSecurityWithContextFunc != nil {
+		return stub.UpdateSecurityWithContextFunc(context.Background(), user)
+	}
+	panic("UsersStub.UpdateSecurity not stubbed")
+}
+
+// UpdateSecurityWithContext calls UpdateSecurityWithContextFunc.
+func (stub *UsersStub) UpdateSecurityWithContext(ctx context.Context, user *SecurityUser) (*string, *Response, error) {
+	if stub.UpdateSecurityWithContextFunc != nil {
+		return stub.UpdateSecurityWithContextFunc(ctx, user)
+	}
+	if stub.UpdateSecurityFunc != nil {
+		return stub.UpdateSecurityFunc(user)
+	}
+	panic("UsersStub.UpdateSecurityWithContext not stubbed")
+}
+
+var (
+	_ UsersAPI = (*UsersService)(nil)
+	_ UsersAPI = (*UsersStub)(nil)
+)
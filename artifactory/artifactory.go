@@ -13,6 +13,7 @@
 // limitations under the License.
 
 //go:generate go run gen-accessors.go
+//go:generate go run gen-services.go
 
 package artifactory
 
@@ -0,0 +1,160 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifactorytest
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// explode deploys the files of the archive deployed at p into its folder,
+// with the properties set with matrix parameters. The archive is exploded
+// all at once: nothing is deployed if one of its files can't be.
+// The caller must hold the lock of the Server.
+func (s *Server) explode(c *gin.Context, r *repository, p string, content []byte, properties map[string][]string) {
+	files, err := unpack(p, content)
+	if err != nil {
+		writeError(c, http.StatusBadRequest, "Failed to explode the archive %s: %v", p, err)
+		return
+	}
+
+	dir := parent(p)
+
+	contents := map[string][]byte{}
+	for name, data := range files {
+		dest := cleanPath(dir + "/" + name)
+
+		if it, ok := r.items[dest]; ok && it.folder {
+			writeError(c, http.StatusConflict, "Can't deploy the file %s over a folder", dest)
+			return
+		}
+
+		for q := parent(dest); len(q) > 0; q = parent(q) {
+			if it, ok := r.items[q]; ok && !it.folder {
+				writeError(c, http.StatusConflict, "Can't deploy %s under the file %s", dest, q)
+				return
+			}
+		}
+
+		contents[dest] = data
+	}
+
+	user := userOf(c)
+
+	for dest, data := range contents {
+		it := newFile(dest, data, user)
+		if existing, ok := r.items[dest]; ok {
+			it.created, it.createdBy = existing.created, existing.createdBy
+		}
+
+		for k, v := range properties {
+			it.properties[k] = v
+		}
+
+		r.put(dest, it, user)
+	}
+
+	if _, ok := r.items[dir]; !ok {
+		r.put(dir, newFolder(user), user)
+	}
+
+	c.JSON(http.StatusCreated, s.folderInfo(r, dir, r.items[dir]))
+}
+
+// unpack returns the content of the regular files of the archive named
+// name by path. The format of the archive is told by its extension.
+func unpack(name string, content []byte) (map[string][]byte, error) {
+	switch {
+	case strings.HasSuffix(name, ".zip"):
+		return unzip(content)
+	case strings.HasSuffix(name, ".tar"):
+		return untar(bytes.NewReader(content))
+	case strings.HasSuffix(name, ".tar.gz"), strings.HasSuffix(name, ".tgz"):
+		gz, err := gzip.NewReader(bytes.NewReader(content))
+		if err != nil {
+			return nil, err
+		}
+
+		return untar(gz)
+	}
+
+	return nil, fmt.Errorf("unsupported archive format")
+}
+
+// unzip returns the content of the regular files of a zip archive by path.
+func unzip(content []byte) (map[string][]byte, error) {
+	zr, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return nil, err
+	}
+
+	files := map[string][]byte{}
+	for _, f := range zr.File {
+		if !f.Mode().IsRegular() {
+			continue
+		}
+
+		rc, err := f.Open()
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := ioutil.ReadAll(rc)
+		_ = rc.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		files[f.Name] = data
+	}
+
+	return files, nil
+}
+
+// untar returns the content of the regular files of a tar archive by path.
+func untar(r io.Reader) (map[string][]byte, error) {
+	tr := tar.NewReader(r)
+
+	files := map[string][]byte{}
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return files, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		data, err := ioutil.ReadAll(tr)
+		if err != nil {
+			return nil, err
+		}
+
+		files[header.Name] = data
+	}
+}
@@ -0,0 +1,405 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifactorytest
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/target/go-arty/v2/artifactory"
+)
+
+// deploy stores the content of the request body as an artifact, with the
+// properties set with matrix parameters. A path ending with a slash creates
+// a folder instead. The X-Checksum-Md5, X-Checksum-Sha1 and X-Checksum-Sha256
+// headers are verified against the content, and an artifact deployed with
+// the X-Checksum-Deploy header reuses a stored binary with the same checksum.
+// An archive deployed with the X-Explode-Archive or X-Explode-Archive-Atomic
+// header is exploded into its folder instead.
+func (s *Server) deploy(c *gin.Context) {
+	p, properties := splitMatrix(rawPath(c))
+	folder := strings.HasSuffix(p, "/")
+	p = cleanPath(p)
+
+	byChecksum := strings.EqualFold(c.GetHeader("X-Checksum-Deploy"), "true")
+
+	// The content is read before locking the Server, since it may be slow to arrive.
+	var content []byte
+	if !folder && !byChecksum {
+		data, err := ioutil.ReadAll(c.Request.Body)
+		if err != nil {
+			writeError(c, http.StatusBadRequest, "Failed to read the content: %v", err)
+			return
+		}
+
+		content = data
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, ok := s.deployRepository(c, c.Param("repo"))
+	if !ok {
+		return
+	}
+
+	for q := parent(p); len(p) > 0; q = parent(q) {
+		if it, ok := r.items[q]; ok && !it.folder {
+			writeError(c, http.StatusConflict, "Can't deploy %s under the file %s", p, q)
+			return
+		}
+
+		if len(q) == 0 {
+			break
+		}
+	}
+
+	if !folder && (strings.EqualFold(c.GetHeader("X-Explode-Archive"), "true") ||
+		strings.EqualFold(c.GetHeader("X-Explode-Archive-Atomic"), "true")) {
+		s.explode(c, r, p, content, properties)
+		return
+	}
+
+	user := userOf(c)
+	existing, exists := r.items[p]
+
+	if folder {
+		if exists && !existing.folder {
+			writeError(c, http.StatusConflict, "Can't create the folder %s over a file", p)
+			return
+		}
+
+		if !exists {
+			existing = newFolder(user)
+			r.put(p, existing, user)
+		}
+
+		for k, v := range properties {
+			existing.properties[k] = v
+		}
+
+		c.JSON(http.StatusCreated, s.fileInfo(r, p, existing))
+		return
+	}
+
+	if exists && existing.folder {
+		writeError(c, http.StatusConflict, "Can't deploy the file %s over a folder", p)
+		return
+	}
+
+	if byChecksum {
+		binary := s.binary(c.GetHeader("X-Checksum-Sha1"), c.GetHeader("X-Checksum-Sha256"))
+		if binary == nil {
+			writeError(c, http.StatusNotFound, "Checksum deploy failed: no binary with the provided checksums is stored")
+			return
+		}
+
+		content = binary.content
+	}
+
+	it := newFile(p, content, user)
+
+	for _, sum := range []struct{ header, actual string }{
+		{"X-Checksum-Md5", it.md5},
+		{"X-Checksum-Sha1", it.sha1},
+		{"X-Checksum-Sha256", it.sha256},
+	} {
+		if expected := c.GetHeader(sum.header); len(expected) > 0 && !strings.EqualFold(expected, sum.actual) {
+			writeError(c, http.StatusConflict, "Checksum policy rejected the artifact: %s %s doesn't match the actual checksum %s",
+				sum.header, expected, sum.actual)
+			return
+		}
+	}
+
+	if exists {
+		it.created, it.createdBy = existing.created, existing.createdBy
+	}
+
+	for k, v := range properties {
+		it.properties[k] = v
+	}
+
+	r.put(p, it, user)
+
+	c.JSON(http.StatusCreated, s.fileInfo(r, p, it))
+}
+
+// binary returns a stored file with the provided SHA-1 or SHA-256 checksum, if any.
+// The caller must hold the lock of the Server.
+func (s *Server) binary(sha1, sha256 string) *item {
+	for _, r := range s.repositories {
+		for _, it := range r.items {
+			if it.folder {
+				continue
+			}
+
+			if (len(sha1) > 0 && strings.EqualFold(sha1, it.sha1)) || (len(sha256) > 0 && strings.EqualFold(sha256, it.sha256)) {
+				return it
+			}
+		}
+	}
+
+	return nil
+}
+
+// download serves the content of an artifact with its checksum headers,
+// supporting range and conditional requests. A folder is served as the
+// list of its children.
+func (s *Server) download(c *gin.Context) {
+	p := cleanPath(c.Param("path"))
+
+	s.mu.Lock()
+
+	r, ok := s.repository(c, c.Param("repo"))
+	if !ok {
+		s.mu.Unlock()
+		return
+	}
+
+	found, it := s.resolve(r, p)
+	if it == nil {
+		s.mu.Unlock()
+		writeError(c, http.StatusNotFound, "Could not find resource %s/%s", r.key(), p)
+		return
+	}
+
+	if it.folder {
+		var listing strings.Builder
+		for _, q := range found.children(p) {
+			name := path.Base(q)
+			if found.items[q].folder {
+				name += "/"
+			}
+
+			fmt.Fprintln(&listing, name)
+		}
+
+		s.mu.Unlock()
+		c.String(http.StatusOK, listing.String())
+		return
+	}
+
+	if c.Request.Method == http.MethodGet {
+		it.downloads++
+		it.lastDownloaded = now()
+		it.lastDownloadedBy = userOf(c)
+	}
+
+	// The content of an item is never modified, so it's served without the lock.
+	content, modified := it.content, it.lastModified
+
+	c.Header("X-Checksum-Md5", it.md5)
+	c.Header("X-Checksum-Sha1", it.sha1)
+	c.Header("X-Checksum-Sha256", it.sha256)
+	c.Header("X-Artifactory-Filename", path.Base(p))
+	c.Header("ETag", "\""+it.sha1+"\"")
+	c.Header("Content-Type", it.mimeType)
+
+	s.mu.Unlock()
+
+	http.ServeContent(c.Writer, c.Request, path.Base(p), modified, bytes.NewReader(content))
+}
+
+// deleteItem removes an artifact, or a folder with all its content.
+func (s *Server) deleteItem(c *gin.Context) {
+	p := cleanPath(c.Param("path"))
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, ok := s.repository(c, c.Param("repo"))
+	if !ok {
+		return
+	}
+
+	if _, ok := r.items[p]; !ok {
+		writeError(c, http.StatusNotFound, "Could not locate artifact '%s:%s'.", r.key(), p)
+		return
+	}
+
+	r.remove(p)
+
+	c.Status(http.StatusNoContent)
+}
+
+// copyItem copies an artifact, or a folder with all its content, to the
+// path set with the to query parameter.
+func (s *Server) copyItem(c *gin.Context) {
+	s.transfer(c, false)
+}
+
+// moveItem moves an artifact, or a folder with all its content, to the
+// path set with the to query parameter.
+func (s *Server) moveItem(c *gin.Context) {
+	s.transfer(c, true)
+}
+
+// transfer copies an item and everything under it to the path set with the
+// to query parameter, removing the source if move is set. An item copied to
+// an existing folder is placed in it. Nothing is changed if the dry query
+// parameter is set to 1. Only the files directly under a folder are copied
+// if the recursive query parameter is set to 0. Items that can't be copied
+// are reported as errors, and the others copied, unless the failFast query
+// parameter is set to 1.
+func (s *Server) transfer(c *gin.Context, move bool) {
+	verb, action, done := "copy", "copying", "copied"
+	if move {
+		verb, action, done = "move", "moving", "moved"
+	}
+
+	params := query(c)
+
+	p := cleanPath(c.Param("path"))
+	targetKey, targetPath, _ := strings.Cut(strings.TrimPrefix(params["to"], "/"), "/")
+	targetPath = cleanPath(targetPath)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, ok := s.repositories[c.Param("repo")]
+	if !ok {
+		writeMessage(c, http.StatusNotFound, "ERROR", "Repository %s does not exist", c.Param("repo"))
+		return
+	}
+
+	if _, ok := r.items[p]; !ok {
+		writeMessage(c, http.StatusNotFound, "ERROR", "Could not find item %s:%s", r.key(), p)
+		return
+	}
+
+	target, ok := s.repositories[targetKey]
+	if !ok || target.rclass() != "local" {
+		writeMessage(c, http.StatusBadRequest, "ERROR", "Target repository %s does not exist or isn't local", targetKey)
+		return
+	}
+
+	if it, ok := target.items[targetPath]; ok && it.folder && len(p) > 0 {
+		targetPath = cleanPath(targetPath + "/" + path.Base(p))
+	}
+
+	if target == r && within(targetPath, p) {
+		writeMessage(c, http.StatusConflict, "ERROR", "Can't %s %s:%s under itself", verb, r.key(), p)
+		return
+	}
+
+	// destination returns the path the provided source item is transferred to.
+	destination := func(q string) string {
+		rel := q
+		if len(p) > 0 {
+			rel = strings.TrimPrefix(q, p)
+		}
+
+		return cleanPath(targetPath + "/" + rel)
+	}
+
+	var (
+		transferred []string
+		messages    []artifactory.ArtifactMessage
+		failed      string
+	)
+
+	files, folders := 0, 0
+	for _, q := range r.tree(p) {
+		switch {
+		case params["recursive"] == "0" && q != p && (parent(q) != p || r.items[q].folder):
+			continue
+		case len(failed) > 0 && within(q, failed):
+			continue
+		}
+
+		dest := destination(q)
+
+		if it, ok := target.items[dest]; ok && it.folder != r.items[q].folder {
+			msg := message("ERROR", "Can't %s %s:%s over %s:%s", verb, r.key(), q, target.key(), dest)
+			if params["failFast"] == "1" {
+				writeMessages(c, http.StatusConflict, msg)
+				return
+			}
+
+			messages = append(messages, msg)
+			failed = q
+
+			continue
+		}
+
+		transferred = append(transferred, q)
+
+		if r.items[q].folder {
+			folders++
+		} else {
+			files++
+		}
+	}
+
+	if params["dry"] != "1" {
+		user := userOf(c)
+
+		for _, q := range transferred {
+			dest := destination(q)
+
+			if it, ok := target.items[dest]; ok && it.folder {
+				continue
+			}
+
+			target.put(dest, r.items[q].clone(user), user)
+		}
+
+		// Folders are only removed once empty, after the items under them.
+		for i := len(transferred) - 1; move && i >= 0; i-- {
+			q := transferred[i]
+			if len(q) > 0 && len(r.tree(q)) == 1 {
+				delete(r.items, q)
+			}
+		}
+	}
+
+	status := http.StatusOK
+	if len(messages) > 0 {
+		status = http.StatusConflict
+	}
+
+	writeMessages(c, status, append(messages, message("INFO", "%s %s:%s to %s:%s completed successfully, %d artifacts and %d folders were %s",
+		action, r.key(), p, target.key(), targetPath, files, folders, done))...)
+}
+
+// message returns a message in the shape returned by the copy and move APIs.
+func message(level, format string, args ...interface{}) artifactory.ArtifactMessage {
+	return artifactory.ArtifactMessage{
+		Level:   artifactory.String(level),
+		Message: artifactory.String(fmt.Sprintf(format, args...)),
+	}
+}
+
+// writeMessage replies with a message in the shape returned by the copy and move APIs.
+func writeMessage(c *gin.Context, status int, level, format string, args ...interface{}) {
+	writeMessages(c, status, message(level, format, args...))
+}
+
+// writeMessages replies with the provided messages of the copy and move APIs.
+func writeMessages(c *gin.Context, status int, messages ...artifactory.ArtifactMessage) {
+	c.JSON(status, artifactory.Artifacts{Messages: &messages})
+}
+
+// rawPath returns the escaped path of the requested item, after the repository key.
+func rawPath(c *gin.Context) string {
+	_, p, _ := strings.Cut(strings.TrimPrefix(c.Request.URL.EscapedPath(), "/"), "/")
+
+	return "/" + p
+}
@@ -0,0 +1,649 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifactorytest
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"testing/fstest"
+
+	"github.com/franela/goblin"
+	"github.com/target/go-arty/v2/artifactory"
+)
+
+// newLocalRepository returns the configuration of a local generic repository.
+func newLocalRepository(key string) *artifactory.LocalRepository {
+	return &artifactory.LocalRepository{
+		GenericRepository: &artifactory.GenericRepository{
+			Key:         artifactory.String(key),
+			RClass:      artifactory.String("local"),
+			PackageType: artifactory.String("generic"),
+		},
+	}
+}
+
+// writeTree writes the provided files, by slash separated path, under dir.
+func writeTree(dir string, files map[string]string) {
+	for name, content := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		_ = os.MkdirAll(filepath.Dir(p), 0755)
+		_ = ioutil.WriteFile(p, []byte(content), 0644)
+	}
+}
+
+// operationRecorder records the operations of the requests sent by a Client.
+type operationRecorder struct {
+	mu         sync.Mutex
+	operations map[string]bool
+}
+
+func (r *operationRecorder) BeforeRequest(ctx context.Context, info *artifactory.RequestInfo) context.Context {
+	return ctx
+}
+
+func (r *operationRecorder) AfterResponse(ctx context.Context, info *artifactory.RequestInfo) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.operations[info.Operation] = true
+}
+
+func Test_Artifacts(t *testing.T) {
+	s := NewServer()
+
+	c, _ := artifactory.NewClient(s.URL, nil)
+	c.Authentication.SetBasicAuth("deployer", "password")
+
+	content := []byte("hello world")
+	sum := sha256.Sum256(content)
+
+	g := goblin.Goblin(t)
+	g.Describe("Artifacts", func() {
+		g.BeforeEach(func() {
+			s.Reset()
+			c.Repositories.Create("libs-local", newLocalRepository("libs-local"))
+			c.Repositories.Create("other-local", newLocalRepository("other-local"))
+		})
+
+		g.After(func() {
+			s.Close()
+		})
+
+		g.It("- should upload and download an artifact", func() {
+			file, _, err := c.Artifacts.UploadReader("libs-local", "org/app/app-1.0.txt", bytes.NewReader(content), -1, nil)
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(file.GetPath()).Equal("/org/app/app-1.0.txt")
+			g.Assert(file.GetSize()).Equal("11")
+			g.Assert(file.GetChecksums().GetSHA256()).Equal(hex.EncodeToString(sum[:]))
+			g.Assert(file.GetCreatedBy()).Equal("deployer")
+
+			actual, _, err := c.Artifacts.Download("libs-local", "org/app/app-1.0.txt")
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(*actual).Equal(content)
+		})
+
+		g.It("- should revalidate an artifact with its ETag", func() {
+			_, _, err := c.Artifacts.UploadReader("libs-local", "org/app/app-1.0.txt", bytes.NewReader(content), -1, nil)
+			g.Assert(err == nil).IsTrue()
+
+			_, resp, err := c.Artifacts.Download("libs-local", "org/app/app-1.0.txt")
+			g.Assert(err == nil).IsTrue()
+
+			req, _ := http.NewRequest(http.MethodGet, s.URL+"/libs-local/org/app/app-1.0.txt", nil)
+			req.SetBasicAuth("deployer", "password")
+			req.Header.Set("If-None-Match", resp.Header.Get("ETag"))
+
+			revalidated, err := http.DefaultClient.Do(req)
+			g.Assert(err == nil).IsTrue()
+			revalidated.Body.Close()
+
+			g.Assert(revalidated.StatusCode).Equal(http.StatusNotModified)
+		})
+
+		g.It("- should upload an artifact from a file", func() {
+			source := filepath.Join(t.TempDir(), "app.txt")
+			_ = ioutil.WriteFile(source, content, 0644)
+
+			_, _, err := c.Artifacts.Upload("libs-local", "app.txt", source, nil)
+			g.Assert(err == nil).IsTrue()
+
+			file, _, err := c.Storage.GetFile("libs-local", "app.txt")
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(file.GetSize()).Equal("11")
+		})
+
+		g.It("- should upload an artifact from a file in strict mode", func() {
+			source := filepath.Join(t.TempDir(), "app.txt")
+			_ = ioutil.WriteFile(source, content, 0644)
+
+			sc, _ := artifactory.NewClient(s.URL, nil, artifactory.WithDecodeMode(artifactory.DecodeStrict))
+			sc.Authentication.SetBasicAuth("deployer", "password")
+
+			created, _, err := sc.Artifacts.Upload("libs-local", "a/b.txt", source, nil)
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(strings.Contains(*created, `"path":"/a/b.txt"`)).IsTrue()
+		})
+
+		g.It("- should set the properties of the matrix parameters", func() {
+			opts := &artifactory.UploadOptions{Properties: map[string][]string{"build.number": {"42"}}}
+			_, _, err := c.Artifacts.UploadReader("libs-local", "app.txt", bytes.NewReader(content), -1, opts)
+			g.Assert(err == nil).IsTrue()
+
+			properties, _, err := c.Storage.GetItemProperties("libs-local", "app.txt")
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert((*properties.Properties)["build.number"]).Equal([]string{"42"})
+		})
+
+		g.It("- should keep special characters in names and properties", func() {
+			name := "lib/my lib;v=1 (copy).jar"
+			properties := map[string][]string{"a;b=c": {"x=y", "1,2", "a b+c/d"}}
+
+			opts := &artifactory.UploadOptions{Properties: properties}
+			file, _, err := c.Artifacts.UploadReader("libs-local", name, bytes.NewReader(content), -1, opts)
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(file.GetPath()).Equal("/" + name)
+
+			actual, _, err := c.Artifacts.Download("libs-local", name)
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(*actual).Equal(content)
+
+			_, err = c.Storage.SetItemProperties("libs-local", name, map[string][]string{`q=1|2`: {`x;y`, `z\`}})
+			g.Assert(err == nil).IsTrue()
+
+			got, _, err := c.Storage.GetItemProperties("libs-local", name)
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert((*got.Properties)["a;b=c"]).Equal(properties["a;b=c"])
+			g.Assert((*got.Properties)[`q=1|2`]).Equal([]string{`x;y`, `z\`})
+
+			_, err = c.Storage.DeleteItemProperties("libs-local", name, []string{"a;b=c"})
+			g.Assert(err == nil).IsTrue()
+
+			got, _, _ = c.Storage.GetItemProperties("libs-local", name)
+			g.Assert(len(*got.Properties)).Equal(1)
+
+			_, _, err = c.Artifacts.Copy("libs-local", name, "other-local", "copies/#1 & more.jar")
+			g.Assert(err == nil).IsTrue()
+
+			_, _, err = c.Storage.GetFile("other-local", "copies/#1 & more.jar")
+			g.Assert(err == nil).IsTrue()
+
+			_, _, err = c.Artifacts.Delete("libs-local", name)
+			g.Assert(err == nil).IsTrue()
+
+			_, _, err = c.Storage.GetFile("libs-local", name)
+			g.Assert(artifactory.IsNotFound(err)).IsTrue()
+		})
+
+		g.It("- should deploy a stored binary by checksum", func() {
+			c.Artifacts.UploadReader("libs-local", "app.txt", bytes.NewReader(content), -1, nil)
+
+			opts := &artifactory.UploadOptions{ChecksumDeploy: true}
+			file, _, err := c.Artifacts.UploadReader("other-local", "copy.txt", bytes.NewReader(content), -1, opts)
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(file.GetChecksums().GetSHA256()).Equal(hex.EncodeToString(sum[:]))
+		})
+
+		g.It("- should download a large artifact in segments", func() {
+			large := bytes.Repeat([]byte("0123456789"), 1000)
+			c.Artifacts.UploadReader("libs-local", "large.bin", bytes.NewReader(large), -1, nil)
+
+			dest := filepath.Join(t.TempDir(), "large.bin")
+			_, _, err := c.Artifacts.DownloadLarge("libs-local", "large.bin", dest, &artifactory.LargeDownloadOptions{SegmentSize: 1024})
+			g.Assert(err == nil).IsTrue()
+
+			actual, _ := ioutil.ReadFile(dest)
+			g.Assert(actual).Equal(large)
+		})
+
+		g.It("- should copy an artifact", func() {
+			c.Artifacts.UploadReader("libs-local", "app.txt", bytes.NewReader(content), -1, nil)
+
+			actual, _, err := c.Artifacts.Copy("libs-local", "app.txt", "other-local", "copies/app.txt")
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(strings.Contains((*actual.Messages)[0].GetMessage(), "1 artifacts and 0 folders were copied")).IsTrue()
+
+			_, _, err = c.Storage.GetFile("libs-local", "app.txt")
+			g.Assert(err == nil).IsTrue()
+
+			_, _, err = c.Storage.GetFile("other-local", "copies/app.txt")
+			g.Assert(err == nil).IsTrue()
+		})
+
+		g.It("- should move a folder into an existing folder", func() {
+			c.Artifacts.UploadReader("libs-local", "org/app/app.txt", bytes.NewReader(content), -1, nil)
+			c.Artifacts.UploadReader("other-local", "archive/readme.txt", bytes.NewReader(content), -1, nil)
+
+			_, _, err := c.Artifacts.Move("libs-local", "org", "other-local", "archive")
+			g.Assert(err == nil).IsTrue()
+
+			_, _, err = c.Storage.GetFolder("libs-local", "org")
+			g.Assert(artifactory.IsNotFound(err)).IsTrue()
+
+			_, _, err = c.Storage.GetFile("other-local", "archive/org/app/app.txt")
+			g.Assert(err == nil).IsTrue()
+		})
+
+		g.It("- should preview a move with a dry run", func() {
+			c.Artifacts.UploadReader("libs-local", "org/app/app.txt", bytes.NewReader(content), -1, nil)
+
+			actual, _, err := c.Artifacts.MoveItem("libs-local", "org", "other-local", "org", &artifactory.TransferOptions{DryRun: true})
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(actual.Result().Artifacts).Equal(1)
+			g.Assert(actual.Result().Folders).Equal(2)
+
+			_, _, err = c.Storage.GetFile("libs-local", "org/app/app.txt")
+			g.Assert(err == nil).IsTrue()
+
+			_, _, err = c.Storage.GetFolder("other-local", "org")
+			g.Assert(artifactory.IsNotFound(err)).IsTrue()
+		})
+
+		g.It("- should copy the other items of a folder when one fails", func() {
+			c.Artifacts.UploadReader("libs-local", "org/a.txt", bytes.NewReader(content), -1, nil)
+			c.Artifacts.UploadReader("libs-local", "org/b.txt", bytes.NewReader(content), -1, nil)
+			c.Artifacts.UploadReader("other-local", "org/a.txt/readme.txt", bytes.NewReader(content), -1, nil)
+
+			actual, _, err := c.Artifacts.CopyItem("libs-local", "org", "other-local", "", nil)
+
+			g.Assert(artifactory.IsConflict(err)).IsTrue()
+
+			result := actual.Result()
+			g.Assert(len(result.Failures)).Equal(1)
+			g.Assert(result.Failures[0].Repo).Equal("libs-local")
+			g.Assert(result.Failures[0].Path).Equal("org/a.txt")
+			g.Assert(result.Artifacts).Equal(1)
+
+			_, _, err = c.Storage.GetFile("other-local", "org/b.txt")
+			g.Assert(err == nil).IsTrue()
+		})
+
+		g.It("- should stop at the first failure with fail fast", func() {
+			c.Artifacts.UploadReader("libs-local", "org/a.txt", bytes.NewReader(content), -1, nil)
+			c.Artifacts.UploadReader("libs-local", "org/b.txt", bytes.NewReader(content), -1, nil)
+			c.Artifacts.UploadReader("other-local", "org/a.txt/readme.txt", bytes.NewReader(content), -1, nil)
+
+			actual, _, err := c.Artifacts.MoveItem("libs-local", "org", "other-local", "", &artifactory.TransferOptions{FailFast: true})
+
+			g.Assert(artifactory.IsConflict(err)).IsTrue()
+			g.Assert(len(actual.Result().Failures)).Equal(1)
+			g.Assert(len(actual.Result().Successes)).Equal(0)
+
+			_, _, err = c.Storage.GetFile("libs-local", "org/b.txt")
+			g.Assert(err == nil).IsTrue()
+
+			_, _, err = c.Storage.GetFile("other-local", "org/b.txt")
+			g.Assert(artifactory.IsNotFound(err)).IsTrue()
+		})
+
+		g.It("- should only move the files directly under a folder when flat", func() {
+			c.Artifacts.UploadReader("libs-local", "org/a.txt", bytes.NewReader(content), -1, nil)
+			c.Artifacts.UploadReader("libs-local", "org/app/b.txt", bytes.NewReader(content), -1, nil)
+
+			actual, _, err := c.Artifacts.MoveItem("libs-local", "org", "other-local", "flat", &artifactory.TransferOptions{Flat: true})
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(actual.Result().Artifacts).Equal(1)
+
+			_, _, err = c.Storage.GetFile("other-local", "flat/a.txt")
+			g.Assert(err == nil).IsTrue()
+
+			_, _, err = c.Storage.GetFile("libs-local", "org/a.txt")
+			g.Assert(artifactory.IsNotFound(err)).IsTrue()
+
+			_, _, err = c.Storage.GetFile("libs-local", "org/app/b.txt")
+			g.Assert(err == nil).IsTrue()
+		})
+
+		g.It("- should delete a folder with its content", func() {
+			c.Artifacts.UploadReader("libs-local", "org/app/app.txt", bytes.NewReader(content), -1, nil)
+
+			_, _, err := c.Artifacts.Delete("libs-local", "org")
+			g.Assert(err == nil).IsTrue()
+
+			_, _, err = c.Artifacts.Download("libs-local", "org/app/app.txt")
+			g.Assert(artifactory.IsNotFound(err)).IsTrue()
+		})
+
+		g.It("- should resolve an artifact through a virtual repository", func() {
+			c.Repositories.Create("libs", &artifactory.VirtualRepository{
+				GenericRepository: &artifactory.GenericRepository{
+					Key:    artifactory.String("libs"),
+					RClass: artifactory.String("virtual"),
+				},
+				Repositories: &[]string{"other-local", "libs-local"},
+			})
+			c.Artifacts.UploadReader("libs-local", "app.txt", bytes.NewReader(content), -1, nil)
+
+			actual, _, err := c.Artifacts.Download("libs", "app.txt")
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(*actual).Equal(content)
+		})
+
+		g.It("- should reject content not matching its checksums", func() {
+			req, _ := c.NewRequest("PUT", "/libs-local/app.txt", bytes.NewReader(content))
+			req.Header.Set("X-Checksum-Sha1", "0000000000000000000000000000000000000000")
+
+			_, err := c.Do(req, nil)
+
+			g.Assert(artifactory.IsConflict(err)).IsTrue()
+		})
+
+		g.It("- should upload the selected files of a directory tree", func() {
+			dir := t.TempDir()
+			writeTree(dir, map[string]string{
+				"org/app.jar":      "jar",
+				"org/app.pom":      "pom",
+				"org/test/app.jar": "test jar",
+				"readme.txt":       "readme",
+			})
+
+			opts := &artifactory.UploadDirOptions{
+				Include:    []string{"*.jar", "*.pom"},
+				Exclude:    []string{"**/test/**"},
+				Properties: map[string][]string{"build.number": {"42"}},
+				Workers:    2,
+			}
+			report, err := c.Artifacts.UploadDir(dir, "libs-local", "/dist/", opts)
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(len(report.Files)).Equal(2)
+			g.Assert(report.Files[0].Path).Equal("org/app.jar")
+			g.Assert(report.Files[0].Size).Equal(int64(3))
+			g.Assert(report.Files[0].Skipped).IsFalse()
+
+			actual, _, err := c.Artifacts.Download("libs-local", "dist/org/app.pom")
+			g.Assert(err == nil).IsTrue()
+			g.Assert(string(*actual)).Equal("pom")
+
+			properties, _, _ := c.Storage.GetItemProperties("libs-local", "dist/org/app.jar")
+			g.Assert((*properties.Properties)["build.number"]).Equal([]string{"42"})
+
+			_, _, err = c.Storage.GetFile("libs-local", "dist/readme.txt")
+			g.Assert(artifactory.IsNotFound(err)).IsTrue()
+		})
+
+		g.It("- should skip the files already uploaded", func() {
+			dir := t.TempDir()
+			writeTree(dir, map[string]string{"a.txt": "a", "b/b.txt": "b"})
+
+			c.Artifacts.UploadDir(dir, "libs-local", "dist", nil)
+			writeTree(dir, map[string]string{"a.txt": "changed"})
+
+			report, err := c.Artifacts.UploadDir(dir, "libs-local", "dist", nil)
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(report.Files[0].Skipped).IsFalse()
+			g.Assert(report.Files[1].Skipped).IsTrue()
+
+			actual, _, _ := c.Artifacts.Download("libs-local", "dist/a.txt")
+			g.Assert(string(*actual)).Equal("changed")
+		})
+
+		g.It("- should report the files failing to upload", func() {
+			dir := t.TempDir()
+			writeTree(dir, map[string]string{"a.txt": "a", "b.txt": "b"})
+
+			report, err := c.Artifacts.UploadDir(dir, "missing-local", "", nil)
+
+			g.Assert(err != nil).IsTrue()
+			g.Assert(len(report.Failed())).Equal(2)
+			g.Assert(artifactory.IsNotFound(report.Files[0].Err)).IsTrue()
+		})
+
+		g.It("- should upload a directory tree as an exploded archive", func() {
+			dir := t.TempDir()
+			writeTree(dir, map[string]string{
+				"org/app.jar":      "jar",
+				"org/app.pom":      "pom",
+				"org/test/app.jar": "test jar",
+			})
+
+			opts := &artifactory.ArchiveOptions{
+				Exclude:    []string{"**/test/**"},
+				Properties: map[string][]string{"build.number": {"42"}},
+			}
+			_, _, err := c.Artifacts.UploadArchive("libs-local", "dist", os.DirFS(dir), opts)
+
+			g.Assert(err == nil).IsTrue()
+
+			actual, _, err := c.Artifacts.Download("libs-local", "dist/org/app.pom")
+			g.Assert(err == nil).IsTrue()
+			g.Assert(string(*actual)).Equal("pom")
+
+			properties, _, _ := c.Storage.GetItemProperties("libs-local", "dist/org/app.jar")
+			g.Assert((*properties.Properties)["build.number"]).Equal([]string{"42"})
+
+			_, _, err = c.Storage.GetFile("libs-local", "dist/org/test/app.jar")
+			g.Assert(artifactory.IsNotFound(err)).IsTrue()
+
+			_, _, err = c.Storage.GetFile("libs-local", "dist/archive.zip")
+			g.Assert(artifactory.IsNotFound(err)).IsTrue()
+		})
+
+		g.It("- should explode a tar.gz archive atomically", func() {
+			c.Artifacts.UploadReader("libs-local", "dist/b.txt/c.txt", strings.NewReader("c"), -1, nil)
+
+			fsys := fstest.MapFS{
+				"a.txt": {Data: []byte("a")},
+				"b.txt": {Data: []byte("b")},
+			}
+			opts := &artifactory.ArchiveOptions{Format: artifactory.ArchiveTarGz, Atomic: true}
+
+			_, _, err := c.Artifacts.UploadArchive("libs-local", "dist", fsys, opts)
+
+			g.Assert(artifactory.IsConflict(err)).IsTrue()
+
+			_, _, err = c.Storage.GetFile("libs-local", "dist/a.txt")
+			g.Assert(artifactory.IsNotFound(err)).IsTrue()
+
+			_, _, err = c.Artifacts.UploadArchive("libs-local", "other", fsys, opts)
+			g.Assert(err == nil).IsTrue()
+
+			actual, _, _ := c.Artifacts.Download("libs-local", "other/b.txt")
+			g.Assert(string(*actual)).Equal("b")
+		})
+
+		g.It("- should describe the operation of each request of a directory tree", func() {
+			dir := t.TempDir()
+			writeTree(dir, map[string]string{"a.txt": "a", "b/b.txt": "b"})
+
+			recorder := &operationRecorder{operations: map[string]bool{}}
+			rc, _ := artifactory.NewClient(s.URL, nil, artifactory.WithMiddleware(recorder))
+			rc.Authentication.SetBasicAuth("deployer", "password")
+
+			_, err := rc.Artifacts.UploadDir(dir, "libs-local", "dist", nil)
+			g.Assert(err == nil).IsTrue()
+
+			_, err = rc.Artifacts.DownloadDir("libs-local", "dist", t.TempDir(), nil)
+			g.Assert(err == nil).IsTrue()
+
+			g.Assert(recorder.operations["Storage.GetFileList"]).IsTrue()
+			g.Assert(recorder.operations["Artifacts.UploadReader"]).IsTrue()
+			g.Assert(recorder.operations["Artifacts.DownloadTo"]).IsTrue()
+			g.Assert(recorder.operations["Artifacts.UploadDir"]).IsFalse()
+			g.Assert(recorder.operations["Artifacts.DownloadDir"]).IsFalse()
+		})
+
+		g.It("- should download the selected files of a directory tree", func() {
+			c.Artifacts.UploadReader("libs-local", "dist/org/app.jar", strings.NewReader("jar"), -1, nil)
+			c.Artifacts.UploadReader("libs-local", "dist/org/app.pom", strings.NewReader("pom"), -1, nil)
+			c.Artifacts.UploadReader("libs-local", "other/app.jar", strings.NewReader("other"), -1, nil)
+
+			dir := t.TempDir()
+			opts := &artifactory.DownloadDirOptions{Include: []string{"*.jar"}}
+			report, err := c.Artifacts.DownloadDir("libs-local", "dist", dir, opts)
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(len(report.Files)).Equal(1)
+			g.Assert(report.Files[0].Path).Equal("org/app.jar")
+
+			actual, _ := ioutil.ReadFile(filepath.Join(dir, "org", "app.jar"))
+			g.Assert(string(actual)).Equal("jar")
+
+			_, err = os.Stat(filepath.Join(dir, "org", "app.pom"))
+			g.Assert(os.IsNotExist(err)).IsTrue()
+		})
+
+		g.It("- should skip the files already downloaded", func() {
+			c.Artifacts.UploadReader("libs-local", "dist/a.txt", strings.NewReader("a"), -1, nil)
+			c.Artifacts.UploadReader("libs-local", "dist/b.txt", strings.NewReader("b"), -1, nil)
+
+			dir := t.TempDir()
+			writeTree(dir, map[string]string{"a.txt": "a", "b.txt": "changed"})
+
+			report, err := c.Artifacts.DownloadDir("libs-local", "dist", dir, nil)
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(report.Files[0].Skipped).IsTrue()
+			g.Assert(report.Files[1].Skipped).IsFalse()
+
+			actual, _ := ioutil.ReadFile(filepath.Join(dir, "b.txt"))
+			g.Assert(string(actual)).Equal("b")
+
+			entries, _ := os.ReadDir(dir)
+			g.Assert(len(entries)).Equal(2)
+		})
+
+		g.It("- should sync a directory tree to a repository", func() {
+			dir := t.TempDir()
+			writeTree(dir, map[string]string{"index.html": "index", "css/site.css": "css", "old.html": "old"})
+
+			plan, err := c.Artifacts.Sync(dir, "libs-local", "www", nil)
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(len(plan.Added)).Equal(3)
+			g.Assert(plan.Added[0].Path).Equal("css/site.css")
+
+			writeTree(dir, map[string]string{"index.html": "changed"})
+			_ = os.Remove(filepath.Join(dir, "old.html"))
+
+			plan, err = c.Artifacts.PlanSync(dir, "libs-local", "www", nil)
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(len(plan.Added)).Equal(0)
+			g.Assert(plan.Changed[0].Path).Equal("index.html")
+			g.Assert(plan.Deleted[0].Path).Equal("old.html")
+			g.Assert(plan.Unchanged).Equal(1)
+
+			err = c.Artifacts.ApplySync(plan, nil)
+			g.Assert(err == nil).IsTrue()
+
+			actual, _, _ := c.Artifacts.Download("libs-local", "www/index.html")
+			g.Assert(string(*actual)).Equal("changed")
+
+			_, _, err = c.Storage.GetFile("libs-local", "www/old.html")
+			g.Assert(artifactory.IsNotFound(err)).IsTrue()
+
+			plan, err = c.Artifacts.PlanSync(dir, "libs-local", "www", nil)
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(plan.Empty()).IsTrue()
+			g.Assert(plan.Unchanged).Equal(2)
+		})
+
+		g.It("- should never delete remote files with no delete", func() {
+			c.Artifacts.UploadReader("libs-local", "www/keep.html", strings.NewReader("keep"), -1, nil)
+
+			dir := t.TempDir()
+			writeTree(dir, map[string]string{"index.html": "index"})
+
+			plan, err := c.Artifacts.Sync(dir, "libs-local", "www", &artifactory.SyncOptions{NoDelete: true})
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(len(plan.Added)).Equal(1)
+			g.Assert(len(plan.Deleted)).Equal(0)
+
+			_, _, err = c.Storage.GetFile("libs-local", "www/keep.html")
+			g.Assert(err == nil).IsTrue()
+
+			plan, _ = c.Artifacts.PlanSync(dir, "libs-local", "www", nil)
+			g.Assert(plan.Deleted[0].Path).Equal("keep.html")
+
+			err = c.Artifacts.ApplySync(plan, &artifactory.SyncOptions{NoDelete: true})
+			g.Assert(err == nil).IsTrue()
+
+			_, _, err = c.Storage.GetFile("libs-local", "www/keep.html")
+			g.Assert(err == nil).IsTrue()
+		})
+
+		g.It("- should only plan a dry run", func() {
+			dir := t.TempDir()
+			writeTree(dir, map[string]string{"index.html": "index"})
+
+			plan, err := c.Artifacts.Sync(dir, "libs-local", "www", &artifactory.SyncOptions{DryRun: true})
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(len(plan.Added)).Equal(1)
+			g.Assert(strings.HasPrefix(plan.String(), "sync upload to libs-local/www\n+ index.html")).IsTrue()
+
+			_, _, err = c.Storage.GetFolder("libs-local", "www")
+			g.Assert(artifactory.IsNotFound(err)).IsTrue()
+		})
+
+		g.It("- should sync a repository to a directory tree", func() {
+			c.Artifacts.UploadReader("libs-local", "tools/bin/tool", strings.NewReader("tool"), -1, nil)
+			c.Artifacts.UploadReader("libs-local", "tools/README", strings.NewReader("readme"), -1, nil)
+
+			dir := t.TempDir()
+			writeTree(dir, map[string]string{"README": "outdated", "stale": "stale"})
+
+			plan, err := c.Artifacts.Sync(dir, "libs-local", "tools", &artifactory.SyncOptions{Direction: artifactory.SyncDownload})
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(plan.Added[0].Path).Equal("bin/tool")
+			g.Assert(plan.Changed[0].Path).Equal("README")
+			g.Assert(plan.Deleted[0].Path).Equal("stale")
+
+			actual, _ := ioutil.ReadFile(filepath.Join(dir, "bin", "tool"))
+			g.Assert(string(actual)).Equal("tool")
+
+			actual, _ = ioutil.ReadFile(filepath.Join(dir, "README"))
+			g.Assert(string(actual)).Equal("readme")
+
+			_, err = os.Stat(filepath.Join(dir, "stale"))
+			g.Assert(os.IsNotExist(err)).IsTrue()
+
+			plan, _ = c.Artifacts.PlanSync(dir, "libs-local", "tools", &artifactory.SyncOptions{Direction: artifactory.SyncDownload})
+			g.Assert(plan.Empty()).IsTrue()
+		})
+
+		g.It("- should return an error for a missing repository", func() {
+			_, _, err := c.Artifacts.UploadReader("missing-local", "app.txt", bytes.NewReader(content), -1, nil)
+
+			g.Assert(artifactory.IsNotFound(err)).IsTrue()
+		})
+	})
+}
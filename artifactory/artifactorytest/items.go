@@ -0,0 +1,239 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifactorytest
+
+import (
+	"crypto/md5"  //nolint:gosec // Artifactory identifies binaries by MD5
+	"crypto/sha1" //nolint:gosec // Artifactory identifies binaries by SHA-1
+	"crypto/sha256"
+	"encoding/hex"
+	"mime"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+// repository is a repository stored by the Server, along with its items.
+type repository struct {
+	config object
+
+	// items holds the files and folders of the repository by path,
+	// the root folder being stored with an empty path.
+	items map[string]*item
+}
+
+// newRepository returns a repository with the provided configuration.
+func newRepository(config object, user string) *repository {
+	return &repository{
+		config: config,
+		items:  map[string]*item{"": newFolder(user)},
+	}
+}
+
+// key returns the key of the repository.
+func (r *repository) key() string {
+	return r.config.str("key")
+}
+
+// rclass returns the class of the repository, like local or virtual.
+func (r *repository) rclass() string {
+	return r.config.str("rclass")
+}
+
+// item is a file or a folder stored in a repository.
+type item struct {
+	folder  bool
+	content []byte
+
+	md5, sha1, sha256 string
+	mimeType          string
+	properties        map[string][]string
+
+	created, lastModified time.Time
+	createdBy, modifiedBy string
+
+	downloads        int
+	lastDownloaded   time.Time
+	lastDownloadedBy string
+}
+
+// newFolder returns a folder created by the provided user.
+func newFolder(user string) *item {
+	t := now()
+
+	return &item{
+		folder:       true,
+		properties:   map[string][]string{},
+		created:      t,
+		lastModified: t,
+		createdBy:    user,
+		modifiedBy:   user,
+	}
+}
+
+// newFile returns a file with the provided content, deployed by the provided user.
+func newFile(p string, content []byte, user string) *item {
+	it := newFolder(user)
+	it.folder = false
+	it.content = content
+
+	md5sum := md5.Sum(content)   //nolint:gosec
+	sha1sum := sha1.Sum(content) //nolint:gosec
+	sha256sum := sha256.Sum256(content)
+
+	it.md5 = hex.EncodeToString(md5sum[:])
+	it.sha1 = hex.EncodeToString(sha1sum[:])
+	it.sha256 = hex.EncodeToString(sha256sum[:])
+
+	it.mimeType = mime.TypeByExtension(path.Ext(p))
+	if len(it.mimeType) == 0 {
+		it.mimeType = "application/octet-stream"
+	}
+
+	return it
+}
+
+// clone returns a copy of the item, created by the provided user.
+func (it *item) clone(user string) *item {
+	c := *it
+
+	c.properties = map[string][]string{}
+	for k, v := range it.properties {
+		c.properties[k] = append([]string(nil), v...)
+	}
+
+	c.created = now()
+	c.lastModified = c.created
+	c.createdBy = user
+	c.modifiedBy = user
+	c.downloads = 0
+	c.lastDownloaded = time.Time{}
+	c.lastDownloadedBy = ""
+
+	return &c
+}
+
+// put stores the provided item, creating its missing parent folders.
+func (r *repository) put(p string, it *item, user string) {
+	for q := parent(p); ; q = parent(q) {
+		if _, ok := r.items[q]; !ok {
+			r.items[q] = newFolder(user)
+		}
+
+		if len(q) == 0 {
+			break
+		}
+	}
+
+	r.items[p] = it
+}
+
+// tree returns the path of the provided item and of all items under it, in order.
+func (r *repository) tree(p string) []string {
+	var paths []string
+	for q := range r.items {
+		if within(q, p) {
+			paths = append(paths, q)
+		}
+	}
+
+	sort.Strings(paths)
+
+	return paths
+}
+
+// children returns the paths of the items directly under the provided folder, in order.
+func (r *repository) children(p string) []string {
+	var paths []string
+	for q := range r.items {
+		if len(q) > 0 && q != p && parent(q) == p {
+			paths = append(paths, q)
+		}
+	}
+
+	sort.Strings(paths)
+
+	return paths
+}
+
+// remove deletes the provided item and all items under it.
+// The root folder is emptied, but kept.
+func (r *repository) remove(p string) {
+	for _, q := range r.tree(p) {
+		if len(q) > 0 {
+			delete(r.items, q)
+		}
+	}
+}
+
+// cleanPath returns the provided item path, without leading and trailing slashes.
+func cleanPath(p string) string {
+	return strings.TrimPrefix(path.Clean("/"+p), "/")
+}
+
+// parent returns the path of the folder holding the provided item.
+func parent(p string) string {
+	i := strings.LastIndex(p, "/")
+	if i < 0 {
+		return ""
+	}
+
+	return p[:i]
+}
+
+// within reports whether the item q is p or under it.
+func within(q, p string) bool {
+	return len(p) == 0 || q == p || strings.HasPrefix(q, p+"/")
+}
+
+// splitMatrix splits the provided escaped request path into the item path
+// and the properties set with matrix parameters.
+func splitMatrix(p string) (string, map[string][]string) {
+	segments := strings.Split(p, ";")
+
+	properties := map[string][]string{}
+	for _, param := range segments[1:] {
+		k, v, _ := strings.Cut(param, "=")
+		if len(k) == 0 {
+			continue
+		}
+
+		properties[unescape(k)] = splitValues(v)
+	}
+
+	return unescape(segments[0]), properties
+}
+
+// splitValues splits the provided comma separated escaped property values.
+func splitValues(v string) []string {
+	var values []string
+	for _, value := range strings.Split(v, ",") {
+		values = append(values, unescape(value))
+	}
+
+	return values
+}
+
+// unescape returns the provided path escaped value unescaped, or as is if it isn't valid.
+func unescape(v string) string {
+	s, err := url.PathUnescape(v)
+	if err != nil {
+		return v
+	}
+
+	return s
+}
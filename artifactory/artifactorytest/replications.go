@@ -0,0 +1,246 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifactorytest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// getReplications lists the replications of all repositories,
+// with their replication type.
+func (s *Server) getReplications(c *gin.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	replications := []object{}
+	for _, key := range sortedKeys(s.replications) {
+		typ := "PUSH"
+		if s.repositories[key].rclass() == "remote" {
+			typ = "PULL"
+		}
+
+		for _, replication := range s.replications[key] {
+			v := replication.copy()
+			v["replicationType"] = typ
+
+			replications = append(replications, v)
+		}
+	}
+
+	c.JSON(http.StatusOK, replications)
+}
+
+// getReplication returns the replications of a repository, as a single
+// object for a pull replication, or a list for push replications.
+func (s *Server) getReplication(c *gin.Context) {
+	key := c.Param("repo")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	replications, ok := s.replications[key]
+	if !ok {
+		writeError(c, http.StatusNotFound, "Could not find replication")
+		return
+	}
+
+	if s.repositories[key].rclass() == "remote" {
+		c.JSON(http.StatusOK, replications[0])
+		return
+	}
+
+	c.JSON(http.StatusOK, replications)
+}
+
+// createReplication creates or replaces the replication of a repository
+// with the details of the request body.
+func (s *Server) createReplication(c *gin.Context) {
+	replication, ok := bind(c)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, ok := s.replicated(c)
+	if !ok {
+		return
+	}
+
+	replication["repoKey"] = r.key()
+	s.replications[r.key()] = []object{replication}
+
+	c.Status(http.StatusCreated)
+}
+
+// updateReplication sets the fields of the request body on the replication of a repository.
+func (s *Server) updateReplication(c *gin.Context) {
+	update, ok := bind(c)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, ok := s.replicated(c)
+	if !ok {
+		return
+	}
+
+	replications, ok := s.replications[r.key()]
+	if !ok {
+		writeError(c, http.StatusNotFound, "Could not find replication")
+		return
+	}
+
+	delete(update, "repoKey")
+	replications[0].merge(update)
+
+	c.Status(http.StatusOK)
+}
+
+// deleteReplication removes the replications of a repository, or only
+// the one to the URL set with the url query parameter.
+func (s *Server) deleteReplication(c *gin.Context) {
+	key := c.Param("repo")
+	u := query(c)["url"]
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	replications, ok := s.replications[key]
+	if !ok {
+		writeError(c, http.StatusNotFound, "Could not find replication")
+		return
+	}
+
+	if len(u) == 0 {
+		delete(s.replications, key)
+
+		c.JSON(http.StatusOK, fmt.Sprintf("Replications of repository %s have been removed successfully.", key))
+		return
+	}
+
+	kept := []object{}
+	for _, replication := range replications {
+		if replication.str("url") != u {
+			kept = append(kept, replication)
+		}
+	}
+
+	if len(kept) == len(replications) {
+		writeError(c, http.StatusNotFound, "Could not find replication to %s", u)
+		return
+	}
+
+	s.replications[key] = kept
+	if len(kept) == 0 {
+		delete(s.replications, key)
+	}
+
+	c.JSON(http.StatusOK, fmt.Sprintf("Replication of repository %s to %s has been removed successfully.", key, u))
+}
+
+// createMultiPushReplication creates or replaces the push replications of a
+// local repository with the details of the request body.
+func (s *Server) createMultiPushReplication(c *gin.Context) {
+	s.multiPushReplication(c, false)
+}
+
+// updateMultiPushReplication updates the push replications of a local
+// repository with the details of the request body, replacing those with
+// the same URL.
+func (s *Server) updateMultiPushReplication(c *gin.Context) {
+	s.multiPushReplication(c, true)
+}
+
+// multiPushReplication stores the push replications of the request body,
+// replacing the existing ones unless update is set. The cron expression and
+// event replication of the request apply to each of them.
+func (s *Server) multiPushReplication(c *gin.Context, update bool) {
+	body, ok := bind(c)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, ok := s.replicated(c)
+	if !ok {
+		return
+	}
+
+	if r.rclass() != "local" {
+		writeError(c, http.StatusBadRequest, "Multi-push replication is only supported for local repositories")
+		return
+	}
+
+	var replications []object
+	if update {
+		replications = s.replications[r.key()]
+	}
+
+	list, _ := body["replications"].([]interface{})
+	for _, e := range list {
+		fields, _ := e.(map[string]interface{})
+		replication := object(fields)
+
+		replication["repoKey"] = r.key()
+		for _, k := range []string{"cronExp", "enableEventReplication"} {
+			if v, ok := body[k]; ok {
+				replication[k] = v
+			}
+		}
+
+		replaced := false
+		for i, existing := range replications {
+			if existing.str("url") == replication.str("url") {
+				replications[i] = replication
+				replaced = true
+			}
+		}
+
+		if !replaced {
+			replications = append(replications, replication)
+		}
+	}
+
+	s.replications[r.key()] = replications
+
+	c.Status(http.StatusCreated)
+}
+
+// replicated returns the repository whose replications are requested.
+// It replies with an error and returns false if it can't be replicated.
+// The caller must hold the lock of the Server.
+func (s *Server) replicated(c *gin.Context) (*repository, bool) {
+	r, ok := s.repository(c, c.Param("repo"))
+	if !ok {
+		return nil, false
+	}
+
+	if r.rclass() == "virtual" {
+		writeError(c, http.StatusBadRequest, "Virtual repository %s can't be replicated", r.key())
+		return nil, false
+	}
+
+	return r, true
+}
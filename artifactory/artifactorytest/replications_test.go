@@ -0,0 +1,91 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifactorytest
+
+import (
+	"testing"
+
+	"github.com/franela/goblin"
+	"github.com/target/go-arty/v2/artifactory"
+)
+
+func Test_Replications(t *testing.T) {
+	s := NewServer()
+
+	c, _ := artifactory.NewClient(s.URL, nil)
+
+	g := goblin.Goblin(t)
+	g.Describe("Replications", func() {
+		g.BeforeEach(func() {
+			s.Reset()
+			c.Repositories.Create("libs-local", newLocalRepository("libs-local"))
+		})
+
+		g.After(func() {
+			s.Close()
+		})
+
+		g.It("- should create and update a replication", func() {
+			_, _, err := c.Replications.Create("libs-local", &artifactory.Replication{
+				Url:     artifactory.String("https://dr.example.com/artifactory/libs-local"),
+				CronExp: artifactory.String("0 0 * * * ?"),
+			})
+			g.Assert(err == nil).IsTrue()
+
+			_, _, err = c.Replications.Update("libs-local", &artifactory.Replication{
+				Enabled: artifactory.Bool(true),
+			})
+			g.Assert(err == nil).IsTrue()
+
+			replications, _, err := c.Replications.Get("libs-local")
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(len(*replications)).Equal(1)
+			g.Assert((*replications)[0].GetRepoKey()).Equal("libs-local")
+			g.Assert((*replications)[0].GetEnabled()).IsTrue()
+
+			all, _, _ := c.Replications.GetAll()
+			g.Assert((*all)[0].GetReplicationType()).Equal("PUSH")
+		})
+
+		g.It("- should create and delete multi-push replications", func() {
+			_, _, err := c.Replications.CreateMultiPush("libs-local", &artifactory.MultiPushReplication{
+				CronExp: artifactory.String("0 0 * * * ?"),
+				Replications: &[]artifactory.Replication{
+					{Url: artifactory.String("https://us.example.com/artifactory/libs-local")},
+					{Url: artifactory.String("https://eu.example.com/artifactory/libs-local")},
+				},
+			})
+			g.Assert(err == nil).IsTrue()
+
+			_, _, err = c.Replications.DeleteMultiPush("libs-local", "https://us.example.com/artifactory/libs-local")
+			g.Assert(err == nil).IsTrue()
+
+			replications, _, _ := c.Replications.Get("libs-local")
+
+			g.Assert(len(*replications)).Equal(1)
+			g.Assert((*replications)[0].GetCronExp()).Equal("0 0 * * * ?")
+		})
+
+		g.It("- should remove the replications of a deleted repository", func() {
+			c.Replications.Create("libs-local", &artifactory.Replication{Url: artifactory.String("https://dr.example.com")})
+			c.Repositories.Delete("libs-local")
+
+			_, _, err := c.Replications.Get("libs-local")
+
+			g.Assert(artifactory.IsNotFound(err)).IsTrue()
+		})
+	})
+}
@@ -0,0 +1,241 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifactorytest
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/target/go-arty/v2/artifactory"
+)
+
+// getRepositories lists the repositories, optionally of the type and package type
+// set with the type and packageType query parameters.
+func (s *Server) getRepositories(c *gin.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	params := query(c)
+
+	repos := []artifactory.Repository{}
+	for _, key := range sortedKeys(s.repositories) {
+		r := s.repositories[key]
+
+		if t := params["type"]; len(t) > 0 && !strings.EqualFold(t, r.rclass()) {
+			continue
+		}
+
+		if t := params["packageType"]; len(t) > 0 && !strings.EqualFold(t, r.config.str("packageType")) {
+			continue
+		}
+
+		u := fmt.Sprintf("%s/%s", s.URL, key)
+		if r.rclass() == "remote" {
+			u = r.config.str("url")
+		}
+
+		repo := artifactory.Repository{
+			Key:         artifactory.String(key),
+			Type:        artifactory.String(strings.ToUpper(r.rclass())),
+			URL:         artifactory.String(u),
+			PackageType: artifactory.String(r.config.str("packageType")),
+		}
+
+		if d := r.config.str("description"); len(d) > 0 {
+			repo.Description = artifactory.String(d)
+		}
+
+		repos = append(repos, repo)
+	}
+
+	c.JSON(http.StatusOK, repos)
+}
+
+// getRepository returns the configuration of a repository.
+func (s *Server) getRepository(c *gin.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, ok := s.repository(c, c.Param("repo"))
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, r.config)
+}
+
+// createRepository creates a repository with the configuration of the request body.
+// The repository class defaults to local, and the package type to generic.
+func (s *Server) createRepository(c *gin.Context) {
+	key := c.Param("repo")
+
+	config, ok := bind(c)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if k := config.str("key"); len(k) > 0 && k != key {
+		writeError(c, http.StatusBadRequest, "Repository key %s doesn't match the key %s of the path", k, key)
+		return
+	}
+
+	for k := range s.repositories {
+		if strings.EqualFold(k, key) {
+			writeError(c, http.StatusBadRequest, "Case insensitive repository key already exists")
+			return
+		}
+	}
+
+	config["key"] = key
+	if len(config.str("rclass")) == 0 {
+		config["rclass"] = "local"
+	}
+	if len(config.str("packageType")) == 0 {
+		config["packageType"] = "generic"
+	}
+
+	switch config.str("rclass") {
+	case "local", "remote", "virtual":
+	default:
+		writeError(c, http.StatusBadRequest, "Invalid repository class %s", config.str("rclass"))
+		return
+	}
+
+	s.repositories[key] = newRepository(config, userOf(c))
+
+	c.JSON(http.StatusOK, fmt.Sprintf("Successfully created repository '%s'", key))
+}
+
+// updateRepository sets the fields of the request body on the configuration of a repository.
+func (s *Server) updateRepository(c *gin.Context) {
+	config, ok := bind(c)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, ok := s.repository(c, c.Param("repo"))
+	if !ok {
+		return
+	}
+
+	if rclass := config.str("rclass"); len(rclass) > 0 && rclass != r.rclass() {
+		writeError(c, http.StatusBadRequest, "Repository class %s can't be changed to %s", r.rclass(), rclass)
+		return
+	}
+
+	delete(config, "key")
+	r.config.merge(config)
+
+	c.JSON(http.StatusOK, fmt.Sprintf("Repository %s update successful.", r.key()))
+}
+
+// deleteRepository removes a repository, along with its content and replications.
+func (s *Server) deleteRepository(c *gin.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, ok := s.repository(c, c.Param("repo"))
+	if !ok {
+		return
+	}
+
+	delete(s.repositories, r.key())
+	delete(s.replications, r.key())
+
+	c.JSON(http.StatusOK, fmt.Sprintf("Repository %s and all its content have been removed successfully.", r.key()))
+}
+
+// repository returns the repository with the provided key.
+// It replies 404 Not Found and returns false if there is none.
+// The caller must hold the lock of the Server.
+func (s *Server) repository(c *gin.Context, key string) (*repository, bool) {
+	r, ok := s.repositories[key]
+	if !ok {
+		writeError(c, http.StatusNotFound, "Repository %s does not exist", key)
+	}
+
+	return r, ok
+}
+
+// deployRepository returns the local repository the items deployed to the
+// provided repository are stored in, which is the default deployment
+// repository of a virtual repository.
+// It replies with an error and returns false if there is none.
+// The caller must hold the lock of the Server.
+func (s *Server) deployRepository(c *gin.Context, key string) (*repository, bool) {
+	r, ok := s.repository(c, key)
+	if !ok {
+		return nil, false
+	}
+
+	if r.rclass() == "virtual" {
+		target := r.config.str("defaultDeploymentRepo")
+		if len(target) == 0 {
+			writeError(c, http.StatusMethodNotAllowed, "Virtual repository %s has no default deployment repository", key)
+			return nil, false
+		}
+
+		return s.deployRepository(c, target)
+	}
+
+	if r.rclass() != "local" {
+		writeError(c, http.StatusBadRequest, "Repository %s doesn't accept deployments", key)
+		return nil, false
+	}
+
+	return r, true
+}
+
+// resolve returns the repository holding the provided item and the item,
+// looking it up in the repositories of a virtual repository in order.
+// The caller must hold the lock of the Server.
+func (s *Server) resolve(r *repository, p string) (*repository, *item) {
+	return s.resolveIn(r, p, map[string]bool{})
+}
+
+// resolveIn resolves the provided item, skipping the visited repositories.
+func (s *Server) resolveIn(r *repository, p string, visited map[string]bool) (*repository, *item) {
+	visited[r.key()] = true
+
+	if r.rclass() != "virtual" || len(p) == 0 {
+		it, ok := r.items[p]
+		if !ok {
+			return nil, nil
+		}
+
+		return r, it
+	}
+
+	for _, key := range r.config.strings("repositories") {
+		inner, ok := s.repositories[key]
+		if !ok || visited[key] {
+			continue
+		}
+
+		if found, it := s.resolveIn(inner, p, visited); it != nil {
+			return found, it
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifactorytest
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/franela/goblin"
+	"github.com/target/go-arty/v2/artifactory"
+)
+
+func Test_Repositories(t *testing.T) {
+	s := NewServer()
+
+	c, _ := artifactory.NewClient(s.URL, nil)
+
+	g := goblin.Goblin(t)
+	g.Describe("Repositories", func() {
+		g.BeforeEach(func() {
+			s.Reset()
+		})
+
+		g.After(func() {
+			s.Close()
+		})
+
+		g.It("- should create and return a repository", func() {
+			_, _, err := c.Repositories.Create("libs-local", newLocalRepository("libs-local"))
+			g.Assert(err == nil).IsTrue()
+
+			repo, _, err := c.Repositories.Get("libs-local")
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(repo.(*artifactory.LocalRepository).GetKey()).Equal("libs-local")
+
+			repos, _, err := c.Repositories.GetAll()
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(len(*repos)).Equal(1)
+			g.Assert((*repos)[0].GetType()).Equal("LOCAL")
+		})
+
+		g.It("- should reject an existing repository", func() {
+			c.Repositories.Create("libs-local", newLocalRepository("libs-local"))
+
+			_, _, err := c.Repositories.Create("LIBS-local", newLocalRepository("LIBS-local"))
+
+			g.Assert(err != nil).IsTrue()
+		})
+
+		g.It("- should update a repository", func() {
+			c.Repositories.Create("libs-local", newLocalRepository("libs-local"))
+
+			_, _, err := c.Repositories.Update("libs-local", &artifactory.GenericRepository{
+				Description: artifactory.String("Releases"),
+			})
+			g.Assert(err == nil).IsTrue()
+
+			repo, _, _ := c.Repositories.Get("libs-local")
+
+			g.Assert(repo.(*artifactory.LocalRepository).GetDescription()).Equal("Releases")
+			g.Assert(repo.(*artifactory.LocalRepository).GetPackageType()).Equal("generic")
+		})
+
+		g.It("- should delete a repository with its content", func() {
+			c.Repositories.Create("libs-local", newLocalRepository("libs-local"))
+			c.Artifacts.UploadReader("libs-local", "app.txt", bytes.NewReader([]byte("app")), -1, nil)
+
+			_, _, err := c.Repositories.Delete("libs-local")
+			g.Assert(err == nil).IsTrue()
+
+			c.Repositories.Create("libs-local", newLocalRepository("libs-local"))
+
+			_, _, err = c.Artifacts.Download("libs-local", "app.txt")
+			g.Assert(artifactory.IsNotFound(err)).IsTrue()
+		})
+
+		g.It("- should return an error for a missing repository", func() {
+			_, _, err := c.Repositories.Get("missing-local")
+
+			g.Assert(artifactory.IsNotFound(err)).IsTrue()
+		})
+	})
+}
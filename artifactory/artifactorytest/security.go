@@ -0,0 +1,545 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifactorytest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/target/go-arty/v2/artifactory"
+)
+
+// getUsers lists the users with their details.
+func (s *Server) getUsers(c *gin.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	users := []artifactory.User{}
+	for _, name := range sortedKeys(s.users) {
+		u := s.users[name]
+		groups := s.userGroups(name)
+
+		user := artifactory.User{
+			Name:   artifactory.String(name),
+			Admin:  artifactory.Bool(u["admin"] == true),
+			Groups: &groups,
+			Realm:  artifactory.String(u.str("realm")),
+		}
+
+		if email := u.str("email"); len(email) > 0 {
+			user.Email = artifactory.String(email)
+		}
+
+		users = append(users, user)
+	}
+
+	c.JSON(http.StatusOK, users)
+}
+
+// getSecurityUsers lists the users.
+func (s *Server) getSecurityUsers(c *gin.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	users := []gin.H{}
+	for _, name := range sortedKeys(s.users) {
+		users = append(users, gin.H{
+			"name":  name,
+			"uri":   fmt.Sprintf("%s/api/security/users/%s", s.URL, name),
+			"realm": s.users[name].str("realm"),
+		})
+	}
+
+	c.JSON(http.StatusOK, users)
+}
+
+// getSecurityUser returns the details of a user, without its password.
+func (s *Server) getSecurityUser(c *gin.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, ok := s.user(c, c.Param("user"))
+	if !ok {
+		return
+	}
+
+	v := u.copy()
+	delete(v, "password")
+	v["groups"] = s.userGroups(c.Param("user"))
+
+	c.JSON(http.StatusOK, v)
+}
+
+// createSecurityUser creates or replaces a user with the details of the request body.
+func (s *Server) createSecurityUser(c *gin.Context) {
+	name := c.Param("user")
+
+	u, ok := bind(c)
+	if !ok {
+		return
+	}
+
+	if len(u.str("email")) == 0 {
+		writeError(c, http.StatusBadRequest, "Email is required")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u["name"] = name
+	if len(u.str("realm")) == 0 {
+		u["realm"] = "internal"
+	}
+
+	s.users[name] = u
+
+	c.Status(http.StatusCreated)
+}
+
+// updateSecurityUser sets the fields of the request body on the details of a user.
+func (s *Server) updateSecurityUser(c *gin.Context) {
+	update, ok := bind(c)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, ok := s.user(c, c.Param("user"))
+	if !ok {
+		return
+	}
+
+	delete(update, "name")
+	u.merge(update)
+
+	c.Status(http.StatusOK)
+}
+
+// deleteSecurityUser removes a user, along with its API key.
+func (s *Server) deleteSecurityUser(c *gin.Context) {
+	name := c.Param("user")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.user(c, name); !ok {
+		return
+	}
+
+	delete(s.users, name)
+	delete(s.apiKeys, name)
+
+	c.JSON(http.StatusOK, fmt.Sprintf("User '%s' has been removed successfully.", name))
+}
+
+// user returns the user with the provided name.
+// It replies 404 Not Found and returns false if there is none.
+// The caller must hold the lock of the Server.
+func (s *Server) user(c *gin.Context, name string) (object, bool) {
+	u, ok := s.users[name]
+	if !ok {
+		writeError(c, http.StatusNotFound, "User '%s' does not exist", name)
+	}
+
+	return u, ok
+}
+
+// userGroups returns the groups the provided user belongs to, either from
+// its own details or from the user names of the groups.
+// The caller must hold the lock of the Server.
+func (s *Server) userGroups(name string) []string {
+	groups := []string{}
+	if u, ok := s.users[name]; ok {
+		groups = append(groups, u.strings("groups")...)
+	}
+
+	for _, group := range sortedKeys(s.groups) {
+		if contains(s.groups[group].strings("userNames"), name) && !contains(groups, group) {
+			groups = append(groups, group)
+		}
+	}
+
+	return groups
+}
+
+// getAPIKey returns the API key of the user making the request, if any.
+func (s *Server) getAPIKey(c *gin.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key, ok := s.apiKeys[userOf(c)]
+	if !ok {
+		c.JSON(http.StatusOK, gin.H{})
+		return
+	}
+
+	c.JSON(http.StatusOK, artifactory.APIKey{APIKey: artifactory.String(key)})
+}
+
+// createAPIKey creates an API key for the user making the request.
+func (s *Server) createAPIKey(c *gin.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	user := userOf(c)
+	if _, ok := s.apiKeys[user]; ok {
+		writeError(c, http.StatusBadRequest, "Api key already exists for user: '%s'", user)
+		return
+	}
+
+	s.apiKeys[user] = randomHex(36)
+
+	c.JSON(http.StatusCreated, artifactory.APIKey{APIKey: artifactory.String(s.apiKeys[user])})
+}
+
+// regenerateAPIKey replaces the API key of the user making the request.
+func (s *Server) regenerateAPIKey(c *gin.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	user := userOf(c)
+	if _, ok := s.apiKeys[user]; !ok {
+		writeError(c, http.StatusBadRequest, "Api key doesn't exist for user: '%s'", user)
+		return
+	}
+
+	s.apiKeys[user] = randomHex(36)
+
+	c.JSON(http.StatusOK, artifactory.APIKey{APIKey: artifactory.String(s.apiKeys[user])})
+}
+
+// deleteAPIKey removes the API key of the user making the request,
+// or all API keys if the deleteAll query parameter is set to 1.
+func (s *Server) deleteAPIKey(c *gin.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if query(c)["deleteAll"] == "1" {
+		s.apiKeys = map[string]string{}
+
+		c.JSON(http.StatusOK, artifactory.DeleteAPIKey{Info: artifactory.String("All api keys have been removed successfully.")})
+		return
+	}
+
+	user := userOf(c)
+	delete(s.apiKeys, user)
+
+	c.JSON(http.StatusOK, artifactory.DeleteAPIKey{Info: artifactory.String(fmt.Sprintf("Api key for user: '%s' has been removed successfully.", user))})
+}
+
+// deleteUserAPIKey removes the API key of a user.
+func (s *Server) deleteUserAPIKey(c *gin.Context) {
+	user := c.Param("user")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.apiKeys, user)
+
+	c.JSON(http.StatusOK, artifactory.DeleteAPIKey{Info: artifactory.String(fmt.Sprintf("Api key for user: '%s' has been removed successfully.", user))})
+}
+
+// getEncryptedPassword returns an encrypted password for the user making the request.
+func (s *Server) getEncryptedPassword(c *gin.Context) {
+	c.JSON(http.StatusOK, "AP"+randomHex(24))
+}
+
+// getGroups lists the groups.
+func (s *Server) getGroups(c *gin.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	groups := []artifactory.Group{}
+	for _, name := range sortedKeys(s.groups) {
+		groups = append(groups, artifactory.Group{
+			Name: artifactory.String(name),
+			URI:  artifactory.String(fmt.Sprintf("%s/api/security/groups/%s", s.URL, name)),
+		})
+	}
+
+	c.JSON(http.StatusOK, groups)
+}
+
+// getGroup returns the details of a group, with the names of its users
+// if the includeUsers query parameter is set to true.
+func (s *Server) getGroup(c *gin.Context) {
+	name := c.Param("group")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	g, ok := s.group(c, name)
+	if !ok {
+		return
+	}
+
+	v := g.copy()
+	delete(v, "userNames")
+
+	if query(c)["includeUsers"] == "true" {
+		users := []string{}
+		for _, user := range sortedKeys(s.users) {
+			if contains(s.userGroups(user), name) {
+				users = append(users, user)
+			}
+		}
+
+		for _, user := range g.strings("userNames") {
+			if !contains(users, user) {
+				users = append(users, user)
+			}
+		}
+
+		v["userNames"] = users
+	}
+
+	c.JSON(http.StatusOK, v)
+}
+
+// createGroup creates or replaces a group with the details of the request body.
+func (s *Server) createGroup(c *gin.Context) {
+	name := c.Param("group")
+
+	g, ok := bind(c)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	g["name"] = name
+	if len(g.str("realm")) == 0 {
+		g["realm"] = "internal"
+	}
+
+	s.groups[name] = g
+
+	c.Status(http.StatusCreated)
+}
+
+// updateGroup sets the fields of the request body on the details of a group.
+func (s *Server) updateGroup(c *gin.Context) {
+	update, ok := bind(c)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	g, ok := s.group(c, c.Param("group"))
+	if !ok {
+		return
+	}
+
+	delete(update, "name")
+	g.merge(update)
+
+	c.Status(http.StatusOK)
+}
+
+// deleteGroup removes a group, and the users from it.
+func (s *Server) deleteGroup(c *gin.Context) {
+	name := c.Param("group")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.group(c, name); !ok {
+		return
+	}
+
+	delete(s.groups, name)
+
+	for _, u := range s.users {
+		if groups := u.strings("groups"); contains(groups, name) {
+			kept := []string{}
+			for _, group := range groups {
+				if group != name {
+					kept = append(kept, group)
+				}
+			}
+
+			u["groups"] = toList(kept)
+		}
+	}
+
+	c.JSON(http.StatusOK, fmt.Sprintf("Group '%s' has been removed successfully.", name))
+}
+
+// group returns the group with the provided name.
+// It replies 404 Not Found and returns false if there is none.
+// The caller must hold the lock of the Server.
+func (s *Server) group(c *gin.Context, name string) (object, bool) {
+	g, ok := s.groups[name]
+	if !ok {
+		writeError(c, http.StatusNotFound, "Group '%s' does not exist", name)
+	}
+
+	return g, ok
+}
+
+// getPermissions lists the permission targets.
+func (s *Server) getPermissions(c *gin.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	targets := []artifactory.PermissionTarget{}
+	for _, name := range sortedKeys(s.permissions) {
+		targets = append(targets, artifactory.PermissionTarget{
+			Name: artifactory.String(name),
+			URI:  artifactory.String(fmt.Sprintf("%s/api/security/permissions/%s", s.URL, name)),
+		})
+	}
+
+	c.JSON(http.StatusOK, targets)
+}
+
+// getPermission returns the details of a permission target.
+func (s *Server) getPermission(c *gin.Context) {
+	name := c.Param("target")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	target, ok := s.permissions[name]
+	if !ok {
+		writeError(c, http.StatusNotFound, "Permission target '%s' does not exist", name)
+		return
+	}
+
+	c.JSON(http.StatusOK, target)
+}
+
+// createPermission creates or replaces a permission target with the details of the request body.
+// The repositories it refers to must exist, unless they are one of the ANY keys.
+func (s *Server) createPermission(c *gin.Context) {
+	name := c.Param("target")
+
+	target, ok := bind(c)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, key := range target.strings("repositories") {
+		if _, ok := s.repositories[key]; !ok && !strings.HasPrefix(key, "ANY") {
+			writeError(c, http.StatusBadRequest, "Repository %s does not exist", key)
+			return
+		}
+	}
+
+	target["name"] = name
+	s.permissions[name] = target
+
+	c.Status(http.StatusCreated)
+}
+
+// deletePermission removes a permission target.
+func (s *Server) deletePermission(c *gin.Context) {
+	name := c.Param("target")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.permissions[name]; !ok {
+		writeError(c, http.StatusNotFound, "Permission target '%s' does not exist", name)
+		return
+	}
+
+	delete(s.permissions, name)
+
+	c.JSON(http.StatusOK, fmt.Sprintf("Permission Target '%s' has been removed successfully.", name))
+}
+
+// getPermissionV2 returns the details of a permission target of the v2 API.
+func (s *Server) getPermissionV2(c *gin.Context) {
+	name := c.Param("target")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	target, ok := s.permissionsV2[name]
+	if !ok {
+		writeError(c, http.StatusNotFound, "Permission target '%s' does not exist", name)
+		return
+	}
+
+	c.JSON(http.StatusOK, target)
+}
+
+// updatePermissionV2 creates or replaces a permission target of the v2 API
+// with the details of the request body.
+func (s *Server) updatePermissionV2(c *gin.Context) {
+	name := c.Param("target")
+
+	target, ok := bind(c)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	target["name"] = name
+	s.permissionsV2[name] = target
+
+	c.Status(http.StatusOK)
+}
+
+// deletePermissionV2 removes a permission target of the v2 API.
+func (s *Server) deletePermissionV2(c *gin.Context) {
+	name := c.Param("target")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.permissionsV2[name]; !ok {
+		writeError(c, http.StatusNotFound, "Permission target '%s' does not exist", name)
+		return
+	}
+
+	delete(s.permissionsV2, name)
+
+	c.Status(http.StatusNoContent)
+}
+
+// randomHex returns a random hexadecimal string of n bytes.
+func randomHex(n int) string {
+	b := make([]byte, n)
+	_, _ = rand.Read(b)
+
+	return hex.EncodeToString(b)
+}
+
+// toList returns the provided strings as a JSON list.
+func toList(strs []string) []interface{} {
+	list := make([]interface{}, 0, len(strs))
+	for _, s := range strs {
+		list = append(list, s)
+	}
+
+	return list
+}
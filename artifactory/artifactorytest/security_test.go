@@ -0,0 +1,151 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifactorytest
+
+import (
+	"testing"
+
+	"github.com/franela/goblin"
+	"github.com/target/go-arty/v2/artifactory"
+)
+
+func Test_Security(t *testing.T) {
+	s := NewServer()
+
+	c, _ := artifactory.NewClient(s.URL, nil)
+	c.Authentication.SetBasicAuth("admin", "password")
+
+	g := goblin.Goblin(t)
+	g.Describe("Security", func() {
+		g.BeforeEach(func() {
+			s.Reset()
+		})
+
+		g.After(func() {
+			s.Close()
+		})
+
+		g.It("- should create, update and delete a user", func() {
+			_, _, err := c.Users.CreateSecurity(&artifactory.SecurityUser{
+				Name:     artifactory.String("jane"),
+				Email:    artifactory.String("jane@example.com"),
+				Password: artifactory.String("secret"),
+			})
+			g.Assert(err == nil).IsTrue()
+
+			_, _, err = c.Users.UpdateSecurity(&artifactory.SecurityUser{
+				Name:  artifactory.String("jane"),
+				Admin: artifactory.Bool(true),
+			})
+			g.Assert(err == nil).IsTrue()
+
+			user, _, err := c.Users.GetSecurity("jane")
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(user.GetEmail()).Equal("jane@example.com")
+			g.Assert(user.GetAdmin()).IsTrue()
+			g.Assert(user.Password == nil).IsTrue()
+
+			_, _, err = c.Users.DeleteSecurity("jane")
+			g.Assert(err == nil).IsTrue()
+
+			_, _, err = c.Users.GetSecurity("jane")
+			g.Assert(artifactory.IsNotFound(err)).IsTrue()
+		})
+
+		g.It("- should return the users of a group", func() {
+			c.Groups.Create(&artifactory.Group{Name: artifactory.String("developers")})
+			c.Users.CreateSecurity(&artifactory.SecurityUser{
+				Name:   artifactory.String("jane"),
+				Email:  artifactory.String("jane@example.com"),
+				Groups: &[]string{"developers"},
+			})
+
+			group, _, err := c.Groups.Get(&artifactory.GetGroupRequest{
+				Name:         artifactory.String("developers"),
+				IncludeUsers: artifactory.Bool(true),
+			})
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(*group.UserNames).Equal([]string{"jane"})
+
+			users, _, err := c.Users.GetAll()
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(*(*users)[0].Groups).Equal([]string{"developers"})
+		})
+
+		g.It("- should manage the API key of the user", func() {
+			created, _, err := c.Users.CreateAPIKey()
+			g.Assert(err == nil).IsTrue()
+
+			key, _, _ := c.Users.GetAPIKey()
+			g.Assert(key.GetAPIKey()).Equal(created.GetAPIKey())
+
+			regenerated, _, _ := c.Users.RegenerateAPIKey()
+			g.Assert(regenerated.GetAPIKey() != created.GetAPIKey()).IsTrue()
+
+			_, _, err = c.Users.DeleteAPIKey()
+			g.Assert(err == nil).IsTrue()
+
+			key, _, _ = c.Users.GetAPIKey()
+			g.Assert(key.APIKey == nil).IsTrue()
+		})
+
+		g.It("- should create and delete a permission target", func() {
+			c.Repositories.Create("libs-local", newLocalRepository("libs-local"))
+
+			_, _, err := c.Permissions.Create(&artifactory.PermissionTarget{
+				Name:         artifactory.String("libs"),
+				Repositories: &[]string{"libs-local"},
+			})
+			g.Assert(err == nil).IsTrue()
+
+			targets, _, _ := c.Permissions.GetAll()
+			g.Assert(len(*targets)).Equal(1)
+
+			target, _, err := c.Permissions.Get("libs")
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(*target.Repositories).Equal([]string{"libs-local"})
+
+			_, _, err = c.Permissions.Delete("libs")
+			g.Assert(err == nil).IsTrue()
+
+			_, _, err = c.Permissions.Get("libs")
+			g.Assert(artifactory.IsNotFound(err)).IsTrue()
+		})
+
+		g.It("- should reject a permission target for a missing repository", func() {
+			_, _, err := c.Permissions.Create(&artifactory.PermissionTarget{
+				Name:         artifactory.String("libs"),
+				Repositories: &[]string{"missing-local"},
+			})
+
+			g.Assert(err != nil).IsTrue()
+		})
+
+		g.It("- should check if a permission target of the v2 API exists", func() {
+			exists, _ := c.PermissionsV2.Exists("libs")
+			g.Assert(exists).IsFalse()
+
+			_, _, err := c.PermissionsV2.Update(&artifactory.PermissionTargetV2{Name: artifactory.String("libs")})
+			g.Assert(err == nil).IsTrue()
+
+			exists, _ = c.PermissionsV2.Exists("libs")
+			g.Assert(exists).IsTrue()
+		})
+	})
+}
@@ -0,0 +1,265 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package artifactorytest provides a fake Artifactory server for tests.
+//
+// The Server emulates the Artifactory API used by the artifactory package
+// with in-memory state. Repositories, artifacts and their properties, users,
+// groups, permission targets and replications created through the API
+// persist across calls, and checksums and storage information are computed
+// from the stored content:
+//
+//	s := artifactorytest.NewServer()
+//	defer s.Close()
+//
+//	client, _ := artifactory.NewClient(s.URL, nil)
+//	client.Repositories.Create("libs-release-local", &artifactory.LocalRepository{...})
+//
+// Requests are not authenticated. The user making a request, recorded as the
+// creator of the items it deploys and as the owner of its API key, is the
+// username of its HTTP Basic authentication, or anonymous.
+package artifactorytest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Server is a fake Artifactory server with in-memory state.
+type Server struct {
+	*httptest.Server
+
+	mu            sync.Mutex
+	repositories  map[string]*repository
+	users         map[string]object
+	groups        map[string]object
+	permissions   map[string]object
+	permissionsV2 map[string]object
+	replications  map[string][]object
+	apiKeys       map[string]string
+}
+
+// NewServer starts and returns a new Server without any content.
+// The caller should call Close when finished, to shut it down.
+func NewServer() *Server {
+	s := new(Server)
+	s.Reset()
+	s.Server = httptest.NewServer(s.handler())
+
+	return s
+}
+
+// Reset removes all the content of the Server.
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.repositories = map[string]*repository{}
+	s.users = map[string]object{}
+	s.groups = map[string]object{}
+	s.permissions = map[string]object{}
+	s.permissionsV2 = map[string]object{}
+	s.replications = map[string][]object{}
+	s.apiKeys = map[string]string{}
+}
+
+// handler returns the http.Handler routing the requests to the Server.
+func (s *Server) handler() http.Handler {
+	e := gin.New()
+
+	e.GET("/api/system/ping", s.ping)
+	e.GET("/api/system/version", s.getVersion)
+
+	e.GET("/api/repositories", s.getRepositories)
+	e.GET("/api/repositories/:repo", s.getRepository)
+	e.PUT("/api/repositories/:repo", s.createRepository)
+	e.POST("/api/repositories/:repo", s.updateRepository)
+	e.DELETE("/api/repositories/:repo", s.deleteRepository)
+
+	e.GET("/:repo/*path", s.download)
+	e.HEAD("/:repo/*path", s.download)
+	e.PUT("/:repo/*path", s.deploy)
+	e.DELETE("/:repo/*path", s.deleteItem)
+	e.POST("/api/copy/:repo/*path", s.copyItem)
+	e.POST("/api/move/:repo/*path", s.moveItem)
+
+	e.GET("/api/storage/:repo/*path", s.getStorage)
+	e.PUT("/api/storage/:repo/*path", s.setProperties)
+	e.DELETE("/api/storage/:repo/*path", s.deleteProperties)
+	e.GET("/api/storageinfo", s.getStorageSummary)
+
+	e.GET("/api/users", s.getUsers)
+	e.GET("/api/security/users", s.getSecurityUsers)
+	e.GET("/api/security/users/:user", s.getSecurityUser)
+	e.PUT("/api/security/users/:user", s.createSecurityUser)
+	e.POST("/api/security/users/:user", s.updateSecurityUser)
+	e.DELETE("/api/security/users/:user", s.deleteSecurityUser)
+	e.GET("/api/security/apiKey", s.getAPIKey)
+	e.POST("/api/security/apiKey", s.createAPIKey)
+	e.PUT("/api/security/apiKey", s.regenerateAPIKey)
+	e.DELETE("/api/security/apiKey", s.deleteAPIKey)
+	e.DELETE("/api/security/apiKey/:user", s.deleteUserAPIKey)
+	e.GET("/api/security/encryptedPassword", s.getEncryptedPassword)
+
+	e.GET("/api/security/groups", s.getGroups)
+	e.GET("/api/security/groups/:group", s.getGroup)
+	e.PUT("/api/security/groups/:group", s.createGroup)
+	e.POST("/api/security/groups/:group", s.updateGroup)
+	e.DELETE("/api/security/groups/:group", s.deleteGroup)
+
+	e.GET("/api/security/permissions", s.getPermissions)
+	e.GET("/api/security/permissions/:target", s.getPermission)
+	e.PUT("/api/security/permissions/:target", s.createPermission)
+	e.DELETE("/api/security/permissions/:target", s.deletePermission)
+	e.GET("/api/v2/security/permissions/:target", s.getPermissionV2)
+	e.HEAD("/api/v2/security/permissions/:target", s.getPermissionV2)
+	e.PUT("/api/v2/security/permissions/:target", s.updatePermissionV2)
+	e.DELETE("/api/v2/security/permissions/:target", s.deletePermissionV2)
+
+	e.GET("/api/replications", s.getReplications)
+	e.GET("/api/replications/:repo", s.getReplication)
+	e.PUT("/api/replications/:repo", s.createReplication)
+	e.POST("/api/replications/:repo", s.updateReplication)
+	e.DELETE("/api/replications/:repo", s.deleteReplication)
+	e.PUT("/api/replications/multiple/:repo", s.createMultiPushReplication)
+	e.POST("/api/replications/multiple/:repo", s.updateMultiPushReplication)
+
+	return e
+}
+
+// object is a JSON object stored by the Server, like a user or a group.
+type object map[string]interface{}
+
+// merge sets the fields of the provided update on the object.
+func (o object) merge(update object) {
+	for k, v := range update {
+		o[k] = v
+	}
+}
+
+// copy returns a shallow copy of the object.
+func (o object) copy() object {
+	c := object{}
+	c.merge(o)
+
+	return c
+}
+
+// str returns the string field of the object with the provided name, if any.
+func (o object) str(name string) string {
+	v, _ := o[name].(string)
+	return v
+}
+
+// strings returns the string list field of the object with the provided name, if any.
+func (o object) strings(name string) []string {
+	return stringList(o[name])
+}
+
+// stringList returns the strings of the provided JSON list.
+func stringList(v interface{}) []string {
+	list, _ := v.([]interface{})
+
+	var strs []string
+	for _, e := range list {
+		if s, ok := e.(string); ok {
+			strs = append(strs, s)
+		}
+	}
+
+	return strs
+}
+
+// bind decodes the JSON body of the request into an object.
+// It replies 400 Bad Request and returns false if the body is invalid.
+func bind(c *gin.Context) (object, bool) {
+	o := object{}
+
+	err := json.NewDecoder(c.Request.Body).Decode(&o)
+	if err != nil {
+		writeError(c, http.StatusBadRequest, "Invalid request body: %v", err)
+		return nil, false
+	}
+
+	return o, true
+}
+
+// writeError replies with an error in the shape returned by Artifactory.
+func writeError(c *gin.Context, status int, format string, args ...interface{}) {
+	c.JSON(status, gin.H{
+		"errors": []gin.H{{
+			"status":  status,
+			"message": fmt.Sprintf(format, args...),
+		}},
+	})
+}
+
+// userOf returns the user making the request.
+func userOf(c *gin.Context) string {
+	if user, _, ok := c.Request.BasicAuth(); ok && len(user) > 0 {
+		return user
+	}
+
+	return "anonymous"
+}
+
+// query returns the parameters of the request query. Unlike the standard
+// parser, it keeps the values holding semicolons, like matrix parameters.
+func query(c *gin.Context) map[string]string {
+	params := map[string]string{}
+
+	for _, param := range strings.Split(c.Request.URL.RawQuery, "&") {
+		if len(param) == 0 {
+			continue
+		}
+
+		k, v, _ := strings.Cut(param, "=")
+		if key, err := url.QueryUnescape(k); err == nil {
+			k = key
+		}
+		if value, err := url.QueryUnescape(v); err == nil {
+			v = value
+		}
+
+		params[k] = v
+	}
+
+	return params
+}
+
+// now returns the current time, as precise as the times of Artifactory.
+func now() time.Time {
+	return time.Now().UTC().Truncate(time.Millisecond)
+}
+
+// sortedKeys returns the keys of the provided map in order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
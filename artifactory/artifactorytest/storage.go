@@ -0,0 +1,535 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifactorytest
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/target/go-arty/v2/artifactory"
+)
+
+// getStorage returns the information of an item, or the part of it
+// selected with the properties, list, lastModified, stats or permissions
+// query parameters.
+func (s *Server) getStorage(c *gin.Context) {
+	p := cleanPath(c.Param("path"))
+	params := query(c)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, ok := s.repository(c, c.Param("repo"))
+	if !ok {
+		return
+	}
+
+	found, it := s.resolve(r, p)
+	if it == nil {
+		writeError(c, http.StatusNotFound, "Unable to find item")
+		return
+	}
+
+	uri := s.storageURI(r, p)
+
+	switch {
+	case has(params, "properties"):
+		properties := map[string][]string{}
+		for k, v := range it.properties {
+			properties[k] = v
+		}
+
+		if names := params["properties"]; len(names) > 0 {
+			properties = map[string][]string{}
+			for _, name := range strings.Split(names, ",") {
+				if v, ok := it.properties[name]; ok {
+					properties[name] = v
+				}
+			}
+		}
+
+		if len(properties) == 0 {
+			writeError(c, http.StatusNotFound, "No properties could be found.")
+			return
+		}
+
+		c.JSON(http.StatusOK, artifactory.ItemProperties{URI: &uri, Properties: &properties})
+	case has(params, "list"):
+		c.JSON(http.StatusOK, found.fileList(uri, p, params["deep"] == "1", params["listFolders"] == "1"))
+	case has(params, "lastModified"):
+		last := it.lastModified
+		for _, q := range found.tree(p) {
+			if t := found.items[q].lastModified; t.After(last) {
+				last = t
+			}
+		}
+
+		c.JSON(http.StatusOK, artifactory.ItemLastModified{URI: &uri, LastModified: timestamp(last)})
+	case has(params, "stats"):
+		stats := artifactory.FileStatistics{URI: &uri, DownloadCount: artifactory.Int(it.downloads)}
+		if it.downloads > 0 {
+			stats.LastDownloaded = timestamp(it.lastDownloaded)
+			stats.LastDownloadedBy = artifactory.String(it.lastDownloadedBy)
+		}
+
+		c.JSON(http.StatusOK, stats)
+	case has(params, "permissions"):
+		c.JSON(http.StatusOK, artifactory.EffectiveItemPermissions{URI: &uri, Principals: s.effectivePrincipals(r)})
+	case it.folder:
+		c.JSON(http.StatusOK, s.folderInfo(found, p, it))
+	default:
+		c.JSON(http.StatusOK, s.fileInfo(found, p, it))
+	}
+}
+
+// setProperties attaches the properties set with the properties query
+// parameter to an item, and to all items under it unless the recursive
+// query parameter is set to 0.
+func (s *Server) setProperties(c *gin.Context) {
+	p := cleanPath(c.Param("path"))
+	params := query(c)
+
+	properties := parseProperties(params["properties"])
+
+	if len(properties) == 0 {
+		writeError(c, http.StatusBadRequest, "No properties were provided")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, it, ok := s.storedItem(c, p)
+	if !ok {
+		return
+	}
+
+	items := []*item{it}
+	if params["recursive"] != "0" {
+		items = items[:0]
+		for _, q := range r.tree(p) {
+			items = append(items, r.items[q])
+		}
+	}
+
+	for _, it := range items {
+		for k, v := range properties {
+			it.properties[k] = v
+		}
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// deleteProperties removes the properties named in the properties query
+// parameter from an item, and from all items under it unless the recursive
+// query parameter is set to 0.
+func (s *Server) deleteProperties(c *gin.Context) {
+	p := cleanPath(c.Param("path"))
+	params := query(c)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, it, ok := s.storedItem(c, p)
+	if !ok {
+		return
+	}
+
+	items := []*item{it}
+	if params["recursive"] != "0" {
+		items = items[:0]
+		for _, q := range r.tree(p) {
+			items = append(items, r.items[q])
+		}
+	}
+
+	for _, it := range items {
+		for _, name := range splitNames(params["properties"]) {
+			delete(it.properties, name)
+		}
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// parseProperties parses the provided value of the properties query
+// parameter, like "key=value1,value2;other=value", in which the special
+// characters are escaped with a backslash.
+func parseProperties(v string) map[string][]string {
+	properties := map[string][]string{}
+
+	var (
+		key, value strings.Builder
+		values     []string
+		inValue    bool
+	)
+
+	current := &key
+	flush := func() {
+		if key.Len() > 0 {
+			properties[key.String()] = append(values, value.String())
+		}
+
+		key.Reset()
+		value.Reset()
+		values, inValue, current = nil, false, &key
+	}
+
+	for i := 0; i < len(v); i++ {
+		switch {
+		case v[i] == '\\' && i+1 < len(v):
+			i++
+			current.WriteByte(v[i])
+		case v[i] == ';':
+			flush()
+		case v[i] == '=' && !inValue:
+			inValue, current = true, &value
+		case v[i] == ',' && inValue:
+			values = append(values, value.String())
+			value.Reset()
+		default:
+			current.WriteByte(v[i])
+		}
+	}
+	flush()
+
+	return properties
+}
+
+// splitNames splits the provided value of the properties query parameter
+// listing property names, like "key,other", in which the special
+// characters are escaped with a backslash.
+func splitNames(v string) []string {
+	var (
+		names []string
+		name  strings.Builder
+	)
+
+	for i := 0; i < len(v); i++ {
+		switch {
+		case v[i] == '\\' && i+1 < len(v):
+			i++
+			name.WriteByte(v[i])
+		case v[i] == ',':
+			names = append(names, name.String())
+			name.Reset()
+		default:
+			name.WriteByte(v[i])
+		}
+	}
+
+	return append(names, name.String())
+}
+
+// storedItem returns the repository and the item requested in a local repository.
+// It replies 404 Not Found and returns false if there is none.
+// The caller must hold the lock of the Server.
+func (s *Server) storedItem(c *gin.Context, p string) (*repository, *item, bool) {
+	r, ok := s.repository(c, c.Param("repo"))
+	if !ok {
+		return nil, nil, false
+	}
+
+	it, ok := r.items[p]
+	if !ok {
+		writeError(c, http.StatusNotFound, "Unable to find item")
+		return nil, nil, false
+	}
+
+	return r, it, true
+}
+
+// getStorageSummary returns the storage summary computed from the stored content.
+func (s *Server) getStorageSummary(c *gin.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var (
+		summaries                []artifactory.RepositoriesSummary
+		sizes                    []int64
+		totalFiles, totalFolders int
+		totalSize, binariesSize  int64
+		binaries                 = map[string]bool{}
+	)
+
+	for _, key := range sortedKeys(s.repositories) {
+		r := s.repositories[key]
+
+		files, folders := 0, 0
+		var size int64
+		for p, it := range r.items {
+			switch {
+			case len(p) == 0:
+			case it.folder:
+				folders++
+			default:
+				files++
+				size += int64(len(it.content))
+
+				if !binaries[it.sha1] {
+					binaries[it.sha1] = true
+					binariesSize += int64(len(it.content))
+				}
+			}
+		}
+
+		totalFiles += files
+		totalFolders += folders
+		totalSize += size
+
+		sizes = append(sizes, size)
+		summaries = append(summaries, artifactory.RepositoriesSummary{
+			RepoKey:      artifactory.String(key),
+			RepoType:     artifactory.String(strings.ToUpper(r.rclass())),
+			FoldersCount: artifactory.Int(folders),
+			FilesCount:   artifactory.Int(files),
+			UsedSpace:    artifactory.String(formatSize(size)),
+			ItemsCount:   artifactory.Int(files + folders),
+			PackageType:  artifactory.String(r.config.str("packageType")),
+		})
+	}
+
+	for i := range summaries {
+		summaries[i].Percentage = artifactory.String(percentage(sizes[i], totalSize))
+	}
+
+	summaries = append(summaries, artifactory.RepositoriesSummary{
+		RepoKey:      artifactory.String("TOTAL"),
+		RepoType:     artifactory.String("NA"),
+		FoldersCount: artifactory.Int(totalFolders),
+		FilesCount:   artifactory.Int(totalFiles),
+		UsedSpace:    artifactory.String(formatSize(totalSize)),
+		ItemsCount:   artifactory.Int(totalFiles + totalFolders),
+	})
+
+	c.JSON(http.StatusOK, artifactory.StorageSummary{
+		BinariesSummary: &artifactory.BinariesSummary{
+			BinariesCount:  artifactory.String(formatCount(len(binaries))),
+			BinariesSize:   artifactory.String(formatSize(binariesSize)),
+			ArtifactsSize:  artifactory.String(formatSize(totalSize)),
+			Optimization:   artifactory.String(percentage(binariesSize, totalSize)),
+			ItemsCount:     artifactory.String(formatCount(totalFiles + totalFolders)),
+			ArtifactsCount: artifactory.String(formatCount(totalFiles)),
+		},
+		FileStoreSummary: &artifactory.FileStoreSummary{
+			StorageType:      artifactory.String("memory"),
+			StorageDirectory: artifactory.String("memory"),
+		},
+		RepositoriesSummaryList: &summaries,
+	})
+}
+
+// fileInfo returns the information of the provided item, as returned when deploying it.
+func (s *Server) fileInfo(r *repository, p string, it *item) *artifactory.File {
+	f := &artifactory.File{
+		URI:          artifactory.String(s.storageURI(r, p)),
+		DownloadURI:  artifactory.String(fmt.Sprintf("%s/%s/%s", s.URL, r.key(), p)),
+		Repo:         artifactory.String(r.key()),
+		Path:         artifactory.String("/" + p),
+		Created:      timestamp(it.created),
+		CreatedBy:    artifactory.String(it.createdBy),
+		LastModified: timestamp(it.lastModified),
+		ModifiedBy:   artifactory.String(it.modifiedBy),
+		LastUpdated:  timestamp(it.lastModified),
+	}
+
+	if it.folder {
+		return f
+	}
+
+	f.Size = artifactory.String(strconv.Itoa(len(it.content)))
+	f.MimeType = artifactory.String(it.mimeType)
+	f.Checksums = &artifactory.Checksums{
+		MD5:    artifactory.String(it.md5),
+		SHA1:   artifactory.String(it.sha1),
+		SHA256: artifactory.String(it.sha256),
+	}
+	f.OriginalChecksums = &artifactory.Checksums{
+		MD5:    artifactory.String(it.md5),
+		SHA1:   artifactory.String(it.sha1),
+		SHA256: artifactory.String(it.sha256),
+	}
+
+	return f
+}
+
+// folderInfo returns the information of the provided folder, with its children.
+func (s *Server) folderInfo(r *repository, p string, it *item) *artifactory.Folder {
+	children := []artifactory.Child{}
+	for _, q := range r.children(p) {
+		children = append(children, artifactory.Child{
+			URI:    artifactory.String("/" + path.Base(q)),
+			Folder: artifactory.String(strconv.FormatBool(r.items[q].folder)),
+		})
+	}
+
+	return &artifactory.Folder{
+		URI:          artifactory.String(s.storageURI(r, p)),
+		Repo:         artifactory.String(r.key()),
+		Path:         artifactory.String("/" + p),
+		Created:      timestamp(it.created),
+		CreatedBy:    artifactory.String(it.createdBy),
+		LastModified: timestamp(it.lastModified),
+		ModifiedBy:   artifactory.String(it.modifiedBy),
+		LastUpdated:  timestamp(it.lastModified),
+		Children:     &children,
+	}
+}
+
+// fileList returns the files under the provided folder, and the folders if
+// listFolders is set. Only its direct children are listed unless deep is set.
+func (r *repository) fileList(uri, p string, deep, listFolders bool) *artifactory.FileList {
+	paths := r.children(p)
+	if deep {
+		paths = r.tree(p)
+	}
+
+	files := []artifactory.FileListItem{}
+	for _, q := range paths {
+		it := r.items[q]
+		if q == p || (it.folder && !listFolders) {
+			continue
+		}
+
+		f := artifactory.FileListItem{
+			URI:          artifactory.String(strings.TrimPrefix(q, p)),
+			LastModified: timestamp(it.lastModified),
+			Folder:       artifactory.Bool(it.folder),
+		}
+
+		if len(p) == 0 {
+			f.URI = artifactory.String("/" + q)
+		}
+
+		if !it.folder {
+			f.Size = artifactory.Int(len(it.content))
+			f.SHA1 = artifactory.String(it.sha1)
+		}
+
+		files = append(files, f)
+	}
+
+	return &artifactory.FileList{
+		URI:     artifactory.String(uri),
+		Created: timestamp(now()),
+		Files:   &files,
+	}
+}
+
+// effectivePrincipals returns the permissions granted on the provided
+// repository by the permission targets.
+// The caller must hold the lock of the Server.
+func (s *Server) effectivePrincipals(r *repository) *artifactory.Principals {
+	users, groups := map[string][]string{}, map[string][]string{}
+
+	for _, name := range sortedKeys(s.permissions) {
+		target := s.permissions[name]
+
+		applies := false
+		for _, key := range target.strings("repositories") {
+			if key == r.key() || key == "ANY" || (key == "ANY LOCAL" && r.rclass() == "local") || (key == "ANY REMOTE" && r.rclass() == "remote") {
+				applies = true
+			}
+		}
+
+		if !applies {
+			continue
+		}
+
+		principals, _ := target["principals"].(map[string]interface{})
+		for kind, granted := range map[string]map[string][]string{"users": users, "groups": groups} {
+			entries, _ := principals[kind].(map[string]interface{})
+			for principal, actions := range entries {
+				for _, action := range stringList(actions) {
+					if !contains(granted[principal], action) {
+						granted[principal] = append(granted[principal], action)
+					}
+				}
+			}
+		}
+	}
+
+	return &artifactory.Principals{Users: &users, Groups: &groups}
+}
+
+// storageURI returns the URI of the provided item in the storage API.
+func (s *Server) storageURI(r *repository, p string) string {
+	return strings.TrimSuffix(fmt.Sprintf("%s/api/storage/%s/%s", s.URL, r.key(), p), "/")
+}
+
+// has reports whether the provided query parameter is set, even without a value.
+func has(params map[string]string, name string) bool {
+	_, ok := params[name]
+	return ok
+}
+
+// contains reports whether the provided list holds v.
+func contains(list []string, v string) bool {
+	for _, e := range list {
+		if e == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+// timestamp returns the provided time as a Timestamp.
+func timestamp(t time.Time) *artifactory.Timestamp {
+	return &artifactory.Timestamp{Time: t}
+}
+
+// formatSize formats the provided number of bytes like Artifactory, as in 3.48 GB.
+func formatSize(n int64) string {
+	units := []string{"KB", "MB", "GB", "TB"}
+
+	if n < 1024 {
+		return fmt.Sprintf("%d bytes", n)
+	}
+
+	v := float64(n) / 1024
+	unit := 0
+	for v >= 1024 && unit < len(units)-1 {
+		v /= 1024
+		unit++
+	}
+
+	return fmt.Sprintf("%.2f %s", v, units[unit])
+}
+
+// formatCount formats the provided count like Artifactory, with thousands separators.
+func formatCount(n int) string {
+	s := strconv.Itoa(n)
+	for i := len(s) - 3; i > 0; i -= 3 {
+		s = s[:i] + "," + s[i:]
+	}
+
+	return s
+}
+
+// percentage formats the ratio of part in total as a percentage, as in 5.82%.
+func percentage(part, total int64) string {
+	if total == 0 {
+		return "0%"
+	}
+
+	return strings.TrimSuffix(strings.TrimRight(fmt.Sprintf("%.2f", float64(part)*100/float64(total)), "0"), ".") + "%"
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifactorytest
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/franela/goblin"
+	"github.com/target/go-arty/v2/artifactory"
+)
+
+func Test_Storage(t *testing.T) {
+	s := NewServer()
+
+	c, _ := artifactory.NewClient(s.URL, nil)
+
+	g := goblin.Goblin(t)
+	g.Describe("Storage", func() {
+		g.BeforeEach(func() {
+			s.Reset()
+			c.Repositories.Create("libs-local", newLocalRepository("libs-local"))
+			c.Artifacts.UploadReader("libs-local", "org/app/app-1.0.txt", bytes.NewReader([]byte("1.0")), -1, nil)
+			c.Artifacts.UploadReader("libs-local", "org/app/app-1.1.txt", bytes.NewReader([]byte("1.1")), -1, nil)
+		})
+
+		g.After(func() {
+			s.Close()
+		})
+
+		g.It("- should return a folder with its children", func() {
+			folder, _, err := c.Storage.GetFolder("libs-local", "org/app")
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(folder.GetPath()).Equal("/org/app")
+			g.Assert(len(*folder.Children)).Equal(2)
+			g.Assert((*folder.Children)[0].GetURI()).Equal("/app-1.0.txt")
+			g.Assert((*folder.Children)[0].GetFolder()).Equal("false")
+		})
+
+		g.It("- should list the files of a folder", func() {
+			list, _, err := c.Storage.GetFileList("libs-local", "org")
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(len(*list.Files)).Equal(2)
+			g.Assert((*list.Files)[1].GetURI()).Equal("/app/app-1.1.txt")
+			g.Assert((*list.Files)[1].GetSize()).Equal(3)
+		})
+
+		g.It("- should set and delete properties recursively", func() {
+			_, err := c.Storage.SetItemProperties("libs-local", "org", map[string][]string{"team": {"platform"}})
+			g.Assert(err == nil).IsTrue()
+
+			properties, _, err := c.Storage.GetItemProperties("libs-local", "org/app/app-1.0.txt")
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert((*properties.Properties)["team"]).Equal([]string{"platform"})
+
+			_, err = c.Storage.DeleteItemProperties("libs-local", "org", []string{"team"})
+			g.Assert(err == nil).IsTrue()
+
+			_, _, err = c.Storage.GetItemProperties("libs-local", "org/app/app-1.0.txt")
+			g.Assert(artifactory.IsNotFound(err)).IsTrue()
+		})
+
+		g.It("- should count the downloads of a file", func() {
+			c.Artifacts.Download("libs-local", "org/app/app-1.0.txt")
+			c.Artifacts.Download("libs-local", "org/app/app-1.0.txt")
+
+			stats, _, err := c.Storage.GetFileStatistics("libs-local", "org/app/app-1.0.txt")
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(stats.GetDownloadCount()).Equal(2)
+			g.Assert(stats.GetLastDownloadedBy()).Equal("anonymous")
+		})
+
+		g.It("- should return the effective permissions of an item", func() {
+			c.Permissions.Create(&artifactory.PermissionTarget{
+				Name:         artifactory.String("deployers"),
+				Repositories: &[]string{"ANY LOCAL"},
+				Principals: &artifactory.Principals{
+					Users: &map[string][]string{"deployer": {"r", "w"}},
+				},
+			})
+
+			permissions, _, err := c.Storage.GetEffectiveItemPermissions("libs-local", "org")
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert((*permissions.Principals.Users)["deployer"]).Equal([]string{"r", "w"})
+		})
+
+		g.It("- should compute the storage summary from the content", func() {
+			c.Artifacts.Copy("libs-local", "org/app/app-1.0.txt", "libs-local", "copy.txt")
+
+			summary, _, err := c.Storage.GetStorageSummary()
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(summary.BinariesSummary.GetBinariesCount()).Equal("2")
+			g.Assert(summary.BinariesSummary.GetArtifactsCount()).Equal("3")
+			g.Assert(summary.BinariesSummary.GetArtifactsSize()).Equal("9 bytes")
+
+			list := *summary.RepositoriesSummaryList
+			g.Assert(list[0].GetRepoKey()).Equal("libs-local")
+			g.Assert(list[0].GetFoldersCount()).Equal(2)
+			g.Assert(list[len(list)-1].GetRepoKey()).Equal("TOTAL")
+		})
+	})
+}
+
+func Test_formatSize(t *testing.T) {
+	g := goblin.Goblin(t)
+	g.Describe("formatSize", func() {
+		g.It("- should format sizes like Artifactory", func() {
+			g.Assert(formatSize(512)).Equal("512 bytes")
+			g.Assert(formatSize(1536)).Equal("1.50 KB")
+			g.Assert(formatSize(3 << 30)).Equal("3.00 GB")
+			g.Assert(formatCount(2176580)).Equal("2,176,580")
+			g.Assert(percentage(1, 3)).Equal("33.33%")
+			g.Assert(percentage(1, 2)).Equal("50%")
+		})
+	})
+}
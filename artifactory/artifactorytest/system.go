@@ -0,0 +1,39 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifactorytest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/target/go-arty/v2/artifactory"
+)
+
+// Version is the Artifactory version reported by the Server.
+const Version = "7.77.0"
+
+// ping replies OK, like a healthy Artifactory.
+func (s *Server) ping(c *gin.Context) {
+	c.String(http.StatusOK, "OK")
+}
+
+// getVersion returns the version of the Server, without any add-ons.
+func (s *Server) getVersion(c *gin.Context) {
+	c.JSON(http.StatusOK, artifactory.Versions{
+		Version:  artifactory.String(Version),
+		Revision: artifactory.String("77700900"),
+		Addons:   &[]string{},
+	})
+}
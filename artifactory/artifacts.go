@@ -17,9 +17,20 @@
 package artifactory
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
 	"os"
+	"regexp"
+	"strconv"
 	"strings"
+
+	"github.com/target/go-arty/v2/internal/rest"
 )
 
 // ArtifactsService handles communication with the artifact related
@@ -51,33 +62,118 @@ func (a Artifacts) String() string {
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-RetrieveArtifact
 func (s *ArtifactsService) Download(repo, path string) (*[]byte, *Response, error) {
-	u := fmt.Sprintf("/%s/%s", repo, path)
-	v := new([]byte)
+	return s.DownloadWithContext(context.Background(), repo, path)
+}
 
-	resp, err := s.client.Call("GET", u, nil, v)
-	return v, resp, err
+// DownloadWithContext retrieves the provided artifact using the provided context.
+func (s *ArtifactsService) DownloadWithContext(ctx context.Context, repo, path string) (*[]byte, *Response, error) {
+	ctx = withOperation(ctx, "Artifacts.Download", "/{repo}/{path}")
+
+	buf := new(bytes.Buffer)
+
+	resp, err := s.downloadTo(ctx, repo, path, buf, nil)
+	v := buf.Bytes()
+	return &v, resp, err
+}
+
+// DownloadOptions represents the options for streaming an artifact from Artifactory.
+type DownloadOptions struct {
+	// Progress is called as the artifact is streamed, if set.
+	Progress ProgressFunc
+}
+
+// DownloadTo streams the provided artifact to w, without buffering it in memory.
+// The X-Checksum-Sha1 and X-Checksum-Sha256 response headers are verified
+// against the streamed content, and a *ChecksumError is returned if they differ.
+//
+// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-RetrieveArtifact
+func (s *ArtifactsService) DownloadTo(repo, path string, w io.Writer, opts *DownloadOptions) (*Response, error) {
+	return s.DownloadToWithContext(context.Background(), repo, path, w, opts)
+}
+
+// DownloadToWithContext streams the provided artifact to w using the provided context.
+func (s *ArtifactsService) DownloadToWithContext(ctx context.Context, repo, path string, w io.Writer, opts *DownloadOptions) (*Response, error) {
+	ctx = withOperation(ctx, "Artifacts.DownloadTo", "/{repo}/{path}")
+
+	return s.downloadTo(ctx, repo, path, w, opts)
+}
+
+// downloadTo streams the provided artifact to w.
+func (s *ArtifactsService) downloadTo(ctx context.Context, repo, path string, w io.Writer, opts *DownloadOptions) (*Response, error) {
+	body, resp, err := s.open(ctx, repo, path, opts)
+	if err != nil {
+		return resp, err
+	}
+
+	defer body.Close()
+
+	_, err = io.Copy(w, body)
+	return resp, err
+}
+
+// Open returns a reader streaming the provided artifact.
+// The caller must close the reader. Reading it to the end verifies the
+// X-Checksum-Sha1 and X-Checksum-Sha256 response headers against the
+// streamed content, and the final read returns a *ChecksumError if they differ.
+//
+// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-RetrieveArtifact
+func (s *ArtifactsService) Open(repo, path string, opts *DownloadOptions) (io.ReadCloser, *Response, error) {
+	return s.OpenWithContext(context.Background(), repo, path, opts)
+}
+
+// OpenWithContext returns a reader streaming the provided artifact using the provided context.
+// Cancelling ctx aborts any read in progress.
+func (s *ArtifactsService) OpenWithContext(ctx context.Context, repo, path string, opts *DownloadOptions) (io.ReadCloser, *Response, error) {
+	ctx = withOperation(ctx, "Artifacts.Open", "/{repo}/{path}")
+
+	return s.open(ctx, repo, path, opts)
+}
+
+// open returns a reader streaming the provided artifact.
+func (s *ArtifactsService) open(ctx context.Context, repo, path string, opts *DownloadOptions) (io.ReadCloser, *Response, error) {
+	u := fmt.Sprintf("/%s", itemPath(repo, path))
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := rest.Send(ctx, s.client.core, req)
+	if resp == nil {
+		return nil, nil, err
+	}
+
+	response := &Response{Response: resp}
+	if err != nil {
+		_ = resp.Body.Close()
+		return nil, response, err
+	}
+
+	body := &verifyingReader{
+		rc:    resp.Body,
+		sums:  responseChecksums(resp),
+		total: resp.ContentLength,
+	}
+
+	if opts != nil {
+		body.progress = opts.Progress
+	}
+
+	return body, response, nil
 }
 
 // Upload deploys the provided artifact to the provided repository.
+// The checksums of the artifact are sent along, so Artifactory can verify them.
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-DeployArtifact
 func (s *ArtifactsService) Upload(repo, path, source string, properties map[string][]string) (*string, *Response, error) {
-	var propertyString string
-	var index int
-	for k, v := range properties {
-		index++
-		if len(v) == 1 {
-			propertyString = propertyString + fmt.Sprintf("%s=%s", k, v[0])
-		} else {
-			propertyString = propertyString + fmt.Sprintf("%s=%s", k, strings.Join(v, ","))
-		}
+	return s.UploadWithContext(context.Background(), repo, path, source, properties)
+}
 
-		if index != len(properties) {
-			propertyString = propertyString + ";"
-		}
-	}
+// UploadWithContext deploys the provided artifact to the provided repository using the provided context.
+func (s *ArtifactsService) UploadWithContext(ctx context.Context, repo, path, source string, properties map[string][]string) (*string, *Response, error) {
+	ctx = withOperation(ctx, "Artifacts.Upload", "/{repo}/{path}")
 
-	u := fmt.Sprintf("/%s/%s;%s", repo, path, propertyString)
 	v := new(string)
 
 	data, err := os.Open(source)
@@ -86,39 +182,327 @@ func (s *ArtifactsService) Upload(repo, path, source string, properties map[stri
 	}
 	defer func() { _ = data.Close() }()
 
-	resp, err := s.client.Call("PUT", u, data, v)
+	resp, err := s.upload(ctx, repo, path, data, -1, &UploadOptions{Properties: properties}, v)
+	return v, resp, err
+}
+
+// UploadOptions represents the options for deploying an artifact to Artifactory.
+type UploadOptions struct {
+	// Properties are attached to the deployed artifact.
+	Properties map[string][]string
+
+	// ChecksumDeploy tries to deploy the artifact by checksum first,
+	// without sending its content. The content is only sent if
+	// Artifactory doesn't already store a binary with the same checksums.
+	ChecksumDeploy bool
+
+	// Progress is called as the content is sent, if set.
+	Progress ProgressFunc
+}
+
+// UploadReader deploys the content read from r to the provided repository.
+// size is the expected length of the content, or -1 if unknown.
+// The MD5, SHA-1 and SHA-256 checksums of the content are computed and sent
+// along, so Artifactory can verify them. Content that can't be rewound is
+// spooled to a temporary file while its checksums are computed.
+//
+// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-DeployArtifact
+//
+//	https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-DeployArtifactbyChecksum
+func (s *ArtifactsService) UploadReader(repo, path string, r io.Reader, size int64, opts *UploadOptions) (*File, *Response, error) {
+	return s.UploadReaderWithContext(context.Background(), repo, path, r, size, opts)
+}
+
+// UploadReaderWithContext deploys the content read from r to the provided repository using the provided context.
+func (s *ArtifactsService) UploadReaderWithContext(ctx context.Context, repo, path string, r io.Reader, size int64, opts *UploadOptions) (*File, *Response, error) {
+	ctx = withOperation(ctx, "Artifacts.UploadReader", "/{repo}/{path}")
+
+	return s.uploadReader(ctx, repo, path, r, size, opts)
+}
+
+// uploadReader deploys the content read from r, decoding the response into a File.
+func (s *ArtifactsService) uploadReader(ctx context.Context, repo, path string, r io.Reader, size int64, opts *UploadOptions) (*File, *Response, error) {
+	v := new(File)
+
+	resp, err := s.upload(ctx, repo, path, r, size, opts, v)
 	return v, resp, err
 }
 
+// upload deploys the content read from r, decoding the response into v.
+func (s *ArtifactsService) upload(ctx context.Context, repo, path string, r io.Reader, size int64, opts *UploadOptions, v interface{}) (*Response, error) {
+	if opts == nil {
+		opts = new(UploadOptions)
+	}
+
+	u := uploadURL(repo, path, opts.Properties)
+
+	// The content is read twice, once for its checksums and once to send it.
+	rs, ok := r.(io.ReadSeeker)
+	if !ok {
+		f, err := ioutil.TempFile("", "go-arty-upload-")
+		if err != nil {
+			return nil, err
+		}
+		defer func() {
+			_ = f.Close()
+			_ = os.Remove(f.Name())
+		}()
+
+		_, err = io.Copy(f, r)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = f.Seek(0, io.SeekStart)
+		if err != nil {
+			return nil, err
+		}
+
+		rs = f
+	}
+
+	sums, n, err := computeChecksums(rs)
+	if err != nil {
+		return nil, err
+	}
+
+	if size >= 0 && n != size {
+		return nil, fmt.Errorf("read %d bytes of content, expected %d", n, size)
+	}
+
+	if opts.ChecksumDeploy {
+		resp, err := s.deployChecksum(ctx, u, sums, v)
+		if !IsNotFound(err) {
+			return resp, err
+		}
+	}
+
+	var body io.Reader = rs
+	if opts.Progress != nil {
+		body = &progressReader{rs: rs, progress: opts.Progress, total: n}
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", u, body)
+	if err != nil {
+		return nil, err
+	}
+
+	setChecksumHeaders(req, sums)
+
+	return s.client.DoContext(ctx, req, v)
+}
+
+// uploadURL returns the deploy URL of the provided artifact with its properties.
+func uploadURL(repo, path string, properties map[string][]string) string {
+	return fmt.Sprintf("/%s%s", itemPath(repo, path), matrixParams(properties))
+}
+
+// deployChecksum deploys an artifact by checksum, without sending its content.
+// Artifactory replies 404 if it doesn't store the binary yet.
+func (s *ArtifactsService) deployChecksum(ctx context.Context, u string, sums *Checksums, v interface{}) (*Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	setChecksumHeaders(req, sums)
+	req.Header.Set("X-Checksum-Deploy", "true")
+
+	return s.client.DoContext(ctx, req, v)
+}
+
+// TransferOptions represents the options for copying or moving an artifact in Artifactory.
+type TransferOptions struct {
+	// DryRun only reports what would be copied or moved, without changing anything.
+	DryRun bool
+
+	// SuppressLayouts keeps the paths as they are, instead of translating
+	// them between the layouts of the source and target repositories.
+	SuppressLayouts bool
+
+	// FailFast stops at the first item that fails, instead of going on
+	// with the other items.
+	FailFast bool
+
+	// Flat only copies or moves the files directly under a source folder,
+	// leaving its subfolders out.
+	Flat bool
+}
+
 // Copy duplicates the provided artifact to the provided destination.
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-CopyItem
 func (s *ArtifactsService) Copy(sourceRepo, sourcePath, targetRepo, targetPath string) (*Artifacts, *Response, error) {
-	u := fmt.Sprintf("/api/copy/%s/%s?to=/%s/%s", sourceRepo, sourcePath, targetRepo, targetPath)
-	v := new(Artifacts)
+	return s.CopyWithContext(context.Background(), sourceRepo, sourcePath, targetRepo, targetPath)
+}
 
-	resp, err := s.client.Call("POST", u, nil, v)
-	return v, resp, err
+// CopyWithContext duplicates the provided artifact to the provided destination using the provided context.
+func (s *ArtifactsService) CopyWithContext(ctx context.Context, sourceRepo, sourcePath, targetRepo, targetPath string) (*Artifacts, *Response, error) {
+	ctx = withOperation(ctx, "Artifacts.Copy", "/api/copy/{sourceRepo}/{sourcePath}")
+
+	return s.transfer(ctx, "copy", sourceRepo, sourcePath, targetRepo, targetPath, nil)
+}
+
+// CopyItem duplicates the provided artifact, or folder, to the provided destination
+// with the provided options. The messages of the copy are returned along with an
+// error if some items failed, so that Result can tell them apart.
+//
+// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-CopyItem
+func (s *ArtifactsService) CopyItem(sourceRepo, sourcePath, targetRepo, targetPath string, opts *TransferOptions) (*Artifacts, *Response, error) {
+	return s.CopyItemWithContext(context.Background(), sourceRepo, sourcePath, targetRepo, targetPath, opts)
+}
+
+// CopyItemWithContext duplicates the provided artifact, or folder, to the provided destination
+// with the provided options using the provided context.
+func (s *ArtifactsService) CopyItemWithContext(ctx context.Context, sourceRepo, sourcePath, targetRepo, targetPath string, opts *TransferOptions) (*Artifacts, *Response, error) {
+	ctx = withOperation(ctx, "Artifacts.CopyItem", "/api/copy/{sourceRepo}/{sourcePath}")
+
+	return s.transfer(ctx, "copy", sourceRepo, sourcePath, targetRepo, targetPath, opts)
 }
 
 // Move migrates the provided artifact to the provided destination.
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-MoveItem
 func (s *ArtifactsService) Move(sourceRepo, sourcePath, targetRepo, targetPath string) (*Artifacts, *Response, error) {
-	u := fmt.Sprintf("/api/move/%s/%s?to=/%s/%s", sourceRepo, sourcePath, targetRepo, targetPath)
+	return s.MoveWithContext(context.Background(), sourceRepo, sourcePath, targetRepo, targetPath)
+}
+
+// MoveWithContext migrates the provided artifact to the provided destination using the provided context.
+func (s *ArtifactsService) MoveWithContext(ctx context.Context, sourceRepo, sourcePath, targetRepo, targetPath string) (*Artifacts, *Response, error) {
+	ctx = withOperation(ctx, "Artifacts.Move", "/api/move/{sourceRepo}/{sourcePath}")
+
+	return s.transfer(ctx, "move", sourceRepo, sourcePath, targetRepo, targetPath, nil)
+}
+
+// MoveItem migrates the provided artifact, or folder, to the provided destination
+// with the provided options. The messages of the move are returned along with an
+// error if some items failed, so that Result can tell them apart.
+//
+// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-MoveItem
+func (s *ArtifactsService) MoveItem(sourceRepo, sourcePath, targetRepo, targetPath string, opts *TransferOptions) (*Artifacts, *Response, error) {
+	return s.MoveItemWithContext(context.Background(), sourceRepo, sourcePath, targetRepo, targetPath, opts)
+}
+
+// MoveItemWithContext migrates the provided artifact, or folder, to the provided destination
+// with the provided options using the provided context.
+func (s *ArtifactsService) MoveItemWithContext(ctx context.Context, sourceRepo, sourcePath, targetRepo, targetPath string, opts *TransferOptions) (*Artifacts, *Response, error) {
+	ctx = withOperation(ctx, "Artifacts.MoveItem", "/api/move/{sourceRepo}/{sourcePath}")
+
+	return s.transfer(ctx, "move", sourceRepo, sourcePath, targetRepo, targetPath, opts)
+}
+
+// transfer copies or moves the provided artifact, as set by action.
+// The messages of an error response are decoded too.
+func (s *ArtifactsService) transfer(ctx context.Context, action, sourceRepo, sourcePath, targetRepo, targetPath string, opts *TransferOptions) (*Artifacts, *Response, error) {
+	if opts == nil {
+		opts = new(TransferOptions)
+	}
+
+	q := url.Values{}
+	q.Set("to", fmt.Sprintf("/%s/%s", targetRepo, targetPath))
+	if opts.DryRun {
+		q.Set("dry", "1")
+	}
+	if opts.SuppressLayouts {
+		q.Set("suppressLayouts", "1")
+	}
+	if opts.FailFast {
+		q.Set("failFast", "1")
+	}
+	if opts.Flat {
+		q.Set("recursive", "0")
+	}
+
+	u := fmt.Sprintf("/api/%s/%s?%s", action, itemPath(sourceRepo, sourcePath), q.Encode())
 	v := new(Artifacts)
 
-	resp, err := s.client.Call("POST", u, nil, v)
+	resp, err := s.client.CallContext(ctx, "POST", u, nil, v)
+
+	var errResp *ErrorResponse
+	if errors.As(err, &errResp) {
+		// Failed items are reported as messages, like the successful ones.
+		_ = json.Unmarshal(errResp.Body, v)
+	}
+
 	return v, resp, err
 }
 
+// TransferMessage represents a message about an item reported by a copy or a move.
+type TransferMessage struct {
+	Level   string
+	Message string
+
+	// Repo and Path locate the item the message is about, which is the
+	// first item named in the message. They are empty if it names none.
+	Repo string
+	Path string
+}
+
+// TransferResult represents the outcome of a copy or a move, parsed from its messages.
+type TransferResult struct {
+	// Successes are the messages about the items copied or moved,
+	// or that would be for a dry run.
+	Successes []TransferMessage
+	// Failures are the messages about the items that failed.
+	Failures []TransferMessage
+
+	// Artifacts and Folders count the items copied or moved,
+	// or that would be for a dry run.
+	Artifacts int
+	Folders   int
+}
+
+// transferItem matches the first item named in a message, like "repo:path"
+// or 'repo:path', without the punctuation following it.
+var transferItem = regexp.MustCompile(`'([^':\s]+):([^']*)'|([^':\s]+):(\S*?)[,;.]?(?:\s|$)`)
+
+// transferCounts matches the counts of items in a message.
+var transferCounts = regexp.MustCompile(`(\d+) artifacts and (\d+) folders were`)
+
+// Result parses the messages of a copy or a move into the successes and the
+// failures per item. Messages of level ERROR are failures.
+func (a *Artifacts) Result() *TransferResult {
+	r := new(TransferResult)
+
+	for _, m := range a.GetMessages() {
+		msg := TransferMessage{Level: m.GetLevel(), Message: m.GetMessage()}
+
+		if match := transferItem.FindStringSubmatch(msg.Message); match != nil {
+			msg.Repo, msg.Path = match[1]+match[3], match[2]+match[4]
+		}
+
+		if strings.EqualFold(msg.Level, "ERROR") {
+			r.Failures = append(r.Failures, msg)
+			continue
+		}
+
+		r.Successes = append(r.Successes, msg)
+
+		if match := transferCounts.FindStringSubmatch(msg.Message); match != nil {
+			artifacts, _ := strconv.Atoi(match[1])
+			folders, _ := strconv.Atoi(match[2])
+			r.Artifacts += artifacts
+			r.Folders += folders
+		}
+	}
+
+	return r
+}
+
 // Delete removes the provided artifact.
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-DeleteItem
 func (s *ArtifactsService) Delete(repo, path string) (*string, *Response, error) {
-	u := fmt.Sprintf("/%s/%s", repo, path)
+	return s.DeleteWithContext(context.Background(), repo, path)
+}
+
+// DeleteWithContext removes the provided artifact using the provided context.
+func (s *ArtifactsService) DeleteWithContext(ctx context.Context, repo, path string) (*string, *Response, error) {
+	ctx = withOperation(ctx, "Artifacts.Delete", "/{repo}/{path}")
+
+	u := fmt.Sprintf("/%s", itemPath(repo, path))
 	v := new(string)
 
-	resp, err := s.client.Call("DELETE", u, nil, v)
+	resp, err := s.client.CallContext(ctx, "DELETE", u, nil, v)
 	return v, resp, err
 }
@@ -17,7 +17,13 @@
 package artifactory
 
 import (
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
+	"io"
 	"io/ioutil"
 	"net/http/httptest"
 	"os"
@@ -88,6 +94,80 @@ func Test_Artifacts(t *testing.T) {
 				g.Assert(err == nil).IsTrue()
 			})
 
+			g.It("- should return no error with DownloadWithContext()", func() {
+				actual, resp, err := c.Artifacts.DownloadWithContext(context.Background(), "local-repo1", "foo.txt")
+				g.Assert(actual != nil).IsTrue()
+				g.Assert(resp != nil).IsTrue()
+				g.Assert(err == nil).IsTrue()
+			})
+
+			g.It("- should return an error with DownloadWithContext() using a cancelled context", func() {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+
+				_, resp, err := c.Artifacts.DownloadWithContext(ctx, "local-repo1", "foo.txt")
+				g.Assert(resp == nil).IsTrue()
+				g.Assert(err == context.Canceled).IsTrue()
+			})
+
+			g.It("- should return the file content with Download()", func() {
+				actual, _, err := c.Artifacts.Download("local-repo1", "foo.txt")
+
+				expected, _ := ioutil.ReadFile("fixtures/artifacts/foo.txt")
+
+				g.Assert(err == nil).IsTrue()
+				g.Assert(*actual).Equal(expected)
+			})
+
+			g.It("- should stream the file with DownloadTo()", func() {
+				var progress []int64
+				opts := &DownloadOptions{
+					Progress: func(transferred, total int64) {
+						progress = append(progress, transferred)
+					},
+				}
+
+				buf := new(bytes.Buffer)
+				resp, err := c.Artifacts.DownloadTo("local-repo1", "foo.txt", buf, opts)
+
+				expected, _ := ioutil.ReadFile("fixtures/artifacts/foo.txt")
+
+				g.Assert(resp != nil).IsTrue()
+				g.Assert(err == nil).IsTrue()
+				g.Assert(buf.Bytes()).Equal(expected)
+				g.Assert(progress[len(progress)-1]).Equal(int64(len(expected)))
+			})
+
+			g.It("- should return a checksum error with DownloadTo()", func() {
+				_, err := c.Artifacts.DownloadTo("local-repo1", "corrupt.txt", ioutil.Discard, nil)
+
+				var actual *ChecksumError
+				g.Assert(errors.As(err, &actual)).IsTrue()
+				g.Assert(actual.Algorithm).Equal("sha1")
+				g.Assert(actual.Expected).Equal("0000000000000000000000000000000000000000")
+			})
+
+			g.It("- should return an error with DownloadTo() for a missing repository", func() {
+				resp, err := c.Artifacts.DownloadTo("not-found", "foo.txt", ioutil.Discard, nil)
+
+				g.Assert(resp.StatusCode).Equal(404)
+				g.Assert(IsNotFound(err)).IsTrue()
+			})
+
+			g.It("- should stream the file with Open()", func() {
+				body, resp, err := c.Artifacts.Open("local-repo1", "foo.txt", nil)
+				g.Assert(resp != nil).IsTrue()
+				g.Assert(err == nil).IsTrue()
+
+				defer body.Close()
+
+				actual, err := ioutil.ReadAll(body)
+				expected, _ := ioutil.ReadFile("fixtures/artifacts/foo.txt")
+
+				g.Assert(err == nil).IsTrue()
+				g.Assert(actual).Equal(expected)
+			})
+
 			g.It("- should return no error with Upload() using 1 property", func() {
 				actual, resp, err := c.Artifacts.Upload("local-repo1", "folder/fixtures/artifacts/foo.txt", "fixtures/artifacts/foo.txt", map[string][]string{"key": []string{"value"}})
 				g.Assert(actual != nil).IsTrue()
@@ -122,6 +202,67 @@ func Test_Artifacts(t *testing.T) {
 				g.Assert(err == nil).IsTrue()
 			})
 
+			g.It("- should send checksums with UploadReader()", func() {
+				content := "some content"
+				sum := sha1.Sum([]byte(content))
+
+				var progress int64
+				opts := &UploadOptions{
+					Properties: map[string][]string{"key": {"value"}},
+					Progress: func(transferred, total int64) {
+						progress = transferred
+					},
+				}
+
+				actual, resp, err := c.Artifacts.UploadReader("local-repo1", "folder/bar.txt", strings.NewReader(content), int64(len(content)), opts)
+				g.Assert(err == nil).IsTrue()
+				g.Assert(resp.StatusCode).Equal(201)
+				g.Assert(resp.Request.URL.Path).Equal("/local-repo1/folder/bar.txt;key=value")
+				g.Assert(resp.Request.Header.Get("X-Checksum-Sha1")).Equal(hex.EncodeToString(sum[:]))
+				g.Assert(len(resp.Request.Header.Get("X-Checksum-Sha256"))).Equal(64)
+				g.Assert(len(resp.Request.Header.Get("X-Checksum-Md5"))).Equal(32)
+				g.Assert(actual.GetChecksums().GetSHA1()).Equal(hex.EncodeToString(sum[:]))
+				g.Assert(progress).Equal(int64(len(content)))
+			})
+
+			g.It("- should spool a non seekable reader with UploadReader()", func() {
+				content := "streamed content"
+				sum := sha1.Sum([]byte(content))
+
+				r := io.MultiReader(strings.NewReader("streamed "), strings.NewReader("content"))
+
+				actual, _, err := c.Artifacts.UploadReader("local-repo1", "folder/bar.txt", r, -1, nil)
+				g.Assert(err == nil).IsTrue()
+				g.Assert(actual.GetChecksums().GetSHA1()).Equal(hex.EncodeToString(sum[:]))
+			})
+
+			g.It("- should return an error with UploadReader() when the size doesn't match", func() {
+				_, resp, err := c.Artifacts.UploadReader("local-repo1", "folder/bar.txt", strings.NewReader("short"), 100, nil)
+				g.Assert(resp == nil).IsTrue()
+				g.Assert(err != nil).IsTrue()
+			})
+
+			g.It("- should deploy by checksum with UploadReader()", func() {
+				data, _ := os.Open("fixtures/artifacts/foo.txt")
+				defer data.Close()
+
+				actual, resp, err := c.Artifacts.UploadReader("local-repo1", "folder/foo.txt", data, -1, &UploadOptions{ChecksumDeploy: true})
+				g.Assert(err == nil).IsTrue()
+				g.Assert(resp.StatusCode).Equal(201)
+				g.Assert(resp.Request.Header.Get("X-Checksum-Deploy")).Equal("true")
+				g.Assert(resp.Request.ContentLength).Equal(int64(0))
+				g.Assert(actual.GetRepo()).Equal("local-repo1")
+			})
+
+			g.It("- should send the content with UploadReader() when the checksum deploy fails", func() {
+				actual, resp, err := c.Artifacts.UploadReader("local-repo1", "folder/bar.txt", strings.NewReader("new content"), -1, &UploadOptions{ChecksumDeploy: true})
+				g.Assert(err == nil).IsTrue()
+				g.Assert(resp.StatusCode).Equal(201)
+				g.Assert(resp.Request.Header.Get("X-Checksum-Deploy")).Equal("")
+				g.Assert(resp.Request.ContentLength).Equal(int64(len("new content")))
+				g.Assert(actual.GetPath()).Equal("/folder/bar.txt")
+			})
+
 			g.It("- should return no error with Copy()", func() {
 				actual, resp, err := c.Artifacts.Copy("local-repo1", "folder/foo.txt", "local-repo1", "test/foo.txt")
 				g.Assert(actual != nil).IsTrue()
@@ -136,6 +277,51 @@ func Test_Artifacts(t *testing.T) {
 				g.Assert(err == nil).IsTrue()
 			})
 
+			g.It("- should send the options with CopyItem()", func() {
+				opts := &TransferOptions{DryRun: true, SuppressLayouts: true, FailFast: true, Flat: true}
+
+				actual, resp, err := c.Artifacts.CopyItem("local-repo1", "folder/foo.txt", "local-repo1", "test/foo.txt", opts)
+				g.Assert(err == nil).IsTrue()
+
+				q := resp.Request.URL.Query()
+				g.Assert(q.Get("to")).Equal("/local-repo1/test/foo.txt")
+				g.Assert(q.Get("dry")).Equal("1")
+				g.Assert(q.Get("suppressLayouts")).Equal("1")
+				g.Assert(q.Get("failFast")).Equal("1")
+				g.Assert(q.Get("recursive")).Equal("0")
+
+				result := actual.Result()
+				g.Assert(result.Artifacts).Equal(1)
+				g.Assert(result.Folders).Equal(0)
+				g.Assert(result.Successes[0].Repo).Equal("local-repo1")
+				g.Assert(result.Successes[0].Path).Equal("folder/foo.txt")
+				g.Assert(len(result.Failures)).Equal(0)
+			})
+
+			g.It("- should not send the default options with MoveItem()", func() {
+				actual, resp, err := c.Artifacts.MoveItem("local-repo1", "folder/foo.txt", "local-repo1", "test/foo.txt", nil)
+				g.Assert(err == nil).IsTrue()
+				g.Assert(resp.Request.URL.RawQuery).Equal("to=%2Flocal-repo1%2Ftest%2Ffoo.txt")
+				g.Assert(actual.Result().Artifacts).Equal(1)
+			})
+
+			g.It("- should parse the failures with Result()", func() {
+				actual := &Artifacts{Messages: &[]ArtifactMessage{
+					{Level: String("ERROR"), Message: String("Can't move 'libs-local:org/app.jar' to 'other-local:org/app.jar': Not enough permissions")},
+					{Level: String("WARNING"), Message: String("Skipping libs-local:org/app.pom, it is excluded")},
+					{Level: String("INFO"), Message: String("moving libs-local:org to other-local:org completed successfully, 3 artifacts and 2 folders were moved")},
+				}}
+
+				result := actual.Result()
+				g.Assert(len(result.Failures)).Equal(1)
+				g.Assert(result.Failures[0].Repo).Equal("libs-local")
+				g.Assert(result.Failures[0].Path).Equal("org/app.jar")
+				g.Assert(len(result.Successes)).Equal(2)
+				g.Assert(result.Successes[0].Path).Equal("org/app.pom")
+				g.Assert(result.Artifacts).Equal(3)
+				g.Assert(result.Folders).Equal(2)
+			})
+
 			g.It("- should return no error with Delete()", func() {
 				actual, resp, err := c.Artifacts.Delete("local-repo1", "foo.txt")
 				g.Assert(actual != nil).IsTrue()
@@ -16,45 +16,31 @@
 
 package artifactory
 
-const (
-	// HTTP Basic Authentication
-	authTypeBasic = 1
-	// Auth via API Token
-	authTypeToken = 2
+import (
+	"time"
+
+	"github.com/target/go-arty/v2/internal/rest"
 )
 
+// authentication holds the credentials applied to the requests of the Client.
+type authentication = rest.Authentication
+
 // AuthenticationService contains authentication related functions.
 type AuthenticationService struct {
-	client   *Client
-	username *string
-	secret   *string
-	authType int
-}
+	*authentication
 
-// SetBasicAuth sets the auth type as HTTP Basic auth.
-func (s *AuthenticationService) SetBasicAuth(username, password string) {
-	s.username = String(username)
-	s.secret = String(password)
-	s.authType = authTypeBasic
+	client *Client
 }
 
-// SetTokenAuth sets the auth type as Token auth.
-func (s *AuthenticationService) SetTokenAuth(token string) {
-	s.secret = String(token)
-	s.authType = authTypeToken
-}
+// SetRefreshTokenAuth sets the auth type as Bearer auth with an access token
+// that is refreshed through the /api/security/token endpoint of this
+// Artifactory instance before it expires.
+func (s *AuthenticationService) SetRefreshTokenAuth(accessToken, refreshToken string, expiry time.Time) {
+	// The path is a constant, so building the URL cannot fail.
+	u, _ := rest.BuildURL(s.client.core, "/api/security/token")
 
-// HasAuth checks if the auth type is set.
-func (s *AuthenticationService) HasAuth() bool {
-	return s.authType > 0
-}
-
-// HasBasicAuth checks if the auth type is HTTP Basic auth.
-func (s *AuthenticationService) HasBasicAuth() bool {
-	return s.authType == authTypeBasic
-}
+	ts := NewRefreshTokenSource(u, accessToken, refreshToken, expiry)
+	ts.HTTPClient = rest.HTTPClient(s.client.core)
 
-// HasTokenAuth checks if the auth type is Token auth.
-func (s *AuthenticationService) HasTokenAuth() bool {
-	return s.authType == authTypeToken
+	s.SetTokenSource(ts)
 }
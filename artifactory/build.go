@@ -1,6 +1,9 @@
 package artifactory
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // BuildService handles communication with the builds related
 // methods of the Artifactory API.
@@ -55,9 +58,16 @@ func (b Build) String() string {
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-BuildInfo
 func (s *BuildService) GetInfo(name, number string) (*Build, *Response, error) {
-	u := fmt.Sprintf("/api/build/%s/%s", name, number)
+	return s.GetInfoWithContext(context.Background(), name, number)
+}
+
+// GetInfoWithContext retrieves the provided build using the provided context.
+func (s *BuildService) GetInfoWithContext(ctx context.Context, name, number string) (*Build, *Response, error) {
+	ctx = withOperation(ctx, "Build.GetInfo", "/api/build/{name}/{number}")
+
+	u := fmt.Sprintf("/api/build/%s/%s", escapePath(name), escapePath(number))
 	v := new(Build)
 
-	resp, err := s.client.Call("GET", u, nil, v)
+	resp, err := s.client.CallContext(ctx, "GET", u, nil, v)
 	return v, resp, err
 }
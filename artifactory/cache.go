@@ -0,0 +1,64 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifactory
+
+import (
+	"context"
+	"time"
+
+	"github.com/target/go-arty/v2/internal/rest"
+)
+
+// CachedResponse represents a response stored in a Cache.
+type CachedResponse = rest.CachedResponse
+
+// Cache stores the responses of the GET requests sent by a Client.
+// It must be safe for concurrent use.
+type Cache = rest.Cache
+
+// WithCache caches the responses of the GET requests decoded by the Client in
+// the provided Cache, or in an in-memory LRU cache of 1000 responses if nil.
+// Streamed downloads are never cached.
+//
+// Requests for a cached response carry its validators in If-None-Match and
+// If-Modified-Since headers, and the cached response is returned if the server
+// replies 304 Not Modified. Responses are keyed by URL, so a Cache shouldn't be
+// shared by clients with different credentials.
+func WithCache(cache Cache) Option {
+	return rest.WithCache(cache)
+}
+
+// WithCacheTTL returns cached responses without contacting the server until
+// they are older than ttl, which also caches responses without validators.
+// Responses are cached in an in-memory LRU cache of 1000 responses, unless
+// another Cache is set with WithCache.
+func WithCacheTTL(ttl time.Duration) Option {
+	return rest.WithCacheTTL(ttl)
+}
+
+// ContextWithoutCache returns a context for calls sent to the server even if their
+// response is cached. The cache is updated with the responses of the calls.
+func ContextWithoutCache(ctx context.Context) context.Context {
+	return rest.ContextWithoutCache(ctx)
+}
+
+// LRUCache is an in-memory Cache holding a limited number of responses.
+// The least recently used response is evicted to store a new one.
+type LRUCache = rest.LRUCache
+
+// NewLRUCache returns a new LRUCache holding up to size responses.
+func NewLRUCache(size int) *LRUCache {
+	return rest.NewLRUCache(size)
+}
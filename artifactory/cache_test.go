@@ -0,0 +1,199 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifactory
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/franela/goblin"
+)
+
+func Test_Cache(t *testing.T) {
+	var (
+		requests    int
+		conditional int
+		version     int
+	)
+
+	// Create http test server with validated, unvalidated and failing resources
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/api/storage"):
+			etag := fmt.Sprintf(`"v%d"`, version)
+			w.Header().Set("ETag", etag)
+
+			if r.Header.Get("If-None-Match") == etag {
+				conditional++
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+
+			fmt.Fprintf(w, `{"repo":"libs-release-local","path":"/org","uri":"v%d"}`, version)
+		case r.URL.Path == "/api/security/groups":
+			modified := time.Date(2018, 1, 1, 0, 0, version, 0, time.UTC).Format(http.TimeFormat)
+			w.Header().Set("Last-Modified", modified)
+
+			if r.Header.Get("If-Modified-Since") == modified {
+				conditional++
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+
+			w.Write([]byte(`[{"name":"readers"}]`))
+		case r.URL.Path == "/api/repositories":
+			fmt.Fprintf(w, `[{"key":"repo%d"}]`, version)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	g := goblin.Goblin(t)
+	g.Describe("Cache", func() {
+		g.BeforeEach(func() {
+			requests, conditional, version = 0, 0, 0
+		})
+
+		// Close http test server after we're done using it
+		g.After(func() {
+			s.Close()
+		})
+
+		g.It("- should return the cached value when not modified", func() {
+			c, _ := NewClient(s.URL, nil, WithCache(nil))
+
+			_, _, err := c.Storage.GetFolder("libs-release-local", "org")
+			g.Assert(err == nil).IsTrue()
+
+			actual, resp, err := c.Storage.GetFolder("libs-release-local", "org")
+			g.Assert(err == nil).IsTrue()
+			g.Assert(actual.GetURI()).Equal("v0")
+			g.Assert(resp.Header.Get("X-From-Cache")).Equal("1")
+			g.Assert(requests).Equal(2)
+			g.Assert(conditional).Equal(1)
+
+			version++
+
+			actual, resp, err = c.Storage.GetFolder("libs-release-local", "org")
+			g.Assert(err == nil).IsTrue()
+			g.Assert(actual.GetURI()).Equal("v1")
+			g.Assert(resp.Header.Get("X-From-Cache")).Equal("")
+			g.Assert(conditional).Equal(1)
+		})
+
+		g.It("- should revalidate with Last-Modified", func() {
+			c, _ := NewClient(s.URL, nil, WithCache(nil))
+
+			_, _, err := c.Groups.GetAll()
+			g.Assert(err == nil).IsTrue()
+
+			actual, _, err := c.Groups.GetAll()
+			g.Assert(err == nil).IsTrue()
+			g.Assert(len(*actual)).Equal(1)
+			g.Assert((*actual)[0].GetName()).Equal("readers")
+			g.Assert(conditional).Equal(1)
+		})
+
+		g.It("- should not cache responses without validators", func() {
+			c, _ := NewClient(s.URL, nil, WithCache(nil))
+
+			_, _, _ = c.Repositories.GetAll()
+			version++
+
+			actual, _, err := c.Repositories.GetAll()
+			g.Assert(err == nil).IsTrue()
+			g.Assert((*actual)[0].GetKey()).Equal("repo1")
+			g.Assert(requests).Equal(2)
+		})
+
+		g.It("- should serve responses until they expire", func() {
+			c, _ := NewClient(s.URL, nil, WithCacheTTL(50*time.Millisecond))
+
+			_, _, _ = c.Repositories.GetAll()
+			version++
+
+			actual, _, err := c.Repositories.GetAll()
+			g.Assert(err == nil).IsTrue()
+			g.Assert((*actual)[0].GetKey()).Equal("repo0")
+			g.Assert(requests).Equal(1)
+
+			time.Sleep(60 * time.Millisecond)
+
+			actual, _, err = c.Repositories.GetAll()
+			g.Assert(err == nil).IsTrue()
+			g.Assert((*actual)[0].GetKey()).Equal("repo1")
+			g.Assert(requests).Equal(2)
+		})
+
+		g.It("- should bypass the cache when asked to", func() {
+			c, _ := NewClient(s.URL, nil, WithCacheTTL(time.Hour))
+
+			_, _, _ = c.Repositories.GetAll()
+			version++
+
+			actual, _, err := c.Repositories.GetAllWithContext(ContextWithoutCache(context.Background()))
+			g.Assert(err == nil).IsTrue()
+			g.Assert((*actual)[0].GetKey()).Equal("repo1")
+
+			actual, _, err = c.Repositories.GetAll()
+			g.Assert(err == nil).IsTrue()
+			g.Assert((*actual)[0].GetKey()).Equal("repo1")
+			g.Assert(requests).Equal(2)
+		})
+
+		g.It("- should not cache errors and streams", func() {
+			c, _ := NewClient(s.URL, nil, WithCacheTTL(time.Hour))
+
+			_, _, err := c.Repositories.Get("missing")
+			g.Assert(IsNotFound(err)).IsTrue()
+
+			_, _, err = c.Repositories.Get("missing")
+			g.Assert(IsNotFound(err)).IsTrue()
+
+			req, _ := c.NewRequest("GET", "/api/repositories", nil)
+			_, _ = c.Do(req, new(bytes.Buffer))
+			req, _ = c.NewRequest("GET", "/api/repositories", nil)
+			_, _ = c.Do(req, new(bytes.Buffer))
+
+			g.Assert(requests).Equal(4)
+		})
+
+		g.It("- should evict the least recently used responses", func() {
+			cache := NewLRUCache(2)
+
+			cache.Set("a", &CachedResponse{})
+			cache.Set("b", &CachedResponse{})
+			_, _ = cache.Get("a")
+			cache.Set("c", &CachedResponse{})
+
+			_, a := cache.Get("a")
+			_, b := cache.Get("b")
+			_, c := cache.Get("c")
+
+			g.Assert(a).IsTrue()
+			g.Assert(b).IsFalse()
+			g.Assert(c).IsTrue()
+			g.Assert(cache.Len()).Equal(2)
+		})
+	})
+}
@@ -0,0 +1,189 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifactory
+
+import (
+	"context"
+	"crypto/md5"  //nolint:gosec // Artifactory identifies binaries by MD5
+	"crypto/sha1" //nolint:gosec // Artifactory identifies binaries by SHA-1
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// ProgressFunc is called as content is transferred.
+// transferred is the number of bytes transferred so far and
+// total is the expected number of bytes, or -1 if unknown.
+type ProgressFunc func(transferred, total int64)
+
+// ChecksumError reports that the checksum of transferred content
+// does not match the checksum reported by Artifactory.
+type ChecksumError struct {
+	// Algorithm is the checksum algorithm, like "sha1" or "sha256".
+	Algorithm string
+	Expected  string
+	Actual    string
+}
+
+func (e *ChecksumError) Error() string {
+	return fmt.Sprintf("%s checksum mismatch: expected %s, got %s", e.Algorithm, e.Expected, e.Actual)
+}
+
+// checksum is a hash computed over content and the value it must match.
+type checksum struct {
+	algorithm string
+	expected  string
+	hash      hash.Hash
+}
+
+// verify checks if the computed hash matches the expected value.
+func (c *checksum) verify() error {
+	actual := hex.EncodeToString(c.hash.Sum(nil))
+	if !strings.EqualFold(actual, c.expected) {
+		return &ChecksumError{Algorithm: c.algorithm, Expected: c.expected, Actual: actual}
+	}
+
+	return nil
+}
+
+// responseChecksums returns the checksums to verify from the
+// X-Checksum-Sha1 and X-Checksum-Sha256 headers of the provided response.
+func responseChecksums(resp *http.Response) []*checksum {
+	var sums []*checksum
+
+	if v := resp.Header.Get("X-Checksum-Sha1"); len(v) > 0 {
+		sums = append(sums, &checksum{algorithm: "sha1", expected: v, hash: sha1.New()}) //nolint:gosec
+	}
+
+	if v := resp.Header.Get("X-Checksum-Sha256"); len(v) > 0 {
+		sums = append(sums, &checksum{algorithm: "sha256", expected: v, hash: sha256.New()})
+	}
+
+	return sums
+}
+
+// verifyingReader hashes the content read from the underlying reader,
+// reports progress, and verifies the checksums once the content is read.
+type verifyingReader struct {
+	rc       io.ReadCloser
+	sums     []*checksum
+	progress ProgressFunc
+	read     int64
+	total    int64
+}
+
+func (r *verifyingReader) Read(p []byte) (int, error) {
+	n, err := r.rc.Read(p)
+	if n > 0 {
+		for _, sum := range r.sums {
+			sum.hash.Write(p[:n])
+		}
+
+		r.read += int64(n)
+		if r.progress != nil {
+			r.progress(r.read, r.total)
+		}
+	}
+
+	if err == io.EOF {
+		for _, sum := range r.sums {
+			if verr := sum.verify(); verr != nil {
+				return n, verr
+			}
+		}
+	}
+
+	return n, err
+}
+
+func (r *verifyingReader) Close() error {
+	return r.rc.Close()
+}
+
+// computeChecksums reads rs to the end to compute its checksums, then rewinds
+// it to where it started. It returns the checksums and the number of bytes read.
+func computeChecksums(rs io.ReadSeeker) (*Checksums, int64, error) {
+	start, err := rs.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	md5sum, sha1sum, sha256sum := md5.New(), sha1.New(), sha256.New() //nolint:gosec
+
+	n, err := io.Copy(io.MultiWriter(md5sum, sha1sum, sha256sum), rs)
+	if err != nil {
+		return nil, n, err
+	}
+
+	_, err = rs.Seek(start, io.SeekStart)
+	if err != nil {
+		return nil, n, err
+	}
+
+	sums := &Checksums{
+		MD5:    String(hex.EncodeToString(md5sum.Sum(nil))),
+		SHA1:   String(hex.EncodeToString(sha1sum.Sum(nil))),
+		SHA256: String(hex.EncodeToString(sha256sum.Sum(nil))),
+	}
+
+	return sums, n, nil
+}
+
+// setChecksumHeaders adds the provided checksums to a deploy request.
+func setChecksumHeaders(req *http.Request, sums *Checksums) {
+	req.Header.Set("X-Checksum-Md5", sums.GetMD5())
+	req.Header.Set("X-Checksum-Sha1", sums.GetSHA1())
+	req.Header.Set("X-Checksum-Sha256", sums.GetSHA256())
+}
+
+// progressReader reports progress as content is read from a seekable source.
+// Seeking restarts the count, so a replayed request body reports from zero.
+type progressReader struct {
+	rs       io.ReadSeeker
+	progress ProgressFunc
+	read     int64
+	total    int64
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.rs.Read(p)
+	if n > 0 {
+		r.read += int64(n)
+		r.progress(r.read, r.total)
+	}
+
+	return n, err
+}
+
+func (r *progressReader) Seek(offset int64, whence int) (int64, error) {
+	r.read = 0
+	return r.rs.Seek(offset, whence)
+}
+
+// freshFileInfo returns the provided file with the checksums currently stored
+// by Artifactory, so it's never read from the cache of the Client.
+func (s *ArtifactsService) freshFileInfo(ctx context.Context, repo, path string) (*File, *Response, error) {
+	return s.client.Storage.GetFileWithContext(ContextWithoutCache(ctx), repo, path)
+}
+
+// freshFileList lists the files under the provided folder with the checksums
+// currently stored by Artifactory, so it's never read from the cache of the Client.
+func (s *ArtifactsService) freshFileList(ctx context.Context, repo, path string) (*FileList, *Response, error) {
+	return s.client.Storage.GetFileListWithContext(ContextWithoutCache(ctx), repo, path)
+}
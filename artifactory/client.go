@@ -17,78 +17,63 @@
 package artifactory
 
 import (
-	"bytes"
-	"encoding/json"
-	"fmt"
-	"io"
-	"io/ioutil"
 	"net/http"
-	"net/url"
-	"reflect"
-	"strings"
 
-	"github.com/google/go-querystring/query"
+	"github.com/target/go-arty/v2/internal/rest"
 )
 
-const (
-	userAgent = "go-arty"
-)
+// core is the request pipeline the Client builds on.
+type core = rest.Client
 
 // Client is a client that manages communication with the Artifactory API.
+//
+// The User agent used when communicating with the Artifactory API and the
+// RetryPolicy for failed requests are set on the embedded core.
 type Client struct {
-	// HTTP client used to communicate with the Artifactory API.
-	client *http.Client
-
-	// Base URL for Artifactory API requests.
-	baseURL *url.URL
-
-	// User agent used when communicating with the Artifactory API.
-	UserAgent string
+	*core
 
 	// Artifactory service for authentication.
 	Authentication *AuthenticationService
-	Artifacts      *ArtifactsService
-	Build          *BuildService
-	Docker         *DockerService
-	Groups         *GroupsService
-	Licenses       *LicensesService
-	Permissions    *PermissionsService
-	PermissionsV2  *PermissionsServiceV2
-	Replications   *ReplicationsService
-	Repositories   *RepositoriesService
-	Search         *SearchService
-	Storage        *StorageService
-	System         *SystemService
-	Users          *UsersService
+	Artifacts      ArtifactsAPI
+	Build          BuildAPI
+	Docker         DockerAPI
+	Groups         GroupsAPI
+	Licenses       LicensesAPI
+	Permissions    PermissionsAPI
+	PermissionsV2  PermissionsV2API
+	Replications   ReplicationsAPI
+	Repositories   RepositoriesAPI
+	Search         SearchAPI
+	Storage        StorageAPI
+	System         SystemAPI
+	Users          UsersAPI
 }
 
 type service struct {
 	client *Client
 }
 
+// config describes Artifactory to the request pipeline.
+var config = rest.Config{
+	Product:         "Artifactory",
+	TokenAuth:       addTokenAuthentication,
+	HealthPath:      "/api/system/ping",
+	EndpointClasses: endpointClasses,
+}
+
 // NewClient returns a new Artifactory API client.
 // baseUrl has to be the HTTP endpoint of the Artifactory API.
 // If no httpClient is provided, then the http.DefaultClient will be used.
-func NewClient(baseUrl string, httpClient *http.Client) (*Client, error) {
-	if httpClient == nil {
-		httpClient = http.DefaultClient
-	}
-
-	if len(baseUrl) == 0 {
-		return nil, fmt.Errorf("No Artifactory baseUrl provided")
-	}
-	baseURL, err := url.Parse(baseUrl)
+// The provided options configure a copy of the httpClient, leaving it untouched.
+func NewClient(baseUrl string, httpClient *http.Client, opts ...Option) (*Client, error) {
+	base, err := rest.New(baseUrl, httpClient, config, opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	c := &Client{
-		client:    httpClient,
-		baseURL:   baseURL,
-		UserAgent: userAgent,
-	}
+	c := &Client{core: base}
 
-	c.Authentication = &AuthenticationService{client: c}
+	c.Authentication = &AuthenticationService{authentication: rest.Auth(base), client: c}
 	c.Artifacts = &ArtifactsService{client: c}
 	c.Build = &BuildService{client: c}
 	c.Docker = &DockerService{client: c}
@@ -106,182 +91,11 @@ func NewClient(baseUrl string, httpClient *http.Client) (*Client, error) {
 	return c, nil
 }
 
-// buildURLForRequest will build the URL (as a string) that will be called.
-// It does several cleaning tasks for us.
-func (c *Client) buildURLForRequest(urlStr string) (string, error) {
-	u := c.baseURL.String()
-
-	// If there is no / at the end, add one.
-	if strings.HasSuffix(u, "/") == false {
-		u += "/"
-	}
-
-	// If there is a "/" at the start, remove it.
-	if strings.HasPrefix(urlStr, "/") == true {
-		urlStr = urlStr[1:]
-	}
-
-	rel, err := url.Parse(urlStr)
-	if err != nil {
-		return "", err
-	}
-	u += rel.String()
-
-	return u, nil
-}
-
-// addAuthentication adds the necessary authentication to the request.
-func (c *Client) addAuthentication(req *http.Request) {
-	// Apply HTTP Basic Authentication.
-	if c.Authentication.HasBasicAuth() {
-		req.SetBasicAuth(*c.Authentication.username, *c.Authentication.secret)
-
-		// Apply Token Authentication.
-	} else if c.Authentication.HasTokenAuth() {
-		req.Header.Add("X-JFrog-Art-Api", *c.Authentication.secret)
-	}
-}
-
-// addOptions adds the parameters in opt as URL query parameters to s.
-// opt must be a struct whose fields may contain "url" tags.
-func addOptions(s string, opt interface{}) (string, error) {
-	v := reflect.ValueOf(opt)
-	if v.Kind() == reflect.Ptr && v.IsNil() {
-		return s, nil
-	}
-
-	u, err := url.Parse(s)
-	if err != nil {
-		return s, err
-	}
-
-	qs, err := query.Values(opt)
-	if err != nil {
-		return s, err
-	}
-
-	u.RawQuery = qs.Encode()
-	return u.String(), nil
-}
-
-// NewRequest creates an API request.
-// A relative URL can be provided in urlStr,
-// in which case it is resolved relative to the baseURL of the Client.
-// Relative URLs should always be specified without a preceding slash.
-// If specified, the value pointed to by body is JSON encoded and included as the request body.
-func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Request, error) {
-	u, err := c.buildURLForRequest(urlStr)
-	if err != nil {
-		return nil, err
-	}
-
-	var buf io.ReadWriter
-	if body != nil {
-		buf = new(bytes.Buffer)
-		err := json.NewEncoder(buf).Encode(body)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	req, err := http.NewRequest(method, u, buf)
-	if err != nil {
-		return nil, err
-	}
-
-	// Apply Authentication.
-	if c.Authentication.HasAuth() {
-		c.addAuthentication(req)
-	}
-
-	req.Header.Add("Content-Type", "application/json")
-
-	return req, nil
+// addTokenAuthentication applies Token Authentication to the request.
+func addTokenAuthentication(req *http.Request, token string) {
+	req.Header.Add("X-JFrog-Art-Api", token)
 }
 
 // Response represents an Artifactory API response.
 // This wraps the standard http.Response returned from Artifactory.
-type Response struct {
-	*http.Response
-}
-
-// Call is a combine function for Client.NewRequest and Client.Do.
-//
-// Most API methods are quite the same.
-// Get the URL, apply options, make a request, and get the response.
-// Without adding special headers or something.
-// To avoid a big amount of code duplication you can Client.Call.
-//
-// method is the HTTP method you want to call.
-// u is the URL you want to call.
-// body is the HTTP body.
-// v is the HTTP response.
-//
-// For more information read https://github.com/google/go-github/issues/234
-func (c *Client) Call(method, u string, body interface{}, v interface{}) (*Response, error) {
-	req, err := c.NewRequest(method, u, body)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.Do(req, v)
-	if err != nil {
-		return resp, err
-	}
-
-	return resp, err
-}
-
-// Do sends an API request and returns the API response.
-// The API response is JSON decoded and stored in the value pointed to by v,
-// or returned as an error if an API error has occurred.
-// If v implements the io.Writer interface, the raw response body will be written to v,
-// without attempting to first decode it.
-func (c *Client) Do(req *http.Request, v interface{}) (*Response, error) {
-	resp, err := c.client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-
-	defer resp.Body.Close()
-
-	// Wrap response
-	response := &Response{Response: resp}
-
-	err = CheckResponse(resp)
-	if err != nil {
-		// even though there was an error, we still return the response
-		// in case the caller wants to inspect it further
-		return response, err
-	}
-
-	if v != nil {
-		if w, ok := v.(io.Writer); ok {
-			io.Copy(w, resp.Body)
-		} else {
-			var body []byte
-			body, err = ioutil.ReadAll(resp.Body)
-			// This ensures the response body is not empty in the event the user
-			// wants to inspect the response body further
-			resp.Body = ioutil.NopCloser(bytes.NewBuffer(body))
-			if err != nil {
-				// even though there was an error, we still return the response
-				// in case the caller wants to inspect it further
-				return response, err
-			}
-			// Since the API we integrate with doesn't always return JSON
-			// we no longer explicitly return an error if we can't unmarshal the body
-			_ = json.Unmarshal(body, v)
-		}
-	}
-	return response, err
-}
-
-// CheckResponse checks the API response for errors, and returns them if present.
-// A response is considered an error if it has a status code outside the 200 range.
-func CheckResponse(r *http.Response) error {
-	if c := r.StatusCode; 200 <= c && c <= 299 {
-		return nil
-	}
-	return fmt.Errorf("API call to %s failed: %s", r.Request.URL.String(), r.Status)
-}
+type Response = rest.Response
@@ -17,7 +17,6 @@
 package artifactory
 
 import (
-	"net/http"
 	"testing"
 
 	"github.com/franela/goblin"
@@ -49,54 +48,16 @@ func Test_client(t *testing.T) {
 		})
 	})
 
-	g.Describe("BuildURLForRequest", func() {
-		client, _ := NewClient("https://some.company.com", nil)
-
-		g.BeforeEach(func() {
-			client, _ = NewClient("https://some.company.com", nil)
-		})
-
-		g.It("- should be able to build url for request", func() {
-			actual, err := client.buildURLForRequest("test")
-
-			g.Assert(actual != "").IsTrue()
-			g.Assert(err == nil).IsTrue()
-		})
-
-		g.It("- should be able to build url for request", func() {
-			actual, err := client.buildURLForRequest("/test")
-
-			g.Assert(actual != "").IsTrue()
-			g.Assert(err == nil).IsTrue()
-		})
-
-		g.It("- should be able to build url for request", func() {
-			actual, err := client.buildURLForRequest("test/")
-
-			g.Assert(actual != "").IsTrue()
-			g.Assert(err == nil).IsTrue()
-		})
-
-		g.It("- should fail to build url for request", func() {
-			actual, err := client.buildURLForRequest("@$(:LKS24poihwekf1203")
-
-			g.Assert(actual == "").IsTrue()
-			g.Assert(err != nil).IsTrue()
-		})
-	})
-
 	g.Describe("AddAuthentication", func() {
 		client, _ := NewClient("https://some.company.com", nil)
-		request, _ := http.NewRequest("GET", "https://some.company.com/ping", nil)
 
 		g.BeforeEach(func() {
 			client, _ = NewClient("https://some.company.com", nil)
-			request, _ = http.NewRequest("GET", "https://some.company.com/ping", nil)
 		})
 
 		g.It("- should be able to add authentication for request", func() {
 			client.Authentication.SetBasicAuth("user", "pass")
-			client.addAuthentication(request)
+			request, _ := client.NewRequest("GET", "/ping", nil)
 
 			user, pass, _ := request.BasicAuth()
 
@@ -106,10 +67,17 @@ func Test_client(t *testing.T) {
 
 		g.It("- should be able to add token authentication for request", func() {
 			client.Authentication.SetTokenAuth("someToken")
-			client.addAuthentication(request)
+			request, _ := client.NewRequest("GET", "/ping", nil)
 
 			g.Assert(request.Header.Get("X-JFrog-Art-Api")).Equal("someToken")
 		})
+
+		g.It("- should be able to add bearer authentication for request", func() {
+			client.Authentication.SetBearerAuth("someToken")
+			request, _ := client.NewRequest("GET", "/ping", nil)
+
+			g.Assert(request.Header.Get("Authorization")).Equal("Bearer someToken")
+		})
 	})
 
 	g.Describe("NewRequest", func() {
@@ -134,40 +102,4 @@ func Test_client(t *testing.T) {
 		})
 	})
 
-	g.Describe("addOptions", func() {
-		type options struct {
-			ShowAll bool `url:"all"`
-			Page    int  `url:"page"`
-		}
-		g.It("- call addOptions - valid case", func() {
-			options := options{ShowAll: true, Page: 1}
-			actual, err := addOptions("http://www.somestring.com", options)
-
-			g.Assert(actual).Equal("http://www.somestring.com?all=true&page=1")
-			g.Assert(err == nil).IsTrue()
-		})
-
-		g.It("- call addOptions - valid url with bad options type", func() {
-			actual, err := addOptions("http://www.somestring.com", 87)
-
-			g.Assert(actual).Equal("http://www.somestring.com")
-			g.Assert(err != nil).IsTrue()
-			g.Assert(err.Error()).Equal("query: Values() expects struct input. Got int")
-		})
-
-		g.It("- call addOptions - bad url parse", func() {
-			options := options{ShowAll: true, Page: 1}
-			_, err := addOptions("!@*&^%%", options)
-			g.Assert(err != nil).IsTrue()
-			g.Assert(err.Error()).Equal("parse \"!@*&^%%\": invalid URL escape \"%%\"")
-		})
-
-		g.It("- call addOptions - nil options passed", func() {
-			options := (*options)(nil)
-			actual, err := addOptions("http://www.somestring.com", options)
-			g.Assert(actual).Equal("http://www.somestring.com")
-			g.Assert(err == nil).IsTrue()
-		})
-	})
-
 }
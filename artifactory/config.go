@@ -0,0 +1,96 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifactory
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/target/go-arty/v2/internal/rest"
+)
+
+// WithConfigDir sets the directory of the JFrog CLI config files read by NewClientFromConfig.
+// Defaults to $JFROG_CLI_HOME_DIR, or ~/.jfrog.
+func WithConfigDir(dir string) Option {
+	return rest.WithConfigDir(dir)
+}
+
+// WithMasterKey sets the master key decrypting the JFrog CLI config read by NewClientFromConfig.
+// Defaults to $JFROG_CLI_ENCRYPTION_KEY.
+func WithMasterKey(key string) Option {
+	return rest.WithMasterKey(key)
+}
+
+// NewClientFromConfig returns a new Artifactory API client for the server
+// with the provided ID in the JFrog CLI config, or the default server if
+// serverID is empty. The client authenticates with the access token of the
+// server, refreshed with its refresh token if any, or else with its user
+// and password, or its API key.
+//
+// The config is read from the jfrog-cli.conf.v6, jfrog-cli.conf.v5 or
+// jfrog-cli.conf file of the config dir, whichever comes first. Encrypted
+// configs are decrypted with the master key.
+//
+// If serverID is empty and there is no default server, the client is configured
+// from the environment: the URL from $JFROG_ARTIFACTORY_URL, or $JFROG_URL
+// followed by "/artifactory", and the credentials from $JFROG_ACCESS_TOKEN,
+// $JFROG_USER and $JFROG_PASSWORD, or $JFROG_API_KEY. A server missing from
+// the config is an error, whatever the environment.
+//
+// The options configure the client as with NewClient.
+//
+// Docs: https://docs.jfrog-applications.jfrog.io/jfrog-applications/jfrog-cli/configurations/jfrog-platform-configuration
+func NewClientFromConfig(serverID string, opts ...Option) (*Client, error) {
+	server, err := rest.LoadServer(serverID, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if server == nil && len(serverID) > 0 {
+		return nil, fmt.Errorf("server %q not found in the JFrog CLI config", serverID)
+	}
+
+	if server == nil {
+		server = rest.EnvServer()
+		if server == nil || (len(server.URL) == 0 && len(server.ArtifactoryURL) == 0) {
+			return nil, errors.New("no default server in the JFrog CLI config, and no JFROG_URL or JFROG_ARTIFACTORY_URL set")
+		}
+	}
+
+	u := server.ArtifactoryURL
+	if len(u) == 0 && len(server.URL) > 0 {
+		u = strings.TrimSuffix(server.URL, "/") + "/artifactory/"
+	}
+
+	c, err := NewClient(u, nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case len(server.AccessToken) > 0 && len(server.RefreshToken) > 0:
+		c.Authentication.SetRefreshTokenAuth(server.AccessToken, server.RefreshToken, time.Time{})
+	case len(server.AccessToken) > 0:
+		c.Authentication.SetBearerAuth(server.AccessToken)
+	case len(server.Password) > 0:
+		c.Authentication.SetBasicAuth(server.User, server.Password)
+	case len(server.APIKey) > 0:
+		c.Authentication.SetTokenAuth(server.APIKey)
+	}
+
+	return c, nil
+}
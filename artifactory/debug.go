@@ -0,0 +1,34 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifactory
+
+import (
+	"io"
+
+	"github.com/target/go-arty/v2/internal/rest"
+)
+
+// WithDebug dumps every request sent by the Client and its response to the
+// provided writer, including retries. The headers are dumped along with a
+// preview of the bodies, cut at maxBody bytes. Bodies aren't dumped if
+// maxBody is 0.
+//
+// Credentials are redacted from the dump: the Authorization and
+// X-JFrog-Art-Api headers, the secret query parameters, and the secret
+// fields of the bodies, like the password of a SecurityUser or a Replication
+// and the key of a LicenseRequest.
+func WithDebug(w io.Writer, maxBody int) Option {
+	return rest.WithDebug(w, maxBody)
+}
@@ -0,0 +1,179 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifactory
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/franela/goblin"
+)
+
+func Test_Debug(t *testing.T) {
+	// Create http test server echoing the request body
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "session=secret")
+
+		if strings.Contains(r.URL.Path, "binary") {
+			w.Write([]byte{0x1f, 0x8b, 0x00, 0x00})
+			return
+		}
+
+		buf := new(bytes.Buffer)
+		buf.ReadFrom(r.Body)
+
+		if buf.Len() == 0 {
+			buf.WriteString(`{"key":"local-repo1","rclass":"local"}`)
+		}
+
+		w.Write(buf.Bytes())
+	}))
+
+	g := goblin.Goblin(t)
+	g.Describe("Debug", func() {
+		var (
+			buf *bytes.Buffer
+			c   *Client
+		)
+
+		g.BeforeEach(func() {
+			buf = new(bytes.Buffer)
+			c, _ = NewClient(s.URL, nil, WithDebug(buf, 1024))
+			c.Authentication.SetBasicAuth("admin", "hunter2")
+		})
+
+		// Close http test server after we're done using it
+		g.After(func() {
+			s.Close()
+		})
+
+		g.It("- should dump the request and the response", func() {
+			actual := new(LocalRepository)
+			_, err := c.Call("GET", "/api/repositories/local-repo1", nil, actual)
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(actual.GetKey()).Equal("local-repo1")
+
+			dump := buf.String()
+			g.Assert(strings.Contains(dump, "> GET "+s.URL+"/api/repositories/local-repo1\n")).IsTrue()
+			g.Assert(strings.Contains(dump, "> Authorization: REDACTED\n")).IsTrue()
+			g.Assert(strings.Contains(dump, "< HTTP/1.1 200 OK")).IsTrue()
+			g.Assert(strings.Contains(dump, "< Content-Type: application/json\n")).IsTrue()
+			g.Assert(strings.Contains(dump, "< Set-Cookie: REDACTED\n")).IsTrue()
+			g.Assert(strings.Contains(dump, `< {"key":"local-repo1","rclass":"local"}`)).IsTrue()
+		})
+
+		g.It("- should redact the API key", func() {
+			c.Authentication.SetTokenAuth("hunter2")
+
+			_, _, err := c.Repositories.Get("local-repo1")
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(strings.Contains(buf.String(), "> X-Jfrog-Art-Api: REDACTED\n")).IsTrue()
+			g.Assert(strings.Contains(buf.String(), "hunter2")).IsFalse()
+		})
+
+		g.It("- should redact the token of a multipart upload", func() {
+			req, _ := c.NewRequest("POST", "/api/v1/uploads/status", nil)
+			req.Header.Set("X-JFrog-Upload-Token", "hunter2")
+
+			_, err := c.Do(req, nil)
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(strings.Contains(buf.String(), "> X-Jfrog-Upload-Token: REDACTED\n")).IsTrue()
+			g.Assert(strings.Contains(buf.String(), "hunter2")).IsFalse()
+		})
+
+		g.It("- should redact the password of a SecurityUser", func() {
+			_, _, err := c.Users.CreateSecurity(&SecurityUser{Name: String("user1"), Password: String("s3cr\"et")})
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(strings.Contains(buf.String(), `> {"name":"user1","password":"REDACTED"}`)).IsTrue()
+			g.Assert(strings.Contains(buf.String(), "s3cr")).IsFalse()
+		})
+
+		g.It("- should redact the password of a Replication", func() {
+			_, err := c.Call("PUT", "/api/replications/local-repo1", &Replication{Username: String("admin"), Password: String("s3cret")}, nil)
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(strings.Contains(buf.String(), `"password":"REDACTED"`)).IsTrue()
+			g.Assert(strings.Contains(buf.String(), "s3cret")).IsFalse()
+		})
+
+		g.It("- should redact license keys", func() {
+			_, _, _ = c.Licenses.Install(&LicenseRequest{LicenseKey: String("179b7ea384d0c4655a00dfac7285a21d986a17923")})
+			_, _, _ = c.Licenses.InstallHA(&[]LicenseRequest{{LicenseKey: String("279b7ea384d0c4655a00dfac7285a21d986a17923")}})
+
+			g.Assert(strings.Count(buf.String(), `"licenseKey":"REDACTED"`)).Equal(4)
+			g.Assert(strings.Contains(buf.String(), "9b7ea384")).IsFalse()
+		})
+
+		g.It("- should redact secrets from a truncated body", func() {
+			c, _ := NewClient(s.URL, nil, WithDebug(buf, 30))
+
+			_, _, err := c.Users.CreateSecurity(&SecurityUser{Name: String("user1"), Password: String("s3cret")})
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(strings.Contains(buf.String(), `> {"name":"user1","password":"REDACTED"`)).IsTrue()
+			g.Assert(strings.Contains(buf.String(), "> [truncated at 30 bytes]")).IsTrue()
+			g.Assert(strings.Contains(buf.String(), "s3c")).IsFalse()
+		})
+
+		g.It("- should leave the response body readable", func() {
+			c, _ := NewClient(s.URL, nil, WithDebug(buf, 5))
+
+			actual := new(LocalRepository)
+			_, err := c.Call("GET", "/api/repositories/local-repo1", nil, actual)
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(actual.GetRClass()).Equal("local")
+			g.Assert(strings.Contains(buf.String(), "< {\"key\n< [truncated at 5 bytes]")).IsTrue()
+		})
+
+		g.It("- should not show binary or streamed bodies", func() {
+			_, _, err := c.Artifacts.UploadReader("binary", "file.bin", strings.NewReader("content"), -1, nil)
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(strings.Contains(buf.String(), "> content\n")).IsTrue()
+			g.Assert(strings.Contains(buf.String(), "< [binary body not shown]")).IsTrue()
+
+			req, _ := c.NewRequest("PUT", "/generic-repo/file.txt", io.MultiReader(strings.NewReader("content")))
+			_, err = c.Do(req, nil)
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(strings.Contains(buf.String(), "> [streamed body not shown]")).IsTrue()
+		})
+
+		g.It("- should not dump bodies without a body size", func() {
+			c, _ := NewClient(s.URL, nil, WithDebug(buf, 0))
+
+			_, _, err := c.Repositories.Get("local-repo1")
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(strings.Contains(buf.String(), "local-repo1\"")).IsFalse()
+		})
+
+		g.It("- should fail without a writer", func() {
+			_, err := NewClient(s.URL, nil, WithDebug(nil, 1024))
+
+			g.Assert(err != nil).IsTrue()
+		})
+	})
+}
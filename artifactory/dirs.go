@@ -0,0 +1,438 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifactory
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const defaultDirWorkers = 4
+
+// UploadDirOptions represents the options for uploading a directory tree to Artifactory.
+type UploadDirOptions struct {
+	// Include lists the glob patterns of the files to upload, all files
+	// if empty. Exclude lists the glob patterns of the files left out,
+	// even if included. The patterns are matched against the slash
+	// separated paths of the files relative to localDir: each element
+	// matches with the syntax of path.Match, "**" matches any number of
+	// elements, and a pattern without a slash matches the file name.
+	Include []string
+	Exclude []string
+
+	// Properties are attached to the deployed artifacts.
+	Properties map[string][]string
+
+	// Workers is the number of files uploaded concurrently.
+	// Defaults to 4.
+	Workers int
+}
+
+// DownloadDirOptions represents the options for downloading a directory tree from Artifactory.
+type DownloadDirOptions struct {
+	// Include lists the glob patterns of the files to download, all files
+	// if empty. Exclude lists the glob patterns of the files left out,
+	// even if included. The patterns are matched as for UploadDirOptions,
+	// against the paths of the artifacts relative to the prefix.
+	Include []string
+	Exclude []string
+
+	// Workers is the number of files downloaded concurrently.
+	// Defaults to 4.
+	Workers int
+}
+
+// DirReport represents the outcome of uploading or downloading a directory tree.
+type DirReport struct {
+	// Files lists the outcome for each file, in the order of their paths.
+	Files []DirFile
+}
+
+// Failed returns the files that failed to upload or download.
+// It returns nil for a nil report, when the directory couldn't be listed.
+func (r *DirReport) Failed() []DirFile {
+	if r == nil {
+		return nil
+	}
+
+	var failed []DirFile
+	for _, f := range r.Files {
+		if f.Err != nil {
+			failed = append(failed, f)
+		}
+	}
+
+	return failed
+}
+
+// DirFile represents the outcome of uploading or downloading a file of a directory tree.
+type DirFile struct {
+	// Path is the slash separated path of the file, relative to the directory.
+	Path string
+
+	// Size is the size of the file in bytes.
+	Size int64
+
+	// Skipped is set if the file was left as is, as the SHA-1 checksums
+	// of the local file and of the artifact already matched.
+	Skipped bool
+
+	// Err is the error the upload or download of the file failed with, if any.
+	Err error
+}
+
+// UploadDir deploys the files of the localDir directory tree to the provided
+// repository, under the provided path prefix, using a pool of workers.
+//
+// Files whose SHA-1 checksum matches the one of the artifact already deployed
+// are skipped. A file failing to upload doesn't stop the others; the report
+// lists the outcome for every file, and the returned error joins the errors
+// of the failed files.
+func (s *ArtifactsService) UploadDir(localDir, repo, prefix string, opts *UploadDirOptions) (*DirReport, error) {
+	return s.UploadDirWithContext(context.Background(), localDir, repo, prefix, opts)
+}
+
+// UploadDirWithContext deploys the files of the localDir directory tree to the provided repository using the provided context.
+func (s *ArtifactsService) UploadDirWithContext(ctx context.Context, localDir, repo, prefix string, opts *UploadDirOptions) (*DirReport, error) {
+	ctx = withOperation(ctx, "Artifacts.UploadDir", "/{repo}/{path}")
+
+	if opts == nil {
+		opts = new(UploadDirOptions)
+	}
+
+	prefix = strings.Trim(prefix, "/")
+
+	files, err := listLocal(localDir, opts.Include, opts.Exclude)
+	if err != nil {
+		return nil, err
+	}
+
+	_, deployed, err := s.listRemote(ctx, repo, prefix, opts.Include, opts.Exclude)
+	if err != nil && !IsNotFound(err) {
+		return nil, err
+	}
+
+	report := forEachFile(ctx, files, opts.Workers, func(f *DirFile) error {
+		local := filepath.Join(localDir, filepath.FromSlash(f.Path))
+
+		if sum, ok := deployed[f.Path]; ok && matchesSHA1(local, sum) {
+			f.Skipped = true
+			return nil
+		}
+
+		data, err := os.Open(local)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = data.Close() }()
+
+		uploadCtx := withOperation(ctx, "Artifacts.UploadReader", "/{repo}/{path}")
+
+		_, _, err = s.uploadReader(uploadCtx, repo, path.Join(prefix, f.Path), data, f.Size, &UploadOptions{Properties: opts.Properties})
+		return err
+	})
+
+	return report, report.err()
+}
+
+// DownloadDir downloads the artifacts under the provided path prefix of the
+// repository to the localDir directory, using a pool of workers. The
+// artifacts are listed with StorageService.GetFileList.
+//
+// Files whose SHA-1 checksum already matches the one of the artifact are
+// skipped. The other files are written to a temporary file in the same
+// directory, only replacing the existing file once complete, with their
+// checksums verified as for DownloadTo. A file failing to download doesn't
+// stop the others; the report lists the outcome for every file, and the
+// returned error joins the errors of the failed files.
+func (s *ArtifactsService) DownloadDir(repo, prefix, localDir string, opts *DownloadDirOptions) (*DirReport, error) {
+	return s.DownloadDirWithContext(context.Background(), repo, prefix, localDir, opts)
+}
+
+// DownloadDirWithContext downloads the artifacts under the provided path prefix of the repository to the localDir directory using the provided context.
+func (s *ArtifactsService) DownloadDirWithContext(ctx context.Context, repo, prefix, localDir string, opts *DownloadDirOptions) (*DirReport, error) {
+	ctx = withOperation(ctx, "Artifacts.DownloadDir", "/{repo}/{path}")
+
+	if opts == nil {
+		opts = new(DownloadDirOptions)
+	}
+
+	prefix = strings.Trim(prefix, "/")
+
+	files, sums, err := s.listRemote(ctx, repo, prefix, opts.Include, opts.Exclude)
+	if err != nil {
+		return nil, err
+	}
+
+	report := forEachFile(ctx, files, opts.Workers, func(f *DirFile) error {
+		// Artifacts can't be written outside of localDir, whatever their path.
+		if !filepath.IsLocal(filepath.FromSlash(f.Path)) {
+			return fmt.Errorf("invalid path %q of artifact under %s/%s", f.Path, repo, prefix)
+		}
+
+		local := filepath.Join(localDir, filepath.FromSlash(f.Path))
+
+		if matchesSHA1(local, sums[f.Path]) {
+			f.Skipped = true
+			return nil
+		}
+
+		return s.downloadFile(ctx, repo, path.Join(prefix, f.Path), local)
+	})
+
+	return report, report.err()
+}
+
+// downloadFile downloads the provided artifact to the local file, through
+// a temporary file renamed once the download is complete.
+func (s *ArtifactsService) downloadFile(ctx context.Context, repo, path, local string) error {
+	ctx = withOperation(ctx, "Artifacts.DownloadTo", "/{repo}/{path}")
+
+	err := os.MkdirAll(filepath.Dir(local), 0755)
+	if err != nil {
+		return err
+	}
+
+	f, err := ioutil.TempFile(filepath.Dir(local), "."+filepath.Base(local)+"-")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(f.Name()) }()
+
+	_, err = s.downloadTo(ctx, repo, path, f, nil)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), local)
+}
+
+// listLocal returns the selected regular files of the localDir directory tree.
+func listLocal(localDir string, include, exclude []string) ([]DirFile, error) {
+	return listFS(os.DirFS(localDir), include, exclude)
+}
+
+// listFS returns the selected regular files of the fsys file system.
+func listFS(fsys fs.FS, include, exclude []string) ([]DirFile, error) {
+	var files []DirFile
+	err := fs.WalkDir(fsys, ".", func(p string, d fs.DirEntry, err error) error {
+		if err != nil || !d.Type().IsRegular() || !selected(p, include, exclude) {
+			return err
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		files = append(files, DirFile{Path: p, Size: info.Size()})
+		return nil
+	})
+
+	return files, err
+}
+
+// listRemote returns the selected artifacts under the provided path prefix of
+// the repository, with their SHA-1 checksums by path relative to the prefix.
+func (s *ArtifactsService) listRemote(ctx context.Context, repo, prefix string, include, exclude []string) ([]DirFile, map[string]string, error) {
+	sums := map[string]string{}
+
+	list, _, err := s.freshFileList(ctx, repo, prefix)
+	if err != nil {
+		return nil, sums, err
+	}
+
+	var files []DirFile
+	for _, item := range list.GetFiles() {
+		rel := strings.TrimPrefix(item.GetURI(), "/")
+		if item.GetFolder() || !selected(rel, include, exclude) {
+			continue
+		}
+
+		files = append(files, DirFile{Path: rel, Size: int64(item.GetSize())})
+		sums[rel] = item.GetSHA1()
+	}
+
+	return files, sums, nil
+}
+
+// forEachFile calls fn for each of the provided files, using a pool of
+// workers, and returns the report of their outcome in the order of their paths.
+func forEachFile(ctx context.Context, files []DirFile, workers int, fn func(f *DirFile) error) *DirReport {
+	sort.Slice(files, func(i, j int) bool { return files[i].Path < files[j].Path })
+
+	errs := forEach(ctx, len(files), workers, func(i int) error {
+		return fn(&files[i])
+	})
+
+	for i, err := range errs {
+		files[i].Err = err
+	}
+
+	return &DirReport{Files: files}
+}
+
+// forEach calls fn with the index of each of n items, using a pool of
+// workers, and returns their errors by index. Once ctx is done, the items
+// not yet handled fail with its error.
+func forEach(ctx context.Context, n, workers int, fn func(i int) error) []error {
+	if workers <= 0 {
+		workers = defaultDirWorkers
+	}
+
+	var (
+		errs = make([]error, n)
+		wg   sync.WaitGroup
+		jobs = make(chan int)
+	)
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range jobs {
+				if errs[i] = ctx.Err(); errs[i] == nil {
+					errs[i] = fn(i)
+				}
+			}
+		}()
+	}
+
+	for i := 0; i < n; i++ {
+		jobs <- i
+	}
+
+	close(jobs)
+	wg.Wait()
+
+	return errs
+}
+
+// err returns the errors of the failed files joined, or nil if none failed.
+func (r *DirReport) err() error {
+	var errs []error
+	for _, f := range r.Failed() {
+		errs = append(errs, fmt.Errorf("%s: %w", f.Path, f.Err))
+	}
+
+	return errors.Join(errs...)
+}
+
+// matchesSHA1 reports whether the local file exists with the provided SHA-1 checksum.
+func matchesSHA1(local, sum string) bool {
+	if len(sum) == 0 {
+		return false
+	}
+
+	actual, err := fileSHA1(local)
+
+	return err == nil && strings.EqualFold(actual, sum)
+}
+
+// fileSHA1 returns the SHA-1 checksum of the local file.
+func fileSHA1(local string) (string, error) {
+	f, err := os.Open(local)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = f.Close() }()
+
+	h := sha1.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// selected reports whether the slash separated path is matched by one of
+// the include patterns, or there are none, and by none of the exclude patterns.
+func selected(name string, include, exclude []string) bool {
+	for _, pattern := range exclude {
+		if matchGlob(pattern, name) {
+			return false
+		}
+	}
+
+	if len(include) == 0 {
+		return true
+	}
+
+	for _, pattern := range include {
+		if matchGlob(pattern, name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchGlob reports whether the slash separated path name matches the glob
+// pattern. Each element of the pattern matches an element of the path with
+// the syntax of path.Match, while a "**" element matches any number of them.
+// A pattern without a slash matches the last element of the path, so that
+// "*.jar" matches all the jar files of a tree.
+func matchGlob(pattern, name string) bool {
+	if !strings.Contains(pattern, "/") {
+		ok, _ := path.Match(pattern, path.Base(name))
+		return ok
+	}
+
+	return matchElements(strings.Split(pattern, "/"), strings.Split(name, "/"))
+}
+
+// matchElements reports whether the elements of a path match the ones of a pattern.
+func matchElements(pattern, name []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for i := 0; i <= len(name); i++ {
+				if matchElements(pattern[1:], name[i:]) {
+					return true
+				}
+			}
+
+			return false
+		}
+
+		if len(name) == 0 {
+			return false
+		}
+
+		if ok, _ := path.Match(pattern[0], name[0]); !ok {
+			return false
+		}
+
+		pattern, name = pattern[1:], name[1:]
+	}
+
+	return len(name) == 0
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifactory
+
+import (
+	"errors"
+	"testing"
+)
+
+func Test_matchGlob(t *testing.T) {
+	var tests = []struct {
+		pattern string
+		name    string
+		out     bool
+	}{
+		{"*.jar", "app.jar", true},
+		{"*.jar", "lib/org/app.jar", true},
+		{"*.jar", "app.pom", false},
+		{"lib/*.jar", "lib/app.jar", true},
+		{"lib/*.jar", "lib/org/app.jar", false},
+		{"lib/**/*.jar", "lib/app.jar", true},
+		{"lib/**/*.jar", "lib/org/app/app.jar", true},
+		{"lib/**", "lib/org/app.pom", true},
+		{"**/test/**", "src/test/data.txt", true},
+		{"**/test/**", "src/main/data.txt", false},
+		{"docs/[a-c]?.md", "docs/b1.md", true},
+	}
+
+	for i, tt := range tests {
+		if got := matchGlob(tt.pattern, tt.name); got != tt.out {
+			t.Errorf("matchGlob #%d(%q, %q) returned %v, want %v", i, tt.pattern, tt.name, got, tt.out)
+		}
+	}
+}
+
+func Test_selected(t *testing.T) {
+	include, exclude := []string{"*.jar", "*.pom"}, []string{"**/test/**"}
+
+	var tests = []struct {
+		name string
+		out  bool
+	}{
+		{"org/app.jar", true},
+		{"org/app.pom", true},
+		{"org/app.txt", false},
+		{"org/test/app.jar", false},
+	}
+
+	for i, tt := range tests {
+		if got := selected(tt.name, include, exclude); got != tt.out {
+			t.Errorf("selected #%d(%q) returned %v, want %v", i, tt.name, got, tt.out)
+		}
+	}
+
+	if !selected("any/file", nil, nil) {
+		t.Errorf("selected returned false without patterns, want true")
+	}
+}
+
+func Test_DirReport(t *testing.T) {
+	failure := errors.New("failure")
+
+	r := &DirReport{Files: []DirFile{
+		{Path: "a.txt"},
+		{Path: "b.txt", Err: failure},
+		{Path: "c.txt", Skipped: true},
+	}}
+
+	if failed := r.Failed(); len(failed) != 1 || failed[0].Path != "b.txt" {
+		t.Errorf("Failed returned %v, want b.txt", failed)
+	}
+
+	if err := r.err(); !errors.Is(err, failure) || err.Error() != "b.txt: failure" {
+		t.Errorf("err returned %v, want b.txt: failure", err)
+	}
+
+	if err := (&DirReport{}).err(); err != nil {
+		t.Errorf("err returned %v without failures, want nil", err)
+	}
+}
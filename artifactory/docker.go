@@ -17,6 +17,7 @@
 package artifactory
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -57,10 +58,17 @@ type ImagePromotion struct {
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-ListDockerRepositories
 func (s *DockerService) GetRepositories(registry string) (*Registry, *Response, error) {
-	u := fmt.Sprintf("/api/docker/%s/v2/_catalog", registry)
+	return s.GetRepositoriesWithContext(context.Background(), registry)
+}
+
+// GetRepositoriesWithContext returns a list of all Docker repositories for the provided registry using the provided context.
+func (s *DockerService) GetRepositoriesWithContext(ctx context.Context, registry string) (*Registry, *Response, error) {
+	ctx = withOperation(ctx, "Docker.GetRepositories", "/api/docker/{registry}/v2/_catalog")
+
+	u := fmt.Sprintf("/api/docker/%s/v2/_catalog", escapePath(registry))
 	v := new(Registry)
 
-	resp, err := s.client.Call("GET", u, nil, v)
+	resp, err := s.client.CallContext(ctx, "GET", u, nil, v)
 	return v, resp, err
 }
 
@@ -68,10 +76,17 @@ func (s *DockerService) GetRepositories(registry string) (*Registry, *Response,
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-ListDockerTags
 func (s *DockerService) GetTags(registry, repository string) (*Tags, *Response, error) {
-	u := fmt.Sprintf("/api/docker/%s/v2/%s/tags/list", registry, repository)
+	return s.GetTagsWithContext(context.Background(), registry, repository)
+}
+
+// GetTagsWithContext returns a list of all tags for the provided Docker repository using the provided context.
+func (s *DockerService) GetTagsWithContext(ctx context.Context, registry, repository string) (*Tags, *Response, error) {
+	ctx = withOperation(ctx, "Docker.GetTags", "/api/docker/{registry}/v2/{repository}/tags/list")
+
+	u := fmt.Sprintf("/api/docker/%s/v2/%s/tags/list", escapePath(registry), escapePath(repository))
 	v := new(Tags)
 
-	resp, err := s.client.Call("GET", u, nil, v)
+	resp, err := s.client.CallContext(ctx, "GET", u, nil, v)
 	return v, resp, err
 }
 
@@ -79,9 +94,16 @@ func (s *DockerService) GetTags(registry, repository string) (*Tags, *Response,
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-PromoteDockerImage
 func (s *DockerService) PromoteImage(registry string, promotion *ImagePromotion) (*string, *Response, error) {
-	u := fmt.Sprintf("/api/docker/%s/v2/promote", registry)
+	return s.PromoteImageWithContext(context.Background(), registry, promotion)
+}
+
+// PromoteImageWithContext promotes the provided Docker image(s) from the provided source repository to the provided destination repository using the provided context.
+func (s *DockerService) PromoteImageWithContext(ctx context.Context, registry string, promotion *ImagePromotion) (*string, *Response, error) {
+	ctx = withOperation(ctx, "Docker.PromoteImage", "/api/docker/{registry}/v2/promote")
+
+	u := fmt.Sprintf("/api/docker/%s/v2/promote", escapePath(registry))
 	v := new(string)
 
-	resp, err := s.client.Call("POST", u, promotion, v)
+	resp, err := s.client.CallContext(ctx, "POST", u, promotion, v)
 	return v, resp, err
 }
@@ -0,0 +1,77 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifactory
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/franela/goblin"
+)
+
+func Test_Errors(t *testing.T) {
+	// Create http test server that replies with the errors of Artifactory
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/repositories/missing":
+			w.WriteHeader(404)
+			fmt.Fprint(w, `{"errors":[{"status":404,"message":"Item not found"}]}`)
+		case "/api/repositories/locked":
+			w.WriteHeader(409)
+			fmt.Fprint(w, `{"errors":[{"status":409,"message":"Conflict"}]}`)
+		case "/api/repositories/secret":
+			w.WriteHeader(403)
+			fmt.Fprint(w, "Forbidden\n")
+		default:
+			w.WriteHeader(401)
+			fmt.Fprint(w, `{"errors":[{"status":401,"message":"Bad credentials"}]}`)
+		}
+	}))
+
+	// Create the client to interact with the http test server
+	c, _ := NewClient(s.URL, nil)
+
+	g := goblin.Goblin(t)
+	g.Describe("ErrorResponse", func() {
+		// Close http test server after we're done using it
+		g.After(func() {
+			s.Close()
+		})
+
+		g.It("- should return the errors of the services", func() {
+			_, _, err := c.Repositories.Get("missing")
+
+			var actual *ErrorResponse
+			g.Assert(errors.As(err, &actual)).IsTrue()
+			g.Assert(actual.GetErrors()[0].GetMessage()).Equal("Item not found")
+			g.Assert(IsNotFound(err)).IsTrue()
+		})
+
+		g.It("- should report the status of the errors", func() {
+			_, _, err := c.Repositories.Get("locked")
+			g.Assert(IsConflict(err)).IsTrue()
+
+			_, _, err = c.Repositories.Get("secret")
+			g.Assert(IsForbidden(err)).IsTrue()
+
+			_, _, err = c.Repositories.Get("other")
+			g.Assert(IsUnauthorized(err)).IsTrue()
+			g.Assert(IsNotFound(err)).IsFalse()
+		})
+	})
+}
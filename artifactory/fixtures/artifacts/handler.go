@@ -1,6 +1,9 @@
 package artifacts
 
 import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -17,6 +20,7 @@ func FakeHandler() http.Handler {
 	e := gin.New()
 
 	e.GET("/:repository/foo.txt", downloadFile)
+	e.GET("/:repository/corrupt.txt", downloadCorruptFile)
 	e.PUT("/:repository/folder/*path", uploadFile)
 	e.POST("/api/copy/:repository/*path", copyFile)
 	e.POST("/api/move/:repository/*path", moveFile)
@@ -33,6 +37,17 @@ func downloadFile(c *gin.Context) {
 		return
 	}
 
+	data := loadFixture("fixtures/artifacts/foo.txt")
+	sha1sum := sha1.Sum(data)
+	sha256sum := sha256.Sum256(data)
+
+	c.Header("X-Checksum-Sha1", hex.EncodeToString(sha1sum[:]))
+	c.Header("X-Checksum-Sha256", hex.EncodeToString(sha256sum[:]))
+	c.Data(200, "application/text", data)
+}
+
+func downloadCorruptFile(c *gin.Context) {
+	c.Header("X-Checksum-Sha1", "0000000000000000000000000000000000000000")
 	c.Data(200, "application/text", loadFixture("fixtures/artifacts/foo.txt"))
 }
 
@@ -44,7 +59,37 @@ func uploadFile(c *gin.Context) {
 		return
 	}
 
-	c.String(200, "")
+	// Only the content of foo.txt is known to be stored already.
+	if c.GetHeader("X-Checksum-Deploy") == "true" {
+		known := sha1.Sum(loadFixture("fixtures/artifacts/foo.txt"))
+
+		if c.GetHeader("X-Checksum-Sha1") != hex.EncodeToString(known[:]) {
+			c.String(404, `{"errors":[{"status":404,"message":"Checksum deploy failed"}]}`)
+			return
+		}
+
+		c.String(201, uploadedFile(repository, c.Param("path"), c.GetHeader("X-Checksum-Sha1")))
+		return
+	}
+
+	data, _ := ioutil.ReadAll(c.Request.Body)
+	sum := sha1.Sum(data)
+
+	if sha1sum := c.GetHeader("X-Checksum-Sha1"); len(sha1sum) > 0 && sha1sum != hex.EncodeToString(sum[:]) {
+		c.String(409, `{"errors":[{"status":409,"message":"Checksum policy rejected the artifact"}]}`)
+		return
+	}
+
+	if len(c.GetHeader("X-Checksum-Sha1")) == 0 {
+		c.String(200, "")
+		return
+	}
+
+	c.String(201, uploadedFile(repository, c.Param("path"), hex.EncodeToString(sum[:])))
+}
+
+func uploadedFile(repository, path, sha1sum string) string {
+	return fmt.Sprintf(`{"repo":"%s","path":"/folder%s","checksums":{"sha1":"%s"}}`, repository, path, sha1sum)
 }
 
 func copyFile(c *gin.Context) {
@@ -39,6 +39,11 @@ func getReplication(c *gin.Context) {
 		return
 	}
 
+	if strings.Contains(repository, "empty") {
+		c.Status(200)
+		return
+	}
+
 	if strings.Contains(repository, "local") {
 		c.String(200, loadFixture("fixtures/replications/local_replication.json"))
 		return
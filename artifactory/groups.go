@@ -17,6 +17,7 @@
 package artifactory
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -48,10 +49,17 @@ func (g Group) String() string {
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-GetGroups
 func (s *GroupsService) GetAll() (*[]Group, *Response, error) {
+	return s.GetAllWithContext(context.Background())
+}
+
+// GetAllWithContext returns a list of all groups using the provided context.
+func (s *GroupsService) GetAllWithContext(ctx context.Context) (*[]Group, *Response, error) {
+	ctx = withOperation(ctx, "Groups.GetAll", "/api/security/groups")
+
 	u := "/api/security/groups"
 	v := new([]Group)
 
-	resp, err := s.client.Call("GET", u, nil, v)
+	resp, err := s.client.CallContext(ctx, "GET", u, nil, v)
 	return v, resp, err
 }
 
@@ -67,7 +75,14 @@ type GetGroupRequest struct {
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-GetGroupDetails
 func (s *GroupsService) Get(groupRequest *GetGroupRequest) (*Group, *Response, error) {
-	u := fmt.Sprintf("/api/security/groups/%s", *groupRequest.Name)
+	return s.GetWithContext(context.Background(), groupRequest)
+}
+
+// GetWithContext returns the provided group using the provided context.
+func (s *GroupsService) GetWithContext(ctx context.Context, groupRequest *GetGroupRequest) (*Group, *Response, error) {
+	ctx = withOperation(ctx, "Groups.Get", "/api/security/groups/{group}")
+
+	u := fmt.Sprintf("/api/security/groups/%s", escapePath(*groupRequest.Name))
 
 	if *groupRequest.IncludeUsers {
 		u = u + "?includeUsers=true"
@@ -75,7 +90,7 @@ func (s *GroupsService) Get(groupRequest *GetGroupRequest) (*Group, *Response, e
 
 	v := new(Group)
 
-	resp, err := s.client.Call("GET", u, nil, v)
+	resp, err := s.client.CallContext(ctx, "GET", u, nil, v)
 	return v, resp, err
 }
 
@@ -83,10 +98,17 @@ func (s *GroupsService) Get(groupRequest *GetGroupRequest) (*Group, *Response, e
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-CreateorReplaceGroup
 func (s *GroupsService) Create(group *Group) (*string, *Response, error) {
-	u := fmt.Sprintf("/api/security/groups/%s", *group.Name)
+	return s.CreateWithContext(context.Background(), group)
+}
+
+// CreateWithContext constructs a group with the provided details using the provided context.
+func (s *GroupsService) CreateWithContext(ctx context.Context, group *Group) (*string, *Response, error) {
+	ctx = withOperation(ctx, "Groups.Create", "/api/security/groups/{group}")
+
+	u := fmt.Sprintf("/api/security/groups/%s", escapePath(*group.Name))
 	v := new(string)
 
-	resp, err := s.client.Call("PUT", u, group, v)
+	resp, err := s.client.CallContext(ctx, "PUT", u, group, v)
 	return v, resp, err
 }
 
@@ -94,10 +116,17 @@ func (s *GroupsService) Create(group *Group) (*string, *Response, error) {
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-UpdateGroup
 func (s *GroupsService) Update(group *Group) (*string, *Response, error) {
-	u := fmt.Sprintf("/api/security/groups/%s", *group.Name)
+	return s.UpdateWithContext(context.Background(), group)
+}
+
+// UpdateWithContext modifies a group with the provided details using the provided context.
+func (s *GroupsService) UpdateWithContext(ctx context.Context, group *Group) (*string, *Response, error) {
+	ctx = withOperation(ctx, "Groups.Update", "/api/security/groups/{group}")
+
+	u := fmt.Sprintf("/api/security/groups/%s", escapePath(*group.Name))
 	v := new(string)
 
-	resp, err := s.client.Call("POST", u, group, v)
+	resp, err := s.client.CallContext(ctx, "POST", u, group, v)
 	return v, resp, err
 }
 
@@ -105,9 +134,16 @@ func (s *GroupsService) Update(group *Group) (*string, *Response, error) {
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-DeleteGroup
 func (s *GroupsService) Delete(group string) (*string, *Response, error) {
-	u := fmt.Sprintf("/api/security/groups/%s", group)
+	return s.DeleteWithContext(context.Background(), group)
+}
+
+// DeleteWithContext removes the provided group using the provided context.
+func (s *GroupsService) DeleteWithContext(ctx context.Context, group string) (*string, *Response, error) {
+	ctx = withOperation(ctx, "Groups.Delete", "/api/security/groups/{group}")
+
+	u := fmt.Sprintf("/api/security/groups/%s", escapePath(group))
 	v := new(string)
 
-	resp, err := s.client.Call("DELETE", u, nil, v)
+	resp, err := s.client.CallContext(ctx, "DELETE", u, nil, v)
 	return v, resp, err
 }
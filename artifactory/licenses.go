@@ -16,6 +16,12 @@
 
 package artifactory
 
+import (
+	"context"
+
+	"github.com/target/go-arty/v2/internal/rest"
+)
+
 // LicensesService handles communication with the license related
 // methods of the Artifactory API.
 //
@@ -91,10 +97,17 @@ type LicenseRemoval struct {
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-LicenseInformation
 func (s *LicensesService) Get() (*License, *Response, error) {
+	return s.GetWithContext(context.Background())
+}
+
+// GetWithContext returns a single license using the provided context.
+func (s *LicensesService) GetWithContext(ctx context.Context) (*License, *Response, error) {
+	ctx = withOperation(ctx, "Licenses.Get", "/api/system/licenses")
+
 	u := "/api/system/licenses"
 	v := new(License)
 
-	resp, err := s.client.Call("GET", u, nil, v)
+	resp, err := s.client.CallContext(ctx, "GET", u, nil, v)
 	return v, resp, err
 }
 
@@ -102,10 +115,17 @@ func (s *LicensesService) Get() (*License, *Response, error) {
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-InstallLicense
 func (s *LicensesService) Install(license *LicenseRequest) (*LicenseResponse, *Response, error) {
+	return s.InstallWithContext(context.Background(), license)
+}
+
+// InstallWithContext deploys the provided license to the instance using the provided context.
+func (s *LicensesService) InstallWithContext(ctx context.Context, license *LicenseRequest) (*LicenseResponse, *Response, error) {
+	ctx = withOperation(ctx, "Licenses.Install", "/api/system/licenses")
+
 	u := "/api/system/licenses"
 	v := new(LicenseResponse)
 
-	resp, err := s.client.Call("POST", u, license, v)
+	resp, err := s.client.CallContext(ctx, "POST", u, license, v)
 	return v, resp, err
 }
 
@@ -113,10 +133,17 @@ func (s *LicensesService) Install(license *LicenseRequest) (*LicenseResponse, *R
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-HALicenseInformation
 func (s *LicensesService) GetHA() (*HALicenses, *Response, error) {
+	return s.GetHAWithContext(context.Background())
+}
+
+// GetHAWithContext returns a list of licenses for an HA cluster using the provided context.
+func (s *LicensesService) GetHAWithContext(ctx context.Context) (*HALicenses, *Response, error) {
+	ctx = withOperation(ctx, "Licenses.GetHA", "/api/system/licenses")
+
 	u := "/api/system/licenses"
 	v := new(HALicenses)
 
-	resp, err := s.client.Call("GET", u, nil, v)
+	resp, err := s.client.CallContext(ctx, "GET", u, nil, v)
 	return v, resp, err
 }
 
@@ -124,10 +151,17 @@ func (s *LicensesService) GetHA() (*HALicenses, *Response, error) {
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-InstallHAClusterLicenses
 func (s *LicensesService) InstallHA(licenses *[]LicenseRequest) (*HALicenseResponse, *Response, error) {
+	return s.InstallHAWithContext(context.Background(), licenses)
+}
+
+// InstallHAWithContext deploys the provided license(s) to an HA cluster using the provided context.
+func (s *LicensesService) InstallHAWithContext(ctx context.Context, licenses *[]LicenseRequest) (*HALicenseResponse, *Response, error) {
+	ctx = withOperation(ctx, "Licenses.InstallHA", "/api/system/licenses")
+
 	u := "/api/system/licenses"
 	v := new(HALicenseResponse)
 
-	resp, err := s.client.Call("POST", u, licenses, v)
+	resp, err := s.client.CallContext(ctx, "POST", u, licenses, v)
 	return v, resp, err
 }
 
@@ -135,14 +169,21 @@ func (s *LicensesService) InstallHA(licenses *[]LicenseRequest) (*HALicenseRespo
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-DeleteHAClusterLicense
 func (s *LicensesService) DeleteHA(hashes *LicenseRemoval) (*HALicenseResponse, *Response, error) {
+	return s.DeleteHAWithContext(context.Background(), hashes)
+}
+
+// DeleteHAWithContext removes the provided license key(s) from an HA cluster using the provided context.
+func (s *LicensesService) DeleteHAWithContext(ctx context.Context, hashes *LicenseRemoval) (*HALicenseResponse, *Response, error) {
+	ctx = withOperation(ctx, "Licenses.DeleteHA", "/api/system/licenses")
+
 	u := "/api/system/licenses"
 	v := new(HALicenseResponse)
 
-	u, err := addOptions(u, hashes)
+	u, err := rest.AddOptions(u, hashes)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	resp, err := s.client.Call("DELETE", u, nil, v)
+	resp, err := s.client.CallContext(ctx, "DELETE", u, nil, v)
 	return v, resp, err
 }
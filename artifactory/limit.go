@@ -0,0 +1,54 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifactory
+
+import "github.com/target/go-arty/v2/internal/rest"
+
+// EndpointClass represents a class of Artifactory API endpoints limited together.
+type EndpointClass = rest.EndpointClass
+
+const (
+	// SearchEndpoints are the endpoints of the SearchService.
+	SearchEndpoints EndpointClass = "search"
+
+	// StorageEndpoints are the endpoints of the StorageService.
+	StorageEndpoints EndpointClass = "storage"
+
+	// SecurityEndpoints are the endpoints managing users, groups, permissions and tokens.
+	SecurityEndpoints EndpointClass = "security"
+)
+
+// endpointClasses are the path prefixes of the endpoints of each class.
+var endpointClasses = map[EndpointClass][]string{
+	SearchEndpoints:   {"/api/search/"},
+	StorageEndpoints:  {"/api/storage/", "/api/storageinfo"},
+	SecurityEndpoints: {"/api/security/", "/api/v2/security/"},
+}
+
+// Limit represents the rate and the concurrency requests are limited to.
+// Requests exceeding the limit block until they are allowed, or until
+// their context is done.
+type Limit = rest.Limit
+
+// WithLimit limits all requests sent by the Client, including retries.
+func WithLimit(limit Limit) Option {
+	return rest.WithLimit(limit)
+}
+
+// WithEndpointLimit limits the requests sent by the Client to a class of endpoints,
+// in addition to the limit set with WithLimit.
+func WithEndpointLimit(class EndpointClass, limit Limit) Option {
+	return rest.WithEndpointLimit(class, limit)
+}
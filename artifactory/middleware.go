@@ -0,0 +1,58 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifactory
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/target/go-arty/v2/internal/rest"
+)
+
+// RequestInfo describes a request sent by the Client, as seen by a Middleware.
+type RequestInfo = rest.RequestInfo
+
+// Middleware observes the requests sent by a Client.
+type Middleware = rest.Middleware
+
+// Hooks is a Middleware calling the provided functions, if set.
+type Hooks = rest.Hooks
+
+// WithMiddleware adds the provided middlewares to the Client.
+// BeforeRequest is called in the order the middlewares are provided,
+// and AfterResponse in the reverse order.
+func WithMiddleware(middlewares ...Middleware) Option {
+	return rest.WithMiddleware(middlewares...)
+}
+
+// NewLoggingMiddleware returns a Middleware logging every request to the provided logger.
+// Requests are logged at the Info level, or at the Error level if they failed.
+// Secrets in the URL and the error of a request are redacted.
+func NewLoggingMiddleware(logger *slog.Logger) Middleware {
+	return rest.NewLoggingMiddleware(logger)
+}
+
+// Metrics records the latency and the errors of the requests sent by a Client.
+type Metrics = rest.Metrics
+
+// NewMetricsMiddleware returns a Middleware recording every request in the provided Metrics.
+func NewMetricsMiddleware(m Metrics) Middleware {
+	return rest.NewMetricsMiddleware(m)
+}
+
+// withOperation returns a context naming the operation sending the requests made with it.
+func withOperation(ctx context.Context, name, path string) context.Context {
+	return rest.WithOperation(ctx, name, path)
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifactory
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/franela/goblin"
+)
+
+// recordingMiddleware records the hooks called on it.
+type recordingMiddleware struct {
+	name  string
+	calls *[]string
+	infos []RequestInfo
+}
+
+type middlewareKey struct{}
+
+func (m *recordingMiddleware) BeforeRequest(ctx context.Context, info *RequestInfo) context.Context {
+	*m.calls = append(*m.calls, m.name+" before")
+	info.Request.Header.Set("X-Trace-"+m.name, "traced")
+
+	return context.WithValue(ctx, middlewareKey{}, m.name)
+}
+
+func (m *recordingMiddleware) AfterResponse(ctx context.Context, info *RequestInfo) {
+	*m.calls = append(*m.calls, m.name+" after "+ctx.Value(middlewareKey{}).(string))
+	m.infos = append(m.infos, *info)
+}
+
+// fakeMetrics records the observations of the metrics middleware.
+type fakeMetrics struct {
+	latencies []string
+	errors    []string
+}
+
+func (m *fakeMetrics) ObserveLatency(operation, method, path string, status int, d time.Duration) {
+	m.latencies = append(m.latencies, strings.Join([]string{operation, method, path, http.StatusText(status)}, " "))
+}
+
+func (m *fakeMetrics) IncErrors(operation, method, path string, status int) {
+	m.errors = append(m.errors, strings.Join([]string{operation, method, path, http.StatusText(status)}, " "))
+}
+
+func Test_Middleware(t *testing.T) {
+	// Create http test server that fails requests to missing items
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.Path, "missing") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Write([]byte(`{"key":"local-repo1"}`))
+	}))
+
+	g := goblin.Goblin(t)
+	g.Describe("Middleware", func() {
+		var (
+			calls []string
+			m     *recordingMiddleware
+			c     *Client
+		)
+
+		g.BeforeEach(func() {
+			calls = nil
+			m = &recordingMiddleware{name: "m", calls: &calls}
+
+			c, _ = NewClient(s.URL, nil, WithMiddleware(m))
+		})
+
+		// Close http test server after we're done using it
+		g.After(func() {
+			s.Close()
+		})
+
+		g.It("- should describe the operation", func() {
+			_, _, err := c.Repositories.Get("local-repo1")
+
+			g.Assert(err == nil).IsTrue()
+
+			info := m.infos[0]
+			g.Assert(info.Operation).Equal("Repositories.Get")
+			g.Assert(info.Method).Equal("GET")
+			g.Assert(info.Path).Equal("/api/repositories/{repo}")
+			g.Assert(info.StatusCode).Equal(200)
+			g.Assert(info.Duration > 0).IsTrue()
+			g.Assert(info.Err == nil).IsTrue()
+		})
+
+		g.It("- should record metrics", func() {
+			metrics := new(fakeMetrics)
+			c, _ := NewClient(s.URL, nil, WithMiddleware(NewMetricsMiddleware(metrics)))
+
+			_, _, _ = c.Repositories.Get("local-repo1")
+			_, _, _ = c.Repositories.Delete("missing")
+
+			g.Assert(metrics.latencies).Equal([]string{
+				"Repositories.Get GET /api/repositories/{repo} OK",
+				"Repositories.Delete DELETE /api/repositories/{repo} Not Found",
+			})
+			g.Assert(metrics.errors).Equal([]string{"Repositories.Delete DELETE /api/repositories/{repo} Not Found"})
+		})
+	})
+}
@@ -0,0 +1,440 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifactory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/target/go-arty/v2/internal/rest"
+)
+
+const (
+	// sizeMiB is the unit of the part size sent to Artifactory.
+	sizeMiB = 1024 * 1024
+
+	defaultLargeUploadThreshold = 200 * sizeMiB
+	defaultPartSize             = 20 * sizeMiB
+	defaultUploadWorkers        = 4
+)
+
+// multipartPollInterval is the wait between checks of a completing upload.
+var multipartPollInterval = time.Second
+
+// LargeUploadOptions represents the options for deploying a large artifact
+// in parts uploaded concurrently.
+type LargeUploadOptions struct {
+	UploadOptions
+
+	// Threshold is the size below which the artifact is deployed with a single request.
+	// Defaults to 200 MiB.
+	Threshold int64
+
+	// PartSize is the size of each part, rounded up to a whole MiB.
+	// Defaults to 20 MiB.
+	PartSize int64
+
+	// Workers is the number of parts uploaded concurrently.
+	// Defaults to 4.
+	Workers int
+
+	// Resume continues a previous upload that failed, skipping the parts
+	// it already uploaded. It is ignored if it was for different content.
+	Resume *MultipartUpload
+}
+
+// MultipartUpload represents the state of an upload in parts.
+// It is returned in a *MultipartUploadError when the upload fails,
+// and can be passed back in LargeUploadOptions.Resume to resume it.
+type MultipartUpload struct {
+	Token    string
+	Repo     string
+	Path     string
+	Size     int64
+	PartSize int64
+	SHA1     string
+
+	// Completed lists the part numbers acknowledged by the storage, in order.
+	Completed []int
+}
+
+// MultipartUploadError reports a failed upload in parts.
+type MultipartUploadError struct {
+	// Upload can be passed in LargeUploadOptions.Resume to resume the upload.
+	Upload *MultipartUpload
+	Err    error
+}
+
+func (e *MultipartUploadError) Error() string {
+	return fmt.Sprintf("upload of %s/%s failed after %d parts: %v", e.Upload.Repo, e.Upload.Path, len(e.Upload.Completed), e.Err)
+}
+
+func (e *MultipartUploadError) Unwrap() error {
+	return e.Err
+}
+
+// multipartToken represents the token identifying an upload in parts.
+type multipartToken struct {
+	Token *string `json:"token,omitempty"`
+}
+
+// multipartPartURL represents the URL a part is uploaded to.
+type multipartPartURL struct {
+	URL *string `json:"url,omitempty"`
+}
+
+// multipartStatus represents the status of a completing upload in parts.
+type multipartStatus struct {
+	Status *string `json:"status,omitempty"`
+	Error  *string `json:"error,omitempty"`
+}
+
+// UploadLarge deploys a large artifact by uploading it in parts concurrently.
+// Content smaller than the threshold is deployed with a single request, like UploadReader.
+//
+// A part that fails is retried as allowed by the RetryPolicy of the Client.
+// If the upload still fails, the returned error is a *MultipartUploadError
+// holding the state needed to resume it. Once all parts are uploaded, the
+// SHA-256 checksum of the deployed artifact is verified against the content.
+//
+// Upload in parts requires an Artifactory instance with cloud storage.
+func (s *ArtifactsService) UploadLarge(repo, path string, r io.ReaderAt, size int64, opts *LargeUploadOptions) (*File, *Response, error) {
+	return s.UploadLargeWithContext(context.Background(), repo, path, r, size, opts)
+}
+
+// UploadLargeWithContext deploys a large artifact by uploading it in parts concurrently using the provided context.
+func (s *ArtifactsService) UploadLargeWithContext(ctx context.Context, repo, path string, r io.ReaderAt, size int64, opts *LargeUploadOptions) (*File, *Response, error) {
+	ctx = withOperation(ctx, "Artifacts.UploadLarge", "/{repo}/{path}")
+
+	if opts == nil {
+		opts = new(LargeUploadOptions)
+	}
+
+	threshold := opts.Threshold
+	if threshold <= 0 {
+		threshold = defaultLargeUploadThreshold
+	}
+
+	if size < threshold {
+		return s.uploadReader(ctx, repo, path, io.NewSectionReader(r, 0, size), size, &opts.UploadOptions)
+	}
+
+	sums, _, err := computeChecksums(io.NewSectionReader(r, 0, size))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := new(File)
+
+	if opts.ChecksumDeploy {
+		resp, err := s.deployChecksum(ctx, uploadURL(repo, path, opts.Properties), sums, v)
+		if !IsNotFound(err) {
+			return v, resp, err
+		}
+	}
+
+	upload := opts.Resume
+	if upload == nil || upload.Repo != repo || upload.Path != path || upload.Size != size || upload.SHA1 != sums.GetSHA1() {
+		upload, err = s.createMultipartUpload(ctx, repo, path, size, sums.GetSHA1(), opts.PartSize)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	err = s.uploadParts(ctx, upload, r, opts)
+	if err != nil {
+		return nil, nil, &MultipartUploadError{Upload: upload, Err: err}
+	}
+
+	err = s.completeMultipartUpload(ctx, upload)
+	if err != nil {
+		return nil, nil, &MultipartUploadError{Upload: upload, Err: err}
+	}
+
+	if len(opts.Properties) > 0 {
+		resp, err := s.client.Storage.SetItemPropertiesWithContext(ctx, repo, path, opts.Properties)
+		if err != nil {
+			return nil, resp, err
+		}
+	}
+
+	v, resp, err := s.freshFileInfo(ctx, repo, path)
+	if err != nil {
+		return v, resp, err
+	}
+
+	if actual := v.GetChecksums().GetSHA256(); actual != sums.GetSHA256() {
+		return v, resp, &ChecksumError{Algorithm: "sha256", Expected: sums.GetSHA256(), Actual: actual}
+	}
+
+	return v, resp, nil
+}
+
+// createMultipartUpload starts a new upload in parts.
+func (s *ArtifactsService) createMultipartUpload(ctx context.Context, repo, path string, size int64, sha1 string, partSize int64) (*MultipartUpload, error) {
+	ctx = withOperation(ctx, "Artifacts.CreateMultipartUpload", "/api/v1/uploads/create")
+
+	if partSize <= 0 {
+		partSize = defaultPartSize
+	}
+
+	// The part size is sent in whole MiB.
+	partSizeMB := (partSize + sizeMiB - 1) / sizeMiB
+
+	q := url.Values{}
+	q.Set("repoKey", repo)
+	q.Set("repoPath", path)
+	q.Set("partSizeMB", fmt.Sprintf("%d", partSizeMB))
+
+	u := "/api/v1/uploads/create?" + q.Encode()
+	v := new(multipartToken)
+
+	_, err := s.client.CallContext(ctx, "POST", u, nil, v)
+	if err != nil {
+		return nil, err
+	}
+
+	if v.Token == nil {
+		return nil, errors.New("no upload token returned by Artifactory")
+	}
+
+	upload := &MultipartUpload{
+		Token:    *v.Token,
+		Repo:     repo,
+		Path:     path,
+		Size:     size,
+		PartSize: partSizeMB * sizeMiB,
+		SHA1:     sha1,
+	}
+
+	return upload, nil
+}
+
+// uploadParts uploads the parts not yet acknowledged, using a pool of workers.
+// The first failure stops the remaining workers.
+func (s *ArtifactsService) uploadParts(ctx context.Context, upload *MultipartUpload, r io.ReaderAt, opts *LargeUploadOptions) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = defaultUploadWorkers
+	}
+
+	parts := int((upload.Size + upload.PartSize - 1) / upload.PartSize)
+
+	done := make(map[int]bool, len(upload.Completed))
+	for _, part := range upload.Completed {
+		done[part] = true
+	}
+
+	var (
+		mu          sync.Mutex
+		firstErr    error
+		transferred int64
+		wg          sync.WaitGroup
+		jobs        = make(chan int)
+	)
+
+	// Parts uploaded before a resume count as transferred.
+	for part := range done {
+		transferred += partLength(upload, part)
+	}
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for part := range jobs {
+				if ctx.Err() != nil {
+					continue
+				}
+
+				err := s.uploadPart(ctx, upload, r, part)
+
+				mu.Lock()
+				if err != nil {
+					if firstErr == nil {
+						firstErr = err
+						cancel()
+					}
+				} else {
+					upload.Completed = append(upload.Completed, part)
+					sort.Ints(upload.Completed)
+
+					transferred += partLength(upload, part)
+					if opts.Progress != nil {
+						opts.Progress(transferred, upload.Size)
+					}
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for part := 1; part <= parts; part++ {
+		if done[part] {
+			continue
+		}
+
+		select {
+		case jobs <- part:
+		case <-ctx.Done():
+		}
+	}
+
+	close(jobs)
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+
+	return ctx.Err()
+}
+
+// partLength returns the length of the provided part.
+func partLength(upload *MultipartUpload, part int) int64 {
+	offset := int64(part-1) * upload.PartSize
+	if upload.Size-offset < upload.PartSize {
+		return upload.Size - offset
+	}
+
+	return upload.PartSize
+}
+
+// uploadPart uploads a single part to the storage URL provided by Artifactory.
+func (s *ArtifactsService) uploadPart(ctx context.Context, upload *MultipartUpload, r io.ReaderAt, part int) error {
+	ctx = withOperation(ctx, "Artifacts.GetMultipartPartURL", "/api/v1/uploads/urlPart")
+
+	u := fmt.Sprintf("/api/v1/uploads/urlPart?partNumber=%d", part)
+	v := new(multipartPartURL)
+
+	req, err := s.client.NewRequestWithContext(ctx, "POST", u, nil)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("X-JFrog-Upload-Token", upload.Token)
+
+	_, err = s.client.DoContext(ctx, req, v)
+	if err != nil {
+		return err
+	}
+
+	if v.URL == nil {
+		return fmt.Errorf("no upload URL returned by Artifactory for part %d", part)
+	}
+
+	offset := int64(part-1) * upload.PartSize
+
+	// The part URL is pre-signed by the storage, so it is sent without the
+	// authentication of the Client.
+	preq, err := http.NewRequestWithContext(ctx, "PUT", *v.URL, nil)
+	if err != nil {
+		return err
+	}
+
+	err = rest.SetSeekableBody(preq, io.NewSectionReader(r, offset, partLength(upload, part)))
+	if err != nil {
+		return err
+	}
+
+	attempts := rest.Attempts(s.client.RetryPolicy, preq)
+	for attempt := 1; ; attempt++ {
+		if attempt > 1 {
+			preq.Body, err = preq.GetBody()
+			if err != nil {
+				return err
+			}
+		}
+
+		resp, err := rest.RoundTrip(s.client.core, preq)
+		if err == nil {
+			err = CheckResponse(resp)
+			rest.Drain(resp)
+		}
+
+		if err == nil || attempt >= attempts || ctx.Err() != nil {
+			return err
+		}
+
+		if err := rest.Sleep(ctx, rest.Backoff(s.client.RetryPolicy, attempt)); err != nil {
+			return err
+		}
+	}
+}
+
+// completeMultipartUpload merges the uploaded parts and waits until Artifactory is done.
+func (s *ArtifactsService) completeMultipartUpload(ctx context.Context, upload *MultipartUpload) error {
+	completeCtx := withOperation(ctx, "Artifacts.CompleteMultipartUpload", "/api/v1/uploads/complete")
+	statusCtx := withOperation(ctx, "Artifacts.GetMultipartUploadStatus", "/api/v1/uploads/status")
+
+	u := "/api/v1/uploads/complete?sha1=" + url.QueryEscape(upload.SHA1)
+
+	req, err := s.client.NewRequestWithContext(completeCtx, "POST", u, nil)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("X-JFrog-Upload-Token", upload.Token)
+
+	_, err = s.client.DoContext(completeCtx, req, nil)
+	if err != nil {
+		return err
+	}
+
+	for {
+		req, err := s.client.NewRequestWithContext(statusCtx, "POST", "/api/v1/uploads/status", nil)
+		if err != nil {
+			return err
+		}
+
+		req.Header.Set("X-JFrog-Upload-Token", upload.Token)
+
+		v := new(multipartStatus)
+
+		_, err = s.client.DoContext(statusCtx, req, v)
+		if err != nil {
+			return err
+		}
+
+		switch status := v.Status; {
+		case status == nil:
+			return errors.New("no upload status returned by Artifactory")
+		case *status == "FINISHED":
+			return nil
+		case *status == "ABORTED":
+			if v.Error != nil {
+				return fmt.Errorf("upload aborted: %s", *v.Error)
+			}
+
+			return errors.New("upload aborted")
+		}
+
+		if err := rest.Sleep(ctx, multipartPollInterval); err != nil {
+			return err
+		}
+	}
+}
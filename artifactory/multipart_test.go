@@ -0,0 +1,247 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifactory
+
+import (
+	"bytes"
+	"crypto/sha1" //nolint:gosec
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/franela/goblin"
+)
+
+func Test_Multipart(t *testing.T) {
+	content := make([]byte, 2*sizeMiB+sizeMiB/2)
+	rand.New(rand.NewSource(1)).Read(content)
+
+	var (
+		mu       sync.Mutex
+		parts    map[int][]byte
+		puts     []int
+		failPart int
+		failures int
+		polls    int
+		badSum   bool
+		singles  int
+	)
+
+	assemble := func() []byte {
+		var b []byte
+		for i := 1; i <= len(parts); i++ {
+			b = append(b, parts[i]...)
+		}
+
+		return b
+	}
+
+	// Create http test server that emulates the upload endpoints
+	// and the pre-signed storage URLs of the parts
+	var s *httptest.Server
+	s = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch {
+		case r.URL.Path == "/api/v1/uploads/create":
+			if r.URL.Query().Get("partSizeMB") != "1" {
+				w.WriteHeader(400)
+				return
+			}
+
+			fmt.Fprint(w, `{"token":"upload-token"}`)
+		case r.URL.Path == "/api/v1/uploads/urlPart":
+			if r.Header.Get("X-JFrog-Upload-Token") != "upload-token" {
+				w.WriteHeader(401)
+				return
+			}
+
+			fmt.Fprintf(w, `{"url":"%s/storage/%s"}`, s.URL, r.URL.Query().Get("partNumber"))
+		case strings.HasPrefix(r.URL.Path, "/storage/"):
+			if len(r.Header.Get("Authorization")) > 0 {
+				w.WriteHeader(400)
+				return
+			}
+
+			part, _ := strconv.Atoi(strings.TrimPrefix(r.URL.Path, "/storage/"))
+			puts = append(puts, part)
+
+			if part == failPart && failures > 0 {
+				failures--
+				w.WriteHeader(503)
+				return
+			}
+
+			parts[part], _ = ioutil.ReadAll(r.Body)
+		case r.URL.Path == "/api/v1/uploads/complete":
+			sum := sha1.Sum(assemble()) //nolint:gosec
+			if r.URL.Query().Get("sha1") != hex.EncodeToString(sum[:]) {
+				w.WriteHeader(409)
+				fmt.Fprint(w, `{"errors":[{"status":409,"message":"Checksum mismatch"}]}`)
+				return
+			}
+
+			w.WriteHeader(202)
+		case r.URL.Path == "/api/v1/uploads/status":
+			polls++
+			if polls == 1 {
+				fmt.Fprint(w, `{"status":"PROCESSING"}`)
+				return
+			}
+
+			fmt.Fprint(w, `{"status":"FINISHED"}`)
+		case r.URL.Path == "/api/storage/local-repo1/large.bin":
+			sum := sha256.Sum256(assemble())
+			if badSum {
+				sum = sha256.Sum256(nil)
+			}
+
+			fmt.Fprintf(w, `{"repo":"local-repo1","path":"/large.bin","checksums":{"sha256":"%s"}}`, hex.EncodeToString(sum[:]))
+		case r.Method == "PUT" && r.URL.Path == "/local-repo1/small.bin":
+			singles++
+
+			w.WriteHeader(201)
+			fmt.Fprint(w, `{"repo":"local-repo1","path":"/small.bin"}`)
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+
+	// Create the client to interact with the http test server
+	c, _ := NewClient(s.URL, nil)
+	c.Authentication.SetBearerAuth("token")
+
+	multipartPollInterval = time.Millisecond
+
+	g := goblin.Goblin(t)
+	g.Describe("Multipart Upload", func() {
+		var opts *LargeUploadOptions
+
+		g.BeforeEach(func() {
+			parts, puts, polls, singles = map[int][]byte{}, nil, 0, 0
+			failPart, failures, badSum = 0, 0, false
+
+			c.RetryPolicy = nil
+			opts = &LargeUploadOptions{Threshold: sizeMiB, PartSize: sizeMiB, Workers: 2}
+		})
+
+		// Close http test server after we're done using it
+		g.After(func() {
+			s.Close()
+		})
+
+		g.It("- should upload large content in parts", func() {
+			var transferred int64
+			opts.Progress = func(n, total int64) {
+				transferred = n
+			}
+
+			f, _, err := c.Artifacts.UploadLarge("local-repo1", "large.bin", bytes.NewReader(content), int64(len(content)), opts)
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(f.GetPath()).Equal("/large.bin")
+			g.Assert(len(parts)).Equal(3)
+			g.Assert(bytes.Equal(assemble(), content)).IsTrue()
+			g.Assert(transferred).Equal(int64(len(content)))
+			g.Assert(polls).Equal(2)
+		})
+
+		g.It("- should upload small content with a single request", func() {
+			f, _, err := c.Artifacts.UploadLarge("local-repo1", "small.bin", bytes.NewReader(content[:10]), 10, opts)
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(f.GetPath()).Equal("/small.bin")
+			g.Assert(singles).Equal(1)
+			g.Assert(len(puts)).Equal(0)
+		})
+
+		g.It("- should retry a failed part", func() {
+			c.RetryPolicy = &RetryPolicy{MaxAttempts: 2, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond}
+			failPart, failures = 2, 1
+
+			_, _, err := c.Artifacts.UploadLarge("local-repo1", "large.bin", bytes.NewReader(content), int64(len(content)), opts)
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(len(puts)).Equal(4)
+			g.Assert(bytes.Equal(assemble(), content)).IsTrue()
+		})
+
+		g.It("- should resume from the acknowledged parts", func() {
+			opts.Workers = 1
+			failPart, failures = 2, 1
+
+			_, _, err := c.Artifacts.UploadLarge("local-repo1", "large.bin", bytes.NewReader(content), int64(len(content)), opts)
+
+			mErr := new(MultipartUploadError)
+			g.Assert(errors.As(err, &mErr)).IsTrue()
+			g.Assert(mErr.Upload.Completed).Equal([]int{1})
+			g.Assert(len(parts)).Equal(1)
+
+			puts = nil
+			opts.Resume = mErr.Upload
+
+			_, _, err = c.Artifacts.UploadLarge("local-repo1", "large.bin", bytes.NewReader(content), int64(len(content)), opts)
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(puts).Equal([]int{2, 3})
+			g.Assert(bytes.Equal(assemble(), content)).IsTrue()
+		})
+
+		g.It("- should describe the operation of each request", func() {
+			var calls []string
+			m := &recordingMiddleware{name: "m", calls: &calls}
+
+			c, _ := NewClient(s.URL, nil, WithMiddleware(m))
+			opts.Workers = 1
+
+			_, _, err := c.Artifacts.UploadLarge("local-repo1", "large.bin", bytes.NewReader(content), int64(len(content)), opts)
+			g.Assert(err == nil).IsTrue()
+
+			operations := map[string]string{}
+			for _, info := range m.infos {
+				operations[info.Operation] = info.Path
+			}
+
+			g.Assert(operations["Artifacts.CreateMultipartUpload"]).Equal("/api/v1/uploads/create")
+			g.Assert(operations["Artifacts.GetMultipartPartURL"]).Equal("/api/v1/uploads/urlPart")
+			g.Assert(operations["Artifacts.CompleteMultipartUpload"]).Equal("/api/v1/uploads/complete")
+			g.Assert(operations["Artifacts.GetMultipartUploadStatus"]).Equal("/api/v1/uploads/status")
+
+			_, ok := operations["Artifacts.UploadLarge"]
+			g.Assert(ok).IsFalse()
+		})
+
+		g.It("- should return an error when the checksum does not match", func() {
+			badSum = true
+
+			_, _, err := c.Artifacts.UploadLarge("local-repo1", "large.bin", bytes.NewReader(content), int64(len(content)), opts)
+
+			cErr := new(ChecksumError)
+			g.Assert(errors.As(err, &cErr)).IsTrue()
+			g.Assert(cErr.Algorithm).Equal("sha256")
+		})
+	})
+}
@@ -0,0 +1,189 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifactory
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/franela/goblin"
+)
+
+// fakeNode is an http test server recording the requests it receives.
+type fakeNode struct {
+	*httptest.Server
+
+	mu     sync.Mutex
+	paths  []string
+	status int
+}
+
+func newFakeNode() *fakeNode {
+	n := &fakeNode{status: http.StatusOK}
+	n.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n.mu.Lock()
+		defer n.mu.Unlock()
+
+		n.paths = append(n.paths, r.URL.Path)
+
+		w.WriteHeader(n.status)
+		w.Write([]byte(`"OK"`))
+	}))
+
+	return n
+}
+
+func (n *fakeNode) reset(status int) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.paths, n.status = nil, status
+}
+
+func Test_Nodes(t *testing.T) {
+	// Create http test servers for the nodes, and the URL of a node that is down
+	first, second := newFakeNode(), newFakeNode()
+
+	dead := httptest.NewServer(http.NotFoundHandler())
+	dead.Close()
+
+	g := goblin.Goblin(t)
+	g.Describe("Nodes", func() {
+		g.BeforeEach(func() {
+			first.reset(http.StatusOK)
+			second.reset(http.StatusOK)
+		})
+
+		// Close http test servers after we're done using them
+		g.After(func() {
+			first.Close()
+			second.Close()
+		})
+
+		g.It("- should spread requests across the nodes", func() {
+			c, _ := NewClient(first.URL, nil, WithNodes(RoundRobin, second.URL))
+
+			for i := 0; i < 4; i++ {
+				_, _, err := c.Repositories.GetAll()
+				g.Assert(err == nil).IsTrue()
+			}
+
+			g.Assert(len(first.paths)).Equal(2)
+			g.Assert(len(second.paths)).Equal(2)
+		})
+
+		g.It("- should send requests to the primary node", func() {
+			c, _ := NewClient(first.URL, nil, WithNodes(PrimarySecondary, second.URL))
+
+			for i := 0; i < 3; i++ {
+				_, _, err := c.Repositories.GetAll()
+				g.Assert(err == nil).IsTrue()
+			}
+
+			g.Assert(len(first.paths)).Equal(3)
+			g.Assert(len(second.paths)).Equal(0)
+		})
+
+		g.It("- should keep the path of the nodes", func() {
+			c, _ := NewClient(first.URL+"/artifactory", nil, WithNodes(PrimarySecondary, second.URL+"/artifactory"))
+			first.reset(http.StatusBadGateway)
+
+			_, _, err := c.Repositories.GetAll()
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(first.paths).Equal([]string{"/artifactory/api/repositories"})
+			g.Assert(second.paths).Equal([]string{"/artifactory/api/repositories"})
+		})
+
+		g.It("- should fail over when a node is down", func() {
+			c, _ := NewClient(dead.URL, nil, WithNodes(PrimarySecondary, first.URL))
+
+			_, _, err := c.Repositories.GetAll()
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(len(first.paths)).Equal(1)
+			g.Assert(c.Nodes()).Equal([]NodeStatus{{URL: dead.URL, Healthy: false}, {URL: first.URL, Healthy: true}})
+		})
+
+		g.It("- should fail over when a node returns 5xx", func() {
+			c, _ := NewClient(first.URL, nil, WithNodes(PrimarySecondary, second.URL))
+			first.reset(http.StatusServiceUnavailable)
+
+			_, _, err := c.Repositories.GetAll()
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(len(first.paths)).Equal(1)
+			g.Assert(len(second.paths)).Equal(1)
+		})
+
+		g.It("- should not fail over non idempotent requests", func() {
+			c, _ := NewClient(first.URL, nil, WithNodes(PrimarySecondary, second.URL))
+			first.reset(http.StatusServiceUnavailable)
+
+			_, err := c.Call("POST", "/api/copy/repo/foo", nil, nil)
+
+			g.Assert(err != nil).IsTrue()
+			g.Assert(len(second.paths)).Equal(0)
+		})
+
+		g.It("- should return the error when every node fails", func() {
+			c, _ := NewClient(first.URL, nil, WithNodes(RoundRobin, second.URL))
+			first.reset(http.StatusBadGateway)
+			second.reset(http.StatusBadGateway)
+
+			_, resp, err := c.Repositories.GetAll()
+
+			g.Assert(err != nil).IsTrue()
+			g.Assert(resp.StatusCode).Equal(http.StatusBadGateway)
+			g.Assert(len(first.paths) + len(second.paths)).Equal(2)
+		})
+
+		g.It("- should ping a failed node after its cooldown", func() {
+			c, _ := NewClient(first.URL, nil, WithNodes(PrimarySecondary, second.URL), WithNodeCooldown(10*time.Millisecond))
+			first.reset(http.StatusServiceUnavailable)
+
+			_, _, err := c.Repositories.GetAll()
+			g.Assert(err == nil).IsTrue()
+
+			_, _, err = c.Repositories.GetAll()
+			g.Assert(err == nil).IsTrue()
+			g.Assert(len(first.paths)).Equal(1)
+
+			first.reset(http.StatusOK)
+			time.Sleep(20 * time.Millisecond)
+
+			_, _, err = c.Repositories.GetAll()
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(first.paths).Equal([]string{"/api/system/ping", "/api/repositories"})
+		})
+
+		g.It("- should check the health of every node", func() {
+			c, _ := NewClient(first.URL, nil, WithNodes(RoundRobin, second.URL, dead.URL))
+			second.reset(http.StatusUnauthorized)
+
+			statuses := c.CheckNodes()
+
+			g.Assert(statuses).Equal([]NodeStatus{
+				{URL: first.URL, Healthy: true},
+				{URL: second.URL, Healthy: true},
+				{URL: dead.URL, Healthy: false},
+			})
+		})
+	})
+}
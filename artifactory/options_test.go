@@ -0,0 +1,68 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifactory
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/franela/goblin"
+	"github.com/target/go-arty/v2/internal/rest"
+)
+
+func Test_Options(t *testing.T) {
+	var header http.Header
+
+	// Create http test server recording the header of the requests
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header
+		w.Write([]byte(`OK`))
+	}))
+
+	g := goblin.Goblin(t)
+	g.Describe("Options", func() {
+		// Close http test server after we're done using it
+		g.After(func() {
+			s.Close()
+		})
+
+		g.It("- should apply the options to the http client", func() {
+			base := &http.Client{}
+
+			c, err := NewClient(s.URL, base, WithTimeout(time.Second))
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(base.Timeout).Equal(time.Duration(0))
+			g.Assert(rest.HTTPClient(c.core).Timeout).Equal(time.Second)
+		})
+
+		g.It("- should send the user agent with its suffix", func() {
+			c, _ := NewClient(s.URL, nil, WithUserAgentSuffix("ci-agent/1.0"))
+
+			_, _, err := c.System.Ping()
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(header.Get("User-Agent")).Equal("go-arty ci-agent/1.0")
+		})
+
+		g.It("- should return the errors of the options", func() {
+			_, err := NewClient(s.URL, nil, WithCABundle([]byte("not a certificate")))
+
+			g.Assert(err != nil).IsTrue()
+		})
+	})
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifactory
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/target/go-arty/v2/internal/rest"
+)
+
+// matrixEscaper escapes the characters url.PathEscape leaves in a path
+// segment that are special in matrix parameters.
+var matrixEscaper = strings.NewReplacer("=", "%3D", "+", "%2B")
+
+// propertyEscaper escapes the characters that are special in the
+// properties query parameter of the storage API with a backslash.
+var propertyEscaper = strings.NewReplacer(`\`, `\\`, ",", `\,`, "|", `\|`, "=", `\=`, ";", `\;`)
+
+// escapePath escapes each segment of the provided slash separated path,
+// so that it can be placed inside a URL path.
+func escapePath(path string) string {
+	return rest.EscapePath(path)
+}
+
+// itemPath returns the escaped path of the provided item, in the form
+// "repo/path", to be placed inside a URL path.
+func itemPath(repo, path string) string {
+	return escapePath(repo) + "/" + escapePath(path)
+}
+
+// matrixParams returns the provided properties as escaped matrix
+// parameters, like ";key=value1,value2", in the order of their keys.
+// It returns an empty string if there are no properties.
+func matrixParams(properties map[string][]string) string {
+	var b strings.Builder
+	for _, k := range sortedKeys(properties) {
+		values := make([]string, len(properties[k]))
+		for i, v := range properties[k] {
+			values[i] = matrixEscaper.Replace(url.PathEscape(v))
+		}
+
+		b.WriteString(";" + matrixEscaper.Replace(url.PathEscape(k)) + "=" + strings.Join(values, ","))
+	}
+
+	return b.String()
+}
+
+// propertiesQuery returns the provided properties as the value of the
+// properties query parameter of the storage API, like
+// "key=value1,value2;other=value", in the order of their keys.
+// The value still has to be escaped as a query parameter.
+func propertiesQuery(properties map[string][]string) string {
+	pairs := make([]string, 0, len(properties))
+	for _, k := range sortedKeys(properties) {
+		values := make([]string, len(properties[k]))
+		for i, v := range properties[k] {
+			values[i] = propertyEscaper.Replace(v)
+		}
+
+		pairs = append(pairs, propertyEscaper.Replace(k)+"="+strings.Join(values, ","))
+	}
+
+	return strings.Join(pairs, ";")
+}
+
+// propertyNames returns the provided property names as the value of the
+// properties query parameter of the storage API, like "key,other".
+// The value still has to be escaped as a query parameter.
+func propertyNames(names []string) string {
+	escaped := make([]string, len(names))
+	for i, name := range names {
+		escaped[i] = propertyEscaper.Replace(name)
+	}
+
+	return strings.Join(escaped, ",")
+}
+
+// sortedKeys returns the keys of the provided map in order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifactory
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func Test_matrixParams(t *testing.T) {
+	var tests = []struct {
+		in  map[string][]string
+		out string
+	}{
+		{nil, ""},
+		{map[string][]string{"b": {"2"}, "a": {"1", "x"}}, ";a=1,x;b=2"},
+		{map[string][]string{"k;=": {"v=1", "a,b", "c d+e/f"}}, ";k%3B%3D=v%3D1,a%2Cb,c%20d%2Be%2Ff"},
+	}
+
+	for i, tt := range tests {
+		if got := matrixParams(tt.in); got != tt.out {
+			t.Errorf("matrixParams #%d returned %q, want %q", i, got, tt.out)
+		}
+	}
+}
+
+func Test_propertiesQuery(t *testing.T) {
+	var tests = []struct {
+		in  map[string][]string
+		out string
+	}{
+		{nil, ""},
+		{map[string][]string{"os": {"win", "linux"}, "qa": {"done"}}, "os=win,linux;qa=done"},
+		{map[string][]string{`a=b`: {`1,2`, `x;y|z\`}}, `a\=b=1\,2,x\;y\|z\\`},
+	}
+
+	for i, tt := range tests {
+		if got := propertiesQuery(tt.in); got != tt.out {
+			t.Errorf("propertiesQuery #%d returned %q, want %q", i, got, tt.out)
+		}
+	}
+
+	if got, want := propertyNames([]string{"os", "a,b"}), `os,a\,b`; got != want {
+		t.Errorf("propertyNames returned %q, want %q", got, want)
+	}
+}
+
+func Test_servicePaths(t *testing.T) {
+	var (
+		mu   sync.Mutex
+		path string
+	)
+
+	// Create http test server recording the escaped path of the requests
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		path = r.URL.EscapedPath()
+		mu.Unlock()
+
+		w.Write([]byte(`{}`))
+	}))
+	defer s.Close()
+
+	c, _ := NewClient(s.URL, nil)
+
+	name := "my name#1?;v"
+
+	var tests = []struct {
+		call func()
+		out  string
+	}{
+		{func() { c.Build.GetInfo(name, "1 2") }, "/api/build/my%20name%231%3F%3Bv/1%202"},
+		{func() { c.Docker.GetTags("docker local", "org/my image") }, "/api/docker/docker%20local/v2/org/my%20image/tags/list"},
+		{func() { c.Groups.Delete(name) }, "/api/security/groups/my%20name%231%3F%3Bv"},
+		{func() { c.Groups.Get(&GetGroupRequest{Name: String(name), IncludeUsers: Bool(false)}) }, "/api/security/groups/my%20name%231%3F%3Bv"},
+		{func() { c.Permissions.Get(name) }, "/api/security/permissions/my%20name%231%3F%3Bv"},
+		{func() { c.PermissionsV2.Get(name) }, "/api/v2/security/permissions/my%20name%231%3F%3Bv"},
+		{func() { c.Replications.Get(name) }, "/api/replications/my%20name%231%3F%3Bv"},
+		{func() { c.Repositories.Delete(name) }, "/api/repositories/my%20name%231%3F%3Bv"},
+		{func() { c.Users.GetSecurity(name) }, "/api/security/users/my%20name%231%3F%3Bv"},
+		{func() { c.Users.DeleteUserAPIKey(name) }, "/api/security/apiKey/my%20name%231%3F%3Bv"},
+	}
+
+	for i, tt := range tests {
+		tt.call()
+
+		mu.Lock()
+		got := path
+		mu.Unlock()
+
+		if got != tt.out {
+			t.Errorf("service path #%d is %q, want %q", i, got, tt.out)
+		}
+	}
+}
@@ -17,6 +17,7 @@
 package artifactory
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -52,10 +53,17 @@ type Principals struct {
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-GetPermissionTargets
 func (s *PermissionsService) GetAll() (*[]PermissionTarget, *Response, error) {
+	return s.GetAllWithContext(context.Background())
+}
+
+// GetAllWithContext returns a list of all permission targets using the provided context.
+func (s *PermissionsService) GetAllWithContext(ctx context.Context) (*[]PermissionTarget, *Response, error) {
+	ctx = withOperation(ctx, "Permissions.GetAll", "/api/security/permissions")
+
 	u := fmt.Sprintf("/api/security/permissions")
 	v := new([]PermissionTarget)
 
-	resp, err := s.client.Call("GET", u, nil, v)
+	resp, err := s.client.CallContext(ctx, "GET", u, nil, v)
 	return v, resp, err
 }
 
@@ -63,10 +71,17 @@ func (s *PermissionsService) GetAll() (*[]PermissionTarget, *Response, error) {
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-GetPermissionTargetDetails
 func (s *PermissionsService) Get(target string) (*PermissionTarget, *Response, error) {
-	u := fmt.Sprintf("/api/security/permissions/%s", target)
+	return s.GetWithContext(context.Background(), target)
+}
+
+// GetWithContext returns the provided permission target using the provided context.
+func (s *PermissionsService) GetWithContext(ctx context.Context, target string) (*PermissionTarget, *Response, error) {
+	ctx = withOperation(ctx, "Permissions.Get", "/api/security/permissions/{target}")
+
+	u := fmt.Sprintf("/api/security/permissions/%s", escapePath(target))
 	v := new(PermissionTarget)
 
-	resp, err := s.client.Call("GET", u, nil, v)
+	resp, err := s.client.CallContext(ctx, "GET", u, nil, v)
 	return v, resp, err
 }
 
@@ -74,10 +89,17 @@ func (s *PermissionsService) Get(target string) (*PermissionTarget, *Response, e
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-CreateorReplacePermissionTarget
 func (s *PermissionsService) Create(target *PermissionTarget) (*string, *Response, error) {
-	u := fmt.Sprintf("/api/security/permissions/%s", *target.Name)
+	return s.CreateWithContext(context.Background(), target)
+}
+
+// CreateWithContext constructs a permission target with the provided details using the provided context.
+func (s *PermissionsService) CreateWithContext(ctx context.Context, target *PermissionTarget) (*string, *Response, error) {
+	ctx = withOperation(ctx, "Permissions.Create", "/api/security/permissions/{target}")
+
+	u := fmt.Sprintf("/api/security/permissions/%s", escapePath(*target.Name))
 	v := new(string)
 
-	resp, err := s.client.Call("PUT", u, target, v)
+	resp, err := s.client.CallContext(ctx, "PUT", u, target, v)
 	return v, resp, err
 }
 
@@ -85,10 +107,17 @@ func (s *PermissionsService) Create(target *PermissionTarget) (*string, *Respons
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-CreateorReplacePermissionTarget
 func (s *PermissionsService) Update(target *PermissionTarget) (*string, *Response, error) {
-	u := fmt.Sprintf("/api/security/permissions/%s", *target.Name)
+	return s.UpdateWithContext(context.Background(), target)
+}
+
+// UpdateWithContext modifies a permission target with the provided details using the provided context.
+func (s *PermissionsService) UpdateWithContext(ctx context.Context, target *PermissionTarget) (*string, *Response, error) {
+	ctx = withOperation(ctx, "Permissions.Update", "/api/security/permissions/{target}")
+
+	u := fmt.Sprintf("/api/security/permissions/%s", escapePath(*target.Name))
 	v := new(string)
 
-	resp, err := s.client.Call("PUT", u, target, v)
+	resp, err := s.client.CallContext(ctx, "PUT", u, target, v)
 	return v, resp, err
 }
 
@@ -96,9 +125,16 @@ func (s *PermissionsService) Update(target *PermissionTarget) (*string, *Respons
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-DeletePermissionTarget
 func (s *PermissionsService) Delete(target string) (*string, *Response, error) {
-	u := fmt.Sprintf("/api/security/permissions/%s", target)
+	return s.DeleteWithContext(context.Background(), target)
+}
+
+// DeleteWithContext removes the provided permission target using the provided context.
+func (s *PermissionsService) DeleteWithContext(ctx context.Context, target string) (*string, *Response, error) {
+	ctx = withOperation(ctx, "Permissions.Delete", "/api/security/permissions/{target}")
+
+	u := fmt.Sprintf("/api/security/permissions/%s", escapePath(target))
 	v := new(string)
 
-	resp, err := s.client.Call("DELETE", u, nil, v)
+	resp, err := s.client.CallContext(ctx, "DELETE", u, nil, v)
 	return v, resp, err
 }
@@ -17,8 +17,8 @@
 package artifactory
 
 import (
+	"context"
 	"fmt"
-	"net/http"
 )
 
 // PermissionsServiceV2 handles communication with the permissions related
@@ -31,9 +31,16 @@ type PermissionsServiceV2 service
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API+V2#ArtifactoryRESTAPIV2-PermissionTargetexistencecheck
 func (s *PermissionsServiceV2) Exists(target string) (bool, error) {
-	u := fmt.Sprintf("/api/v2/security/permissions/%s", target)
-	resp, err := s.client.Call("HEAD", u, nil, nil)
-	if resp != nil && resp.StatusCode == http.StatusNotFound {
+	return s.ExistsWithContext(context.Background(), target)
+}
+
+// ExistsWithContext validates if the specific permission target exists using the provided context.
+func (s *PermissionsServiceV2) ExistsWithContext(ctx context.Context, target string) (bool, error) {
+	ctx = withOperation(ctx, "PermissionsV2.Exists", "/api/v2/security/permissions/{target}")
+
+	u := fmt.Sprintf("/api/v2/security/permissions/%s", escapePath(target))
+	_, err := s.client.CallContext(ctx, "HEAD", u, nil, nil)
+	if IsNotFound(err) {
 		return false, nil
 	} else if err != nil {
 		return false, err
@@ -74,10 +81,17 @@ func (p PermissionTargetV2) String() string {
 //
 // Docs: https://www.jfrog.com/confluence/display/JFROG/Artifactory+REST+API+V2#ArtifactoryRESTAPIV2-UpdatePermissionTarget
 func (s *PermissionsServiceV2) Update(target *PermissionTargetV2) (*string, *Response, error) {
-	u := fmt.Sprintf("/api/v2/security/permissions/%s", *target.Name)
+	return s.UpdateWithContext(context.Background(), target)
+}
+
+// UpdateWithContext creates a new permission target or replaces an existing permission target using the provided context.
+func (s *PermissionsServiceV2) UpdateWithContext(ctx context.Context, target *PermissionTargetV2) (*string, *Response, error) {
+	ctx = withOperation(ctx, "PermissionsV2.Update", "/api/v2/security/permissions/{target}")
+
+	u := fmt.Sprintf("/api/v2/security/permissions/%s", escapePath(*target.Name))
 	v := new(string)
 
-	resp, err := s.client.Call("PUT", u, target, v)
+	resp, err := s.client.CallContext(ctx, "PUT", u, target, v)
 	return v, resp, err
 }
 
@@ -85,9 +99,16 @@ func (s *PermissionsServiceV2) Update(target *PermissionTargetV2) (*string, *Res
 //
 // Docs: https://www.jfrog.com/confluence/display/JFROG/Artifactory+REST+API+V2#ArtifactoryRESTAPIV2-GetPermissionTargetDetails
 func (s *PermissionsServiceV2) Get(target string) (*PermissionTargetV2, *Response, error) {
-	u := fmt.Sprintf("/api/v2/security/permissions/%s", target)
+	return s.GetWithContext(context.Background(), target)
+}
+
+// GetWithContext returns the provided permission target using the provided context.
+func (s *PermissionsServiceV2) GetWithContext(ctx context.Context, target string) (*PermissionTargetV2, *Response, error) {
+	ctx = withOperation(ctx, "PermissionsV2.Get", "/api/v2/security/permissions/{target}")
+
+	u := fmt.Sprintf("/api/v2/security/permissions/%s", escapePath(target))
 	v := new(PermissionTargetV2)
 
-	resp, err := s.client.Call("GET", u, nil, v)
+	resp, err := s.client.CallContext(ctx, "GET", u, nil, v)
 	return v, resp, err
 }
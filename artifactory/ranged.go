@@ -0,0 +1,387 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifactory
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/target/go-arty/v2/internal/rest"
+)
+
+const (
+	defaultSegmentSize      = 20 * sizeMiB
+	defaultDownloadWorkers  = 4
+	partialDownloadSuffix   = ".part"
+	downloadStateFileSuffix = ".part.json"
+)
+
+// LargeDownloadOptions represents the options for downloading a large artifact
+// in segments fetched concurrently.
+type LargeDownloadOptions struct {
+	// Progress is called as the artifact is downloaded, if set.
+	Progress ProgressFunc
+
+	// SegmentSize is the size of each segment.
+	// Defaults to 20 MiB.
+	SegmentSize int64
+
+	// Workers is the number of segments downloaded concurrently.
+	// Defaults to 4.
+	Workers int
+}
+
+// downloadState records the segments of a partial download written to disk,
+// so an interrupted download can be resumed.
+type downloadState struct {
+	Size        int64  `json:"size"`
+	SHA256      string `json:"sha256"`
+	SegmentSize int64  `json:"segmentSize"`
+	Completed   []int  `json:"completed"`
+}
+
+// DownloadLarge downloads the provided artifact to the dest file, fetching
+// segments of it concurrently with Range requests.
+//
+// The artifact is written to dest with a ".part" suffix, alongside a
+// ".part.json" file recording the segments written so far. If the download
+// is interrupted, calling DownloadLarge again for the same artifact resumes
+// it from the recorded segments. A segment that fails midway is requested
+// again from where it stopped, as allowed by the RetryPolicy of the Client.
+//
+// Once all segments are written, the SHA-256 checksum of the file is verified
+// against the checksums returned by StorageService.GetFile, and dest is only
+// created if they match. Otherwise a *ChecksumError is returned and the partial
+// download is discarded.
+//
+// Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-RetrieveArtifact
+func (s *ArtifactsService) DownloadLarge(repo, path, dest string, opts *LargeDownloadOptions) (*File, *Response, error) {
+	return s.DownloadLargeWithContext(context.Background(), repo, path, dest, opts)
+}
+
+// DownloadLargeWithContext downloads the provided artifact to the dest file in concurrent segments using the provided context.
+func (s *ArtifactsService) DownloadLargeWithContext(ctx context.Context, repo, path, dest string, opts *LargeDownloadOptions) (*File, *Response, error) {
+	ctx = withOperation(ctx, "Artifacts.DownloadLarge", "/{repo}/{path}")
+
+	if opts == nil {
+		opts = new(LargeDownloadOptions)
+	}
+
+	file, resp, err := s.freshFileInfo(ctx, repo, path)
+	if err != nil {
+		return file, resp, err
+	}
+
+	size, err := strconv.ParseInt(file.GetSize(), 10, 64)
+	if err != nil {
+		return file, resp, fmt.Errorf("invalid size %q of %s/%s: %w", file.GetSize(), repo, path, err)
+	}
+
+	expected := file.GetChecksums().GetSHA256()
+	if len(expected) == 0 {
+		return file, resp, fmt.Errorf("no sha256 checksum returned by Artifactory for %s/%s", repo, path)
+	}
+
+	segmentSize := opts.SegmentSize
+	if segmentSize <= 0 {
+		segmentSize = defaultSegmentSize
+	}
+
+	partial := dest + partialDownloadSuffix
+	stateFile := dest + downloadStateFileSuffix
+
+	// A previous download is only resumed if it was for the same content.
+	state := readDownloadState(stateFile)
+	if state == nil || state.Size != size || state.SHA256 != expected || state.SegmentSize != segmentSize {
+		state = &downloadState{Size: size, SHA256: expected, SegmentSize: segmentSize}
+	}
+
+	f, err := os.OpenFile(partial, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return file, resp, err
+	}
+
+	err = s.downloadSegments(ctx, fmt.Sprintf("/%s", itemPath(repo, path)), f, state, stateFile, opts)
+	if err != nil {
+		_ = f.Close()
+		return file, resp, err
+	}
+
+	err = verifyDownload(f, size, expected)
+	_ = f.Close()
+
+	if err != nil {
+		_ = os.Remove(partial)
+		_ = os.Remove(stateFile)
+
+		return file, resp, err
+	}
+
+	err = os.Rename(partial, dest)
+	if err != nil {
+		return file, resp, err
+	}
+
+	_ = os.Remove(stateFile)
+
+	return file, resp, nil
+}
+
+// readDownloadState returns the state recorded in the provided file, or nil if there is none.
+func readDownloadState(name string) *downloadState {
+	b, err := ioutil.ReadFile(name)
+	if err != nil {
+		return nil
+	}
+
+	state := new(downloadState)
+	if err := json.Unmarshal(b, state); err != nil {
+		return nil
+	}
+
+	return state
+}
+
+// verifyDownload checks the size and the SHA-256 checksum of the downloaded file.
+func verifyDownload(f *os.File, size int64, expected string) error {
+	err := f.Truncate(size)
+	if err != nil {
+		return err
+	}
+
+	_, err = f.Seek(0, io.SeekStart)
+	if err != nil {
+		return err
+	}
+
+	h := sha256.New()
+
+	_, err = io.Copy(h, f)
+	if err != nil {
+		return err
+	}
+
+	actual := hex.EncodeToString(h.Sum(nil))
+	if !strings.EqualFold(actual, expected) {
+		return &ChecksumError{Algorithm: "sha256", Expected: expected, Actual: actual}
+	}
+
+	return nil
+}
+
+// downloadSegments downloads the segments not yet written, using a pool of workers.
+// The state file is updated as segments complete. The first failure stops the
+// remaining workers.
+func (s *ArtifactsService) downloadSegments(ctx context.Context, u string, f *os.File, state *downloadState, stateFile string, opts *LargeDownloadOptions) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = defaultDownloadWorkers
+	}
+
+	segments := int((state.Size + state.SegmentSize - 1) / state.SegmentSize)
+
+	done := make(map[int]bool, len(state.Completed))
+	for _, segment := range state.Completed {
+		done[segment] = true
+	}
+
+	var (
+		mu          sync.Mutex
+		firstErr    error
+		transferred int64
+		wg          sync.WaitGroup
+		jobs        = make(chan int)
+	)
+
+	// Segments written before a resume count as transferred.
+	for segment := range done {
+		transferred += segmentLength(state, segment)
+	}
+
+	progress := func(n int64) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		transferred += n
+		if opts.Progress != nil {
+			opts.Progress(transferred, state.Size)
+		}
+	}
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for segment := range jobs {
+				if ctx.Err() != nil {
+					continue
+				}
+
+				err := s.downloadSegment(ctx, u, f, state, segment, progress)
+
+				mu.Lock()
+				if err != nil {
+					if firstErr == nil {
+						firstErr = err
+						cancel()
+					}
+				} else {
+					state.Completed = append(state.Completed, segment)
+					sort.Ints(state.Completed)
+
+					// The recorded state is only needed to resume, so failing
+					// to write it doesn't fail the download.
+					if b, err := json.Marshal(state); err == nil {
+						_ = ioutil.WriteFile(stateFile, b, 0644)
+					}
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for segment := 0; segment < segments; segment++ {
+		if done[segment] {
+			continue
+		}
+
+		select {
+		case jobs <- segment:
+		case <-ctx.Done():
+		}
+	}
+
+	close(jobs)
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+
+	return ctx.Err()
+}
+
+// segmentLength returns the length of the provided segment.
+func segmentLength(state *downloadState, segment int) int64 {
+	offset := int64(segment) * state.SegmentSize
+	if state.Size-offset < state.SegmentSize {
+		return state.Size - offset
+	}
+
+	return state.SegmentSize
+}
+
+// downloadSegment writes a single segment of the artifact to f. If a request
+// fails, or the content stops before the end of the segment, the rest of it is
+// requested again. The requests are sent once each, so that the attempts of
+// the RetryPolicy are shared by both cases.
+func (s *ArtifactsService) downloadSegment(ctx context.Context, u string, f *os.File, state *downloadState, segment int, progress func(int64)) error {
+	pos := int64(segment) * state.SegmentSize
+	end := pos + segmentLength(state, segment)
+
+	buf := make([]byte, 32*1024)
+	sendCtx := rest.ContextWithoutRetry(ctx)
+
+	for attempt := 1; ; attempt++ {
+		req, err := s.client.NewRequestWithContext(sendCtx, "GET", u, nil)
+		if err != nil {
+			return err
+		}
+
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", pos, end-1))
+
+		resp, err := rest.Send(sendCtx, s.client.core, req)
+		if err != nil {
+			if resp != nil {
+				rest.Drain(resp)
+			}
+
+			if attempt >= rest.Attempts(s.client.RetryPolicy, req) || ctx.Err() != nil {
+				return err
+			}
+
+			if err := rest.Sleep(ctx, rest.Backoff(s.client.RetryPolicy, attempt)); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		// A server ignoring the range sends the whole artifact, which is
+		// only usable for a single segment read from the start.
+		if resp.StatusCode != http.StatusPartialContent && (pos != 0 || end != state.Size) {
+			rest.Drain(resp)
+			return fmt.Errorf("range request for %s not supported: %s", u, resp.Status)
+		}
+
+		for pos < end {
+			n := int64(len(buf))
+			if end-pos < n {
+				n = end - pos
+			}
+
+			var read int
+			read, err = resp.Body.Read(buf[:n])
+			if read > 0 {
+				_, werr := f.WriteAt(buf[:read], pos)
+				if werr != nil {
+					_ = resp.Body.Close()
+					return werr
+				}
+
+				pos += int64(read)
+				progress(int64(read))
+			}
+
+			if err != nil {
+				break
+			}
+		}
+
+		_ = resp.Body.Close()
+
+		if pos >= end {
+			return nil
+		}
+
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+
+		if attempt >= rest.Attempts(s.client.RetryPolicy, req) || ctx.Err() != nil {
+			return err
+		}
+
+		if err := rest.Sleep(ctx, rest.Backoff(s.client.RetryPolicy, attempt)); err != nil {
+			return err
+		}
+	}
+}
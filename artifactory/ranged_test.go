@@ -0,0 +1,228 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifactory
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/franela/goblin"
+)
+
+// truncatingWriter drops the connection after writing limit bytes of the body.
+type truncatingWriter struct {
+	http.ResponseWriter
+	limit int
+}
+
+func (w *truncatingWriter) Write(p []byte) (int, error) {
+	if len(p) > w.limit {
+		p = p[:w.limit]
+	}
+
+	n, err := w.ResponseWriter.Write(p)
+	w.limit -= n
+	if err == nil && w.limit == 0 {
+		err = errors.New("connection dropped")
+	}
+
+	return n, err
+}
+
+func Test_Ranged(t *testing.T) {
+	content := make([]byte, 2*sizeMiB+sizeMiB/2)
+	rand.New(rand.NewSource(1)).Read(content)
+
+	var (
+		mu       sync.Mutex
+		ranges   []string
+		dropFrom string
+		drops    int
+		failFrom string
+		fails    int
+		badSum   bool
+	)
+
+	// Create http test server that serves ranges of the artifact
+	// and fails or drops the connection of the selected range
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch r.URL.Path {
+		case "/api/storage/local-repo1/large.bin":
+			sum := sha256.Sum256(content)
+			if badSum {
+				sum = sha256.Sum256(nil)
+			}
+
+			fmt.Fprintf(w, `{"repo":"local-repo1","path":"/large.bin","size":"%d","checksums":{"sha256":"%s"}}`, len(content), hex.EncodeToString(sum[:]))
+		case "/local-repo1/large.bin":
+			ranges = append(ranges, r.Header.Get("Range"))
+
+			if fails > 0 && strings.HasPrefix(r.Header.Get("Range"), failFrom) {
+				fails--
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+
+			if drops > 0 && strings.HasPrefix(r.Header.Get("Range"), dropFrom) {
+				drops--
+				w = &truncatingWriter{ResponseWriter: w, limit: 1000}
+			}
+
+			http.ServeContent(w, r, "large.bin", time.Time{}, bytes.NewReader(content))
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+
+	// Create the client to interact with the http test server
+	c, _ := NewClient(s.URL, nil)
+
+	g := goblin.Goblin(t)
+	g.Describe("Ranged Download", func() {
+		var (
+			dest string
+			opts *LargeDownloadOptions
+		)
+
+		g.BeforeEach(func() {
+			ranges, dropFrom, drops, failFrom, fails, badSum = nil, "", 0, "", 0, false
+
+			c.RetryPolicy = nil
+			dest = filepath.Join(t.TempDir(), "large.bin")
+			opts = &LargeDownloadOptions{SegmentSize: sizeMiB, Workers: 1}
+		})
+
+		// Close http test server after we're done using it
+		g.After(func() {
+			s.Close()
+		})
+
+		g.It("- should download the artifact in segments", func() {
+			var transferred int64
+			opts.Workers = 3
+			opts.Progress = func(n, total int64) {
+				transferred = n
+			}
+
+			f, _, err := c.Artifacts.DownloadLarge("local-repo1", "large.bin", dest, opts)
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(f.GetPath()).Equal("/large.bin")
+			g.Assert(len(ranges)).Equal(3)
+			g.Assert(transferred).Equal(int64(len(content)))
+
+			b, _ := ioutil.ReadFile(dest)
+			g.Assert(bytes.Equal(b, content)).IsTrue()
+
+			_, err = os.Stat(dest + ".part.json")
+			g.Assert(os.IsNotExist(err)).IsTrue()
+		})
+
+		g.It("- should request the rest of a dropped segment", func() {
+			c.RetryPolicy = &RetryPolicy{MaxAttempts: 2, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond}
+			dropFrom, drops = "bytes=1048576-", 1
+
+			_, _, err := c.Artifacts.DownloadLarge("local-repo1", "large.bin", dest, opts)
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(ranges[1]).Equal("bytes=1048576-2097151")
+			g.Assert(ranges[2]).Equal("bytes=1049576-2097151")
+
+			b, _ := ioutil.ReadFile(dest)
+			g.Assert(bytes.Equal(b, content)).IsTrue()
+		})
+
+		g.It("- should retry a failed segment", func() {
+			c.RetryPolicy = &RetryPolicy{MaxAttempts: 2, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond, RetryableStatuses: []int{503}}
+			failFrom, fails = "bytes=1048576-", 1
+
+			_, _, err := c.Artifacts.DownloadLarge("local-repo1", "large.bin", dest, opts)
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(ranges).Equal([]string{"bytes=0-1048575", "bytes=1048576-2097151", "bytes=1048576-2097151", "bytes=2097152-2621439"})
+		})
+
+		g.It("- should not retry a segment more than the policy allows", func() {
+			c.RetryPolicy = &RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond, RetryableStatuses: []int{503}}
+			failFrom, fails = "bytes=1048576-", 10
+
+			_, _, err := c.Artifacts.DownloadLarge("local-repo1", "large.bin", dest, opts)
+
+			g.Assert(err != nil).IsTrue()
+			g.Assert(ranges).Equal([]string{"bytes=0-1048575", "bytes=1048576-2097151", "bytes=1048576-2097151", "bytes=1048576-2097151"})
+		})
+
+		g.It("- should share the attempts of a segment between failed and dropped requests", func() {
+			c.RetryPolicy = &RetryPolicy{MaxAttempts: 2, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond, RetryableStatuses: []int{503}}
+			dropFrom, drops = "bytes=1048576-", 1
+			failFrom, fails = "bytes=1049576-", 1
+
+			_, _, err := c.Artifacts.DownloadLarge("local-repo1", "large.bin", dest, opts)
+
+			g.Assert(err != nil).IsTrue()
+			g.Assert(ranges).Equal([]string{"bytes=0-1048575", "bytes=1048576-2097151", "bytes=1049576-2097151"})
+		})
+
+		g.It("- should resume an interrupted download", func() {
+			dropFrom, drops = "bytes=1048576-", 1
+
+			_, _, err := c.Artifacts.DownloadLarge("local-repo1", "large.bin", dest, opts)
+
+			g.Assert(err != nil).IsTrue()
+			g.Assert(readDownloadState(dest + ".part.json").Completed).Equal([]int{0})
+
+			ranges = nil
+
+			_, _, err = c.Artifacts.DownloadLarge("local-repo1", "large.bin", dest, opts)
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(ranges).Equal([]string{"bytes=1048576-2097151", "bytes=2097152-2621439"})
+
+			b, _ := ioutil.ReadFile(dest)
+			g.Assert(bytes.Equal(b, content)).IsTrue()
+		})
+
+		g.It("- should return an error when the checksum does not match", func() {
+			badSum = true
+
+			_, _, err := c.Artifacts.DownloadLarge("local-repo1", "large.bin", dest, opts)
+
+			cErr := new(ChecksumError)
+			g.Assert(errors.As(err, &cErr)).IsTrue()
+
+			_, err = os.Stat(dest)
+			g.Assert(os.IsNotExist(err)).IsTrue()
+
+			_, err = os.Stat(dest + ".part")
+			g.Assert(os.IsNotExist(err)).IsTrue()
+		})
+	})
+}
@@ -2,9 +2,11 @@ package artifactory
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"net/url"
 )
 
 // ReplicationsService handles communication with the replications related
@@ -63,10 +65,17 @@ func (r MultiPushReplication) String() string {
 //
 // Docs: This endpoint is currently undocumented by JFrog
 func (r *ReplicationsService) GetAll() (*[]Replications, *Response, error) {
+	return r.GetAllWithContext(context.Background())
+}
+
+// GetAllWithContext returns a list of all replications using the provided context.
+func (r *ReplicationsService) GetAllWithContext(ctx context.Context) (*[]Replications, *Response, error) {
+	ctx = withOperation(ctx, "Replications.GetAll", "/api/replications")
+
 	u := fmt.Sprintf("/api/replications")
 	v := new([]Replications)
 
-	resp, err := r.client.Call("GET", u, nil, v)
+	resp, err := r.client.CallContext(ctx, "GET", u, nil, v)
 	return v, resp, err
 }
 
@@ -75,14 +84,21 @@ func (r *ReplicationsService) GetAll() (*[]Replications, *Response, error) {
 // This method returns a slice to maintain consistency.
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-GetRepositoryReplicationConfiguration
 func (r *ReplicationsService) Get(repo string) (*[]Replication, *Response, error) {
-	u := fmt.Sprintf("/api/replications/%s", repo)
+	return r.GetWithContext(context.Background(), repo)
+}
+
+// GetWithContext returns replications for the provided repository using the provided context.
+func (r *ReplicationsService) GetWithContext(ctx context.Context, repo string) (*[]Replication, *Response, error) {
+	ctx = withOperation(ctx, "Replications.Get", "/api/replications/{repo}")
+
+	u := fmt.Sprintf("/api/replications/%s", escapePath(repo))
 	v := new(bytes.Buffer)
 
 	replications := new([]Replication)
 
-	resp, err := r.client.Call("GET", u, nil, v)
+	resp, err := r.client.CallContext(ctx, "GET", u, nil, v)
 	if err != nil {
-		if resp.StatusCode == 404 {
+		if IsNotFound(err) {
 			return replications, resp, err
 		}
 		return nil, resp, err
@@ -93,6 +109,10 @@ func (r *ReplicationsService) Get(repo string) (*[]Replication, *Response, error
 		return nil, resp, err
 	}
 
+	if len(body) == 0 {
+		return replications, resp, nil
+	}
+
 	switch body[0] {
 	case '[':
 		err = json.Unmarshal(body, replications)
@@ -109,10 +129,17 @@ func (r *ReplicationsService) Get(repo string) (*[]Replication, *Response, error
 // If multiple push replications are required CreateMultiPush needs to be used
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-CreateRepository
 func (r *ReplicationsService) Create(repo string, replication *Replication) (*string, *Response, error) {
-	u := fmt.Sprintf("/api/replications/%s", repo)
+	return r.CreateWithContext(context.Background(), repo, replication)
+}
+
+// CreateWithContext constructs a single replication for the provided repository using the provided context.
+func (r *ReplicationsService) CreateWithContext(ctx context.Context, repo string, replication *Replication) (*string, *Response, error) {
+	ctx = withOperation(ctx, "Replications.Create", "/api/replications/{repo}")
+
+	u := fmt.Sprintf("/api/replications/%s", escapePath(repo))
 	v := new(string)
 
-	resp, err := r.client.Call("PUT", u, replication, v)
+	resp, err := r.client.CallContext(ctx, "PUT", u, replication, v)
 	return v, resp, err
 }
 
@@ -120,10 +147,17 @@ func (r *ReplicationsService) Create(repo string, replication *Replication) (*st
 // for a local repository with multiple push replications UpdateMultiPush needs to be used
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-UpdateRepositoryReplicationConfiguration
 func (r *ReplicationsService) Update(repo string, replication *Replication) (*string, *Response, error) {
-	u := fmt.Sprintf("/api/replications/%s", repo)
+	return r.UpdateWithContext(context.Background(), repo, replication)
+}
+
+// UpdateWithContext updates a single replication for the provided repository using the provided context.
+func (r *ReplicationsService) UpdateWithContext(ctx context.Context, repo string, replication *Replication) (*string, *Response, error) {
+	ctx = withOperation(ctx, "Replications.Update", "/api/replications/{repo}")
+
+	u := fmt.Sprintf("/api/replications/%s", escapePath(repo))
 	v := new(string)
 
-	resp, err := r.client.Call("POST", u, replication, v)
+	resp, err := r.client.CallContext(ctx, "POST", u, replication, v)
 	return v, resp, err
 }
 
@@ -131,10 +165,17 @@ func (r *ReplicationsService) Update(repo string, replication *Replication) (*st
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-DeleteRepositoryReplicationConfiguration
 func (r *ReplicationsService) Delete(repo string) (*string, *Response, error) {
-	u := fmt.Sprintf("/api/replications/%s", repo)
+	return r.DeleteWithContext(context.Background(), repo)
+}
+
+// DeleteWithContext deletes the existing replication configuration for the provided repository using the provided context.
+func (r *ReplicationsService) DeleteWithContext(ctx context.Context, repo string) (*string, *Response, error) {
+	ctx = withOperation(ctx, "Replications.Delete", "/api/replications/{repo}")
+
+	u := fmt.Sprintf("/api/replications/%s", escapePath(repo))
 	v := new(string)
 
-	resp, err := r.client.Call("DELETE", u, nil, v)
+	resp, err := r.client.CallContext(ctx, "DELETE", u, nil, v)
 	return v, resp, err
 }
 
@@ -142,10 +183,17 @@ func (r *ReplicationsService) Delete(repo string) (*string, *Response, error) {
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-CreateorReplaceLocalMulti-pushReplication
 func (r *ReplicationsService) CreateMultiPush(repo string, replications *MultiPushReplication) (*string, *Response, error) {
-	u := fmt.Sprintf("/api/replications/multiple/%s", repo)
+	return r.CreateMultiPushWithContext(context.Background(), repo, replications)
+}
+
+// CreateMultiPushWithContext constructs a Local Multi-push replication for the provided repository using the provided context.
+func (r *ReplicationsService) CreateMultiPushWithContext(ctx context.Context, repo string, replications *MultiPushReplication) (*string, *Response, error) {
+	ctx = withOperation(ctx, "Replications.CreateMultiPush", "/api/replications/multiple/{repo}")
+
+	u := fmt.Sprintf("/api/replications/multiple/%s", escapePath(repo))
 	v := new(string)
 
-	resp, err := r.client.Call("PUT", u, replications, v)
+	resp, err := r.client.CallContext(ctx, "PUT", u, replications, v)
 	return v, resp, err
 }
 
@@ -153,20 +201,34 @@ func (r *ReplicationsService) CreateMultiPush(repo string, replications *MultiPu
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-UpdateLocalMulti-pushReplication
 func (r *ReplicationsService) UpdateMultiPush(repo string, replications *MultiPushReplication) (*string, *Response, error) {
-	u := fmt.Sprintf("/api/replications/multiple/%s", repo)
+	return r.UpdateMultiPushWithContext(context.Background(), repo, replications)
+}
+
+// UpdateMultiPushWithContext updates a Local Multi-push replication for the provided repository using the provided context.
+func (r *ReplicationsService) UpdateMultiPushWithContext(ctx context.Context, repo string, replications *MultiPushReplication) (*string, *Response, error) {
+	ctx = withOperation(ctx, "Replications.UpdateMultiPush", "/api/replications/multiple/{repo}")
+
+	u := fmt.Sprintf("/api/replications/multiple/%s", escapePath(repo))
 	v := new(string)
 
-	resp, err := r.client.Call("POST", u, replications, v)
+	resp, err := r.client.CallContext(ctx, "POST", u, replications, v)
 	return v, resp, err
 }
 
 // DeleteMultiPush deletes replication configuration at the provided URL for the provided repository.
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-DeleteRepositoryReplicationConfiguration
-func (r *ReplicationsService) DeleteMultiPush(repo string, url string) (*string, *Response, error) {
-	u := fmt.Sprintf("/api/replications/%s?url=%s", repo, url)
+func (r *ReplicationsService) DeleteMultiPush(repo string, replicationURL string) (*string, *Response, error) {
+	return r.DeleteMultiPushWithContext(context.Background(), repo, replicationURL)
+}
+
+// DeleteMultiPushWithContext deletes replication configuration at the provided URL for the provided repository using the provided context.
+func (r *ReplicationsService) DeleteMultiPushWithContext(ctx context.Context, repo string, replicationURL string) (*string, *Response, error) {
+	ctx = withOperation(ctx, "Replications.DeleteMultiPush", "/api/replications/{repo}")
+
+	u := fmt.Sprintf("/api/replications/%s?url=%s", escapePath(repo), url.QueryEscape(replicationURL))
 	v := new(string)
 
-	resp, err := r.client.Call("DELETE", u, nil, v)
+	resp, err := r.client.CallContext(ctx, "DELETE", u, nil, v)
 	return v, resp, err
 }
@@ -147,6 +147,14 @@ func Test_Replications(t *testing.T) {
 				g.Assert(err == nil).IsTrue()
 			})
 
+			g.It("- should return no replications with Get() on an empty response", func() {
+				actual, resp, err := c.Replications.Get("empty-repo1")
+				g.Assert(actual != nil).IsTrue()
+				g.Assert(len(*actual)).Equal(0)
+				g.Assert(resp != nil).IsTrue()
+				g.Assert(err == nil).IsTrue()
+			})
+
 			g.It("- should return no error with Get() on remote replication", func() {
 				actual, resp, err := c.Replications.Get("remote-repo1")
 				g.Assert(actual != nil).IsTrue()
@@ -17,6 +17,7 @@
 package artifactory
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -194,10 +195,17 @@ func (v VirtualRepository) String() string {
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-GetRepositories
 func (s *RepositoriesService) GetAll() (*[]Repository, *Response, error) {
+	return s.GetAllWithContext(context.Background())
+}
+
+// GetAllWithContext returns a list of all repositories using the provided context.
+func (s *RepositoriesService) GetAllWithContext(ctx context.Context) (*[]Repository, *Response, error) {
+	ctx = withOperation(ctx, "Repositories.GetAll", "/api/repositories")
+
 	u := "/api/repositories"
 	v := new([]Repository)
 
-	resp, err := s.client.Call("GET", u, nil, v)
+	resp, err := s.client.CallContext(ctx, "GET", u, nil, v)
 	return v, resp, err
 }
 
@@ -205,10 +213,17 @@ func (s *RepositoriesService) GetAll() (*[]Repository, *Response, error) {
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-RepositoryConfiguration
 func (s *RepositoriesService) Get(repo string) (interface{}, *Response, error) {
-	u := fmt.Sprintf("/api/repositories/%s", repo)
+	return s.GetWithContext(context.Background(), repo)
+}
+
+// GetWithContext returns the provided repository using the provided context.
+func (s *RepositoriesService) GetWithContext(ctx context.Context, repo string) (interface{}, *Response, error) {
+	ctx = withOperation(ctx, "Repositories.Get", "/api/repositories/{repo}")
+
+	u := fmt.Sprintf("/api/repositories/%s", escapePath(repo))
 	v := new(GenericRepository)
 
-	resp, err := s.client.Call("GET", u, nil, v)
+	resp, err := s.client.CallContext(ctx, "GET", u, nil, v)
 	if err != nil {
 		return v, resp, err
 	}
@@ -241,10 +256,17 @@ func (s *RepositoriesService) Get(repo string) (interface{}, *Response, error) {
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-CreateRepository
 func (s *RepositoriesService) Create(repo string, body interface{}) (*string, *Response, error) {
-	u := fmt.Sprintf("/api/repositories/%s", repo)
+	return s.CreateWithContext(context.Background(), repo, body)
+}
+
+// CreateWithContext constructs a repository with the provided details using the provided context.
+func (s *RepositoriesService) CreateWithContext(ctx context.Context, repo string, body interface{}) (*string, *Response, error) {
+	ctx = withOperation(ctx, "Repositories.Create", "/api/repositories/{repo}")
+
+	u := fmt.Sprintf("/api/repositories/%s", escapePath(repo))
 	v := new(string)
 
-	resp, err := s.client.Call("PUT", u, body, v)
+	resp, err := s.client.CallContext(ctx, "PUT", u, body, v)
 	return v, resp, err
 }
 
@@ -252,10 +274,17 @@ func (s *RepositoriesService) Create(repo string, body interface{}) (*string, *R
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-UpdateRepositoryConfiguration
 func (s *RepositoriesService) Update(repo string, body interface{}) (*string, *Response, error) {
-	u := fmt.Sprintf("/api/repositories/%s", repo)
+	return s.UpdateWithContext(context.Background(), repo, body)
+}
+
+// UpdateWithContext modifies a repository with the provided details using the provided context.
+func (s *RepositoriesService) UpdateWithContext(ctx context.Context, repo string, body interface{}) (*string, *Response, error) {
+	ctx = withOperation(ctx, "Repositories.Update", "/api/repositories/{repo}")
+
+	u := fmt.Sprintf("/api/repositories/%s", escapePath(repo))
 	v := new(string)
 
-	resp, err := s.client.Call("POST", u, body, v)
+	resp, err := s.client.CallContext(ctx, "POST", u, body, v)
 	return v, resp, err
 }
 
@@ -263,9 +292,16 @@ func (s *RepositoriesService) Update(repo string, body interface{}) (*string, *R
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-DeleteRepository
 func (s *RepositoriesService) Delete(repo string) (*string, *Response, error) {
-	u := fmt.Sprintf("/api/repositories/%s", repo)
+	return s.DeleteWithContext(context.Background(), repo)
+}
+
+// DeleteWithContext removes the provided repository using the provided context.
+func (s *RepositoriesService) DeleteWithContext(ctx context.Context, repo string) (*string, *Response, error) {
+	ctx = withOperation(ctx, "Repositories.Delete", "/api/repositories/{repo}")
+
+	u := fmt.Sprintf("/api/repositories/%s", escapePath(repo))
 	v := new(string)
 
-	resp, err := s.client.Call("DELETE", u, nil, v)
+	resp, err := s.client.CallContext(ctx, "DELETE", u, nil, v)
 	return v, resp, err
 }
@@ -16,6 +16,12 @@
 
 package artifactory
 
+import (
+	"context"
+
+	"github.com/target/go-arty/v2/internal/rest"
+)
+
 // SearchService handles communication with the search related
 // methods of the Artifactory API.
 //
@@ -44,14 +50,21 @@ func (g GAVCResponse) String() string {
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-GAVCSearch
 func (s *SearchService) GAVC(coords *GAVCRequest) (*GAVCResponse, *Response, error) {
+	return s.GAVCWithContext(context.Background(), coords)
+}
+
+// GAVCWithContext returns the list of artifacts from the Maven search using the provided context.
+func (s *SearchService) GAVCWithContext(ctx context.Context, coords *GAVCRequest) (*GAVCResponse, *Response, error) {
+	ctx = withOperation(ctx, "Search.GAVC", "/api/search/gavc")
+
 	u := "/api/search/gavc"
 	v := new(GAVCResponse)
 
-	u, err := addOptions(u, coords)
+	u, err := rest.AddOptions(u, coords)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	resp, err := s.client.Call("GET", u, nil, v)
+	resp, err := s.client.CallContext(ctx, "GET", u, nil, v)
 	return v, resp, err
 }
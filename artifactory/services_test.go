@@ -0,0 +1,79 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifactory
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/franela/goblin"
+)
+
+func Test_Services(t *testing.T) {
+	g := goblin.Goblin(t)
+	g.Describe("Service stubs", func() {
+		g.It("- should let a stub replace a service of the client", func() {
+			c, _ := NewClient("http://artifactory.example.com", nil)
+
+			c.Repositories = &RepositoriesStub{
+				GetAllFunc: func() (*[]Repository, *Response, error) {
+					return &[]Repository{{Key: String("libs-release-local")}}, nil, nil
+				},
+			}
+
+			repos, _, err := c.Repositories.GetAll()
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(*(*repos)[0].Key).Equal("libs-release-local")
+		})
+
+		g.It("- should call the context variant of a method", func() {
+			var got context.Context
+			stub := &SystemStub{
+				PingWithContextFunc: func(ctx context.Context) (*string, *Response, error) {
+					got = ctx
+					return String("OK"), nil, nil
+				},
+			}
+
+			actual, _, err := stub.Ping()
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(*actual).Equal("OK")
+			g.Assert(got != nil).IsTrue()
+		})
+
+		g.It("- should call the method of a context variant", func() {
+			stub := &SystemStub{
+				PingFunc: func() (*string, *Response, error) {
+					return nil, nil, errors.New("unavailable")
+				},
+			}
+
+			_, _, err := stub.PingWithContext(context.Background())
+
+			g.Assert(err.Error()).Equal("unavailable")
+		})
+
+		g.It("- should panic for a method that is not stubbed", func() {
+			defer func() {
+				g.Assert(recover()).Equal("SystemStub.Ping not stubbed")
+			}()
+
+			new(SystemStub).Ping()
+		})
+	})
+}
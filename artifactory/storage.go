@@ -17,8 +17,9 @@
 package artifactory
 
 import (
+	"context"
 	"fmt"
-	"strings"
+	"net/url"
 )
 
 // StorageService handles communication with the storage related
@@ -187,10 +188,17 @@ func (e EffectiveItemPermissions) String() string {
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-FolderInfo
 func (s *StorageService) GetFolder(repo, path string) (*Folder, *Response, error) {
-	u := fmt.Sprintf("/api/storage/%s/%s", repo, path)
+	return s.GetFolderWithContext(context.Background(), repo, path)
+}
+
+// GetFolderWithContext returns the provided folder using the provided context.
+func (s *StorageService) GetFolderWithContext(ctx context.Context, repo, path string) (*Folder, *Response, error) {
+	ctx = withOperation(ctx, "Storage.GetFolder", "/api/storage/{repo}/{path}")
+
+	u := fmt.Sprintf("/api/storage/%s", itemPath(repo, path))
 	v := new(Folder)
 
-	resp, err := s.client.Call("GET", u, nil, v)
+	resp, err := s.client.CallContext(ctx, "GET", u, nil, v)
 	return v, resp, err
 }
 
@@ -198,10 +206,17 @@ func (s *StorageService) GetFolder(repo, path string) (*Folder, *Response, error
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-FileInfo
 func (s *StorageService) GetFile(repo, path string) (*File, *Response, error) {
-	u := fmt.Sprintf("/api/storage/%s/%s", repo, path)
+	return s.GetFileWithContext(context.Background(), repo, path)
+}
+
+// GetFileWithContext returns the provided file using the provided context.
+func (s *StorageService) GetFileWithContext(ctx context.Context, repo, path string) (*File, *Response, error) {
+	ctx = withOperation(ctx, "Storage.GetFile", "/api/storage/{repo}/{path}")
+
+	u := fmt.Sprintf("/api/storage/%s", itemPath(repo, path))
 	v := new(File)
 
-	resp, err := s.client.Call("GET", u, nil, v)
+	resp, err := s.client.CallContext(ctx, "GET", u, nil, v)
 	return v, resp, err
 }
 
@@ -209,10 +224,17 @@ func (s *StorageService) GetFile(repo, path string) (*File, *Response, error) {
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-ItemLastModified
 func (s *StorageService) GetItemLastModified(repo, path string) (*ItemLastModified, *Response, error) {
-	u := fmt.Sprintf("/api/storage/%s/%s?lastModified", repo, path)
+	return s.GetItemLastModifiedWithContext(context.Background(), repo, path)
+}
+
+// GetItemLastModifiedWithContext returns the ISO8601 timestamp of the provided item's last modified date using the provided context.
+func (s *StorageService) GetItemLastModifiedWithContext(ctx context.Context, repo, path string) (*ItemLastModified, *Response, error) {
+	ctx = withOperation(ctx, "Storage.GetItemLastModified", "/api/storage/{repo}/{path}")
+
+	u := fmt.Sprintf("/api/storage/%s?lastModified", itemPath(repo, path))
 	v := new(ItemLastModified)
 
-	resp, err := s.client.Call("GET", u, nil, v)
+	resp, err := s.client.CallContext(ctx, "GET", u, nil, v)
 	return v, resp, err
 }
 
@@ -220,10 +242,17 @@ func (s *StorageService) GetItemLastModified(repo, path string) (*ItemLastModifi
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-FileStatistics
 func (s *StorageService) GetFileStatistics(repo, path string) (*FileStatistics, *Response, error) {
-	u := fmt.Sprintf("/api/storage/%s/%s?stats", repo, path)
+	return s.GetFileStatisticsWithContext(context.Background(), repo, path)
+}
+
+// GetFileStatisticsWithContext returns download statistics for the provided file using the provided context.
+func (s *StorageService) GetFileStatisticsWithContext(ctx context.Context, repo, path string) (*FileStatistics, *Response, error) {
+	ctx = withOperation(ctx, "Storage.GetFileStatistics", "/api/storage/{repo}/{path}")
+
+	u := fmt.Sprintf("/api/storage/%s?stats", itemPath(repo, path))
 	v := new(FileStatistics)
 
-	resp, err := s.client.Call("GET", u, nil, v)
+	resp, err := s.client.CallContext(ctx, "GET", u, nil, v)
 	return v, resp, err
 }
 
@@ -231,10 +260,17 @@ func (s *StorageService) GetFileStatistics(repo, path string) (*FileStatistics,
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-ItemProperties
 func (s *StorageService) GetItemProperties(repo, path string) (*ItemProperties, *Response, error) {
-	u := fmt.Sprintf("/api/storage/%s/%s?properties", repo, path)
+	return s.GetItemPropertiesWithContext(context.Background(), repo, path)
+}
+
+// GetItemPropertiesWithContext returns properties on the provided item using the provided context.
+func (s *StorageService) GetItemPropertiesWithContext(ctx context.Context, repo, path string) (*ItemProperties, *Response, error) {
+	ctx = withOperation(ctx, "Storage.GetItemProperties", "/api/storage/{repo}/{path}")
+
+	u := fmt.Sprintf("/api/storage/%s?properties", itemPath(repo, path))
 	v := new(ItemProperties)
 
-	resp, err := s.client.Call("GET", u, nil, v)
+	resp, err := s.client.CallContext(ctx, "GET", u, nil, v)
 	return v, resp, err
 }
 
@@ -242,24 +278,16 @@ func (s *StorageService) GetItemProperties(repo, path string) (*ItemProperties,
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-SetItemProperties
 func (s *StorageService) SetItemProperties(repo, path string, properties map[string][]string) (*Response, error) {
-	var propertyString string
-	var index int
-	for k, v := range properties {
-		index++
-		if len(v) == 1 {
-			propertyString = propertyString + fmt.Sprintf("%s=%s", k, v[0])
-		} else {
-			propertyString = propertyString + fmt.Sprintf("%s=[%s]", k, strings.Join(v, ","))
-		}
-
-		if index != len(properties) {
-			propertyString = propertyString + ";"
-		}
-	}
-
-	u := fmt.Sprintf("/api/storage/%s/%s?properties=%s&recursive=1", repo, path, propertyString)
-
-	resp, err := s.client.Call("PUT", u, nil, nil)
+	return s.SetItemPropertiesWithContext(context.Background(), repo, path, properties)
+}
+
+// SetItemPropertiesWithContext attaches the provided properties to the provided item using the provided context.
+func (s *StorageService) SetItemPropertiesWithContext(ctx context.Context, repo, path string, properties map[string][]string) (*Response, error) {
+	ctx = withOperation(ctx, "Storage.SetItemProperties", "/api/storage/{repo}/{path}")
+
+	u := fmt.Sprintf("/api/storage/%s?properties=%s&recursive=1", itemPath(repo, path), url.QueryEscape(propertiesQuery(properties)))
+
+	resp, err := s.client.CallContext(ctx, "PUT", u, nil, nil)
 	return resp, err
 }
 
@@ -267,18 +295,16 @@ func (s *StorageService) SetItemProperties(repo, path string, properties map[str
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-DeleteItemProperties
 func (s *StorageService) DeleteItemProperties(repo, path string, properties []string) (*Response, error) {
-	var propertyString string
-	for k, v := range properties {
-		propertyString = propertyString + fmt.Sprintf("%s,", v)
+	return s.DeleteItemPropertiesWithContext(context.Background(), repo, path, properties)
+}
 
-		if k != len(properties) {
-			propertyString = strings.TrimRight(propertyString, ",")
-		}
-	}
+// DeleteItemPropertiesWithContext removes the provided properties from the provided item using the provided context.
+func (s *StorageService) DeleteItemPropertiesWithContext(ctx context.Context, repo, path string, properties []string) (*Response, error) {
+	ctx = withOperation(ctx, "Storage.DeleteItemProperties", "/api/storage/{repo}/{path}")
 
-	u := fmt.Sprintf("/api/storage/%s/%s?properties=%s", repo, path, propertyString)
+	u := fmt.Sprintf("/api/storage/%s?properties=%s", itemPath(repo, path), url.QueryEscape(propertyNames(properties)))
 
-	resp, err := s.client.Call("DELETE", u, nil, nil)
+	resp, err := s.client.CallContext(ctx, "DELETE", u, nil, nil)
 	return resp, err
 }
 
@@ -286,10 +312,17 @@ func (s *StorageService) DeleteItemProperties(repo, path string, properties []st
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-FileList
 func (s *StorageService) GetFileList(repo, path string) (*FileList, *Response, error) {
-	u := fmt.Sprintf("/api/storage/%s/%s?list&deep=1", repo, path)
+	return s.GetFileListWithContext(context.Background(), repo, path)
+}
+
+// GetFileListWithContext lists all files in the provided repo using the provided context.
+func (s *StorageService) GetFileListWithContext(ctx context.Context, repo, path string) (*FileList, *Response, error) {
+	ctx = withOperation(ctx, "Storage.GetFileList", "/api/storage/{repo}/{path}")
+
+	u := fmt.Sprintf("/api/storage/%s?list&deep=1", itemPath(repo, path))
 	v := new(FileList)
 
-	resp, err := s.client.Call("GET", u, nil, v)
+	resp, err := s.client.CallContext(ctx, "GET", u, nil, v)
 	return v, resp, err
 }
 
@@ -297,10 +330,17 @@ func (s *StorageService) GetFileList(repo, path string) (*FileList, *Response, e
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-GetStorageSummaryInfo
 func (s *StorageService) GetStorageSummary() (*StorageSummary, *Response, error) {
+	return s.GetStorageSummaryWithContext(context.Background())
+}
+
+// GetStorageSummaryWithContext returns the storage summary information using the provided context.
+func (s *StorageService) GetStorageSummaryWithContext(ctx context.Context) (*StorageSummary, *Response, error) {
+	ctx = withOperation(ctx, "Storage.GetStorageSummary", "/api/storageinfo")
+
 	u := "/api/storageinfo"
 	v := new(StorageSummary)
 
-	resp, err := s.client.Call("GET", u, nil, v)
+	resp, err := s.client.CallContext(ctx, "GET", u, nil, v)
 	return v, resp, err
 }
 
@@ -308,9 +348,16 @@ func (s *StorageService) GetStorageSummary() (*StorageSummary, *Response, error)
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-EffectiveItemPermissions
 func (s *StorageService) GetEffectiveItemPermissions(repo, path string) (*EffectiveItemPermissions, *Response, error) {
-	u := fmt.Sprintf("/api/storage/%s/%s?permissions", repo, path)
+	return s.GetEffectiveItemPermissionsWithContext(context.Background(), repo, path)
+}
+
+// GetEffectiveItemPermissionsWithContext returns the effective item permissions for a file or folder using the provided context.
+func (s *StorageService) GetEffectiveItemPermissionsWithContext(ctx context.Context, repo, path string) (*EffectiveItemPermissions, *Response, error) {
+	ctx = withOperation(ctx, "Storage.GetEffectiveItemPermissions", "/api/storage/{repo}/{path}")
+
+	u := fmt.Sprintf("/api/storage/%s?permissions", itemPath(repo, path))
 	v := new(EffectiveItemPermissions)
 
-	resp, err := s.client.Call("GET", u, nil, v)
+	resp, err := s.client.CallContext(ctx, "GET", u, nil, v)
 	return v, resp, err
 }
@@ -51,11 +51,11 @@ func Test_Storage(t *testing.T) {
 					URI:          String("http://localhost:8081/artifactory/api/storage/local-repo1/folder"),
 					Repo:         String("local-repo1"),
 					Path:         String("/folder"),
-					Created:      &Timestamp{time.Date(2010, time.October, 10, 10, 10, 10, 0, time.UTC)},
+					Created:      &Timestamp{Time: time.Date(2010, time.October, 10, 10, 10, 10, 0, time.UTC)},
 					CreatedBy:    String("admin"),
-					LastModified: &Timestamp{time.Date(2011, time.November, 11, 11, 11, 11, 0, time.UTC)},
+					LastModified: &Timestamp{Time: time.Date(2011, time.November, 11, 11, 11, 11, 0, time.UTC)},
 					ModifiedBy:   String("admin"),
-					LastUpdated:  &Timestamp{time.Date(2012, time.December, 12, 12, 12, 12, 0, time.UTC)},
+					LastUpdated:  &Timestamp{Time: time.Date(2012, time.December, 12, 12, 12, 12, 0, time.UTC)},
 					Children: &[]Child{
 						Child{URI: String("/file.json"), Folder: String("true")},
 						Child{URI: String("/foo.txt"), Folder: String("false")},
@@ -76,11 +76,11 @@ func Test_Storage(t *testing.T) {
 					DownloadURI:  String("http://localhost:8081/artifactory/local-repo1/folder/file.json"),
 					Repo:         String("local-repo1"),
 					Path:         String("/folder/file.json"),
-					Created:      &Timestamp{time.Date(2010, time.October, 10, 10, 10, 10, 0, time.UTC)},
+					Created:      &Timestamp{Time: time.Date(2010, time.October, 10, 10, 10, 10, 0, time.UTC)},
 					CreatedBy:    String("admin"),
-					LastModified: &Timestamp{time.Date(2011, time.November, 11, 11, 11, 11, 0, time.UTC)},
+					LastModified: &Timestamp{Time: time.Date(2011, time.November, 11, 11, 11, 11, 0, time.UTC)},
 					ModifiedBy:   String("admin"),
-					LastUpdated:  &Timestamp{time.Date(2012, time.December, 12, 12, 12, 12, 0, time.UTC)},
+					LastUpdated:  &Timestamp{Time: time.Date(2012, time.December, 12, 12, 12, 12, 0, time.UTC)},
 					Size:         String("1024"),
 					MimeType:     String("application/json"),
 					Checksums: &Checksums{
@@ -179,7 +179,7 @@ func Test_Storage(t *testing.T) {
 			g.It("- should return valid string for ItemLastModified with String()", func() {
 				actual := &ItemLastModified{
 					URI:          String("http://localhost:8081/artifactory/api/storage/local-repo1/folder/file.json"),
-					LastModified: &Timestamp{time.Date(2011, time.November, 11, 11, 11, 11, 0, time.UTC)},
+					LastModified: &Timestamp{Time: time.Date(2011, time.November, 11, 11, 11, 11, 0, time.UTC)},
 				}
 
 				data, _ := ioutil.ReadFile("fixtures/storage/last_modified.json")
@@ -192,7 +192,7 @@ func Test_Storage(t *testing.T) {
 			g.It("- should return valid string for FileStatistics with String()", func() {
 				actual := &FileStatistics{
 					URI:              String("http://localhost:8081/artifactory/api/storage/local-repo1/folder/file.json"),
-					LastDownloaded:   &Timestamp{time.Date(2012, time.December, 12, 12, 12, 12, 0, time.UTC)},
+					LastDownloaded:   &Timestamp{Time: time.Date(2012, time.December, 12, 12, 12, 12, 0, time.UTC)},
 					DownloadCount:    Int(3),
 					LastDownloadedBy: String("admin"),
 				}
@@ -222,19 +222,19 @@ func Test_Storage(t *testing.T) {
 			g.It("- should return valid string for FileList with String()", func() {
 				actual := &FileList{
 					URI:     String("http://localhost:8081/artifactory/api/storage/local-repo1/folder"),
-					Created: &Timestamp{time.Date(2010, time.October, 10, 10, 10, 10, 0, time.UTC)},
+					Created: &Timestamp{Time: time.Date(2010, time.October, 10, 10, 10, 10, 0, time.UTC)},
 					Files: &[]FileListItem{
 						FileListItem{
 							URI:          String("/file.json"),
 							Size:         Int(253207),
-							LastModified: &Timestamp{time.Date(2011, time.November, 11, 11, 11, 11, 0, time.UTC)},
+							LastModified: &Timestamp{Time: time.Date(2011, time.November, 11, 11, 11, 11, 0, time.UTC)},
 							Folder:       Bool(false),
 							SHA1:         String("ECB252044B5EA0F679EE78EC1A12904739E2904D"),
 						},
 						FileListItem{
 							URI:          String("/foo.txt"),
 							Size:         Int(253100),
-							LastModified: &Timestamp{time.Date(2012, time.December, 12, 12, 12, 12, 0, time.UTC)},
+							LastModified: &Timestamp{Time: time.Date(2012, time.December, 12, 12, 12, 12, 0, time.UTC)},
 							Folder:       Bool(false),
 							SHA1:         String("B680C4A75B05C5AAB4C365D68D9FACF42482BC64"),
 						},
@@ -79,7 +79,7 @@ func TestStringify(t *testing.T) {
 
 		// actual Artifactory structs
 		{
-			Timestamp{time.Date(2006, time.January, 02, 15, 04, 05, 0, time.UTC)},
+			Timestamp{Time: time.Date(2006, time.January, 02, 15, 04, 05, 0, time.UTC)},
 			`artifactory.Timestamp{2006-01-02 15:04:05 +0000 UTC}`,
 		},
 		{
@@ -131,7 +131,7 @@ func TestString(t *testing.T) {
 		{ItemProperties{URI: String("test")}, `artifactory.ItemProperties{URI:"test"}`},
 		{FileList{URI: String("test")}, `artifactory.FileList{URI:"test"}`},
 		{Versions{Version: String("test")}, `artifactory.Versions{Version:"test"}`},
-		{Timestamp{time.Date(2006, time.January, 02, 15, 04, 05, 0, time.UTC)}, `2006-01-02 15:04:05 +0000 UTC`},
+		{Timestamp{Time: time.Date(2006, time.January, 02, 15, 04, 05, 0, time.UTC)}, `2006-01-02 15:04:05 +0000 UTC`},
 		{SecurityUser{Name: String("test")}, `artifactory.SecurityUser{Name:"test"}`},
 		{APIKey{APIKey: String("test")}, `artifactory.APIKey{APIKey:"test"}`},
 		{DeleteAPIKey{Info: String("test")}, `artifactory.DeleteAPIKey{Info:"test"}`},
@@ -0,0 +1,381 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifactory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// SyncDirection represents the side a sync copies the files from.
+type SyncDirection int
+
+const (
+	// SyncUpload makes the repository path mirror the local directory.
+	SyncUpload SyncDirection = iota
+	// SyncDownload makes the local directory mirror the repository path.
+	SyncDownload
+)
+
+func (d SyncDirection) String() string {
+	if d == SyncDownload {
+		return "download"
+	}
+
+	return "upload"
+}
+
+// SyncOptions represents the options for syncing a local directory and a repository path.
+type SyncOptions struct {
+	// Direction is the side the files are copied from.
+	// Defaults to SyncUpload.
+	Direction SyncDirection
+
+	// Include lists the glob patterns of the files to sync, all files if
+	// empty. Exclude lists the glob patterns of the files left out, even
+	// if included. The patterns are matched as for UploadDirOptions.
+	// Files left out are neither copied nor deleted.
+	Include []string
+	Exclude []string
+
+	// NoDelete keeps the files missing from the side the files are copied
+	// from, instead of deleting them. For uploads, it never deletes any
+	// artifact from the repository.
+	NoDelete bool
+
+	// DryRun only computes the plan of Sync, without applying it.
+	DryRun bool
+
+	// Properties are attached to the deployed artifacts.
+	Properties map[string][]string
+
+	// Workers is the number of files hashed, copied or deleted concurrently.
+	// Defaults to 4.
+	Workers int
+}
+
+// SyncEntry represents a file added, changed or deleted by a sync.
+type SyncEntry struct {
+	// Path is the slash separated path of the file, relative to the
+	// local directory and the repository path.
+	Path string
+
+	// LocalSHA1 and RemoteSHA1 are the SHA-1 checksums of the local file
+	// and of the artifact. Each is empty if the file is missing from its side.
+	LocalSHA1  string
+	RemoteSHA1 string
+
+	// Size is the size in bytes of the file copied, or deleted.
+	Size int64
+
+	// Err is the error applying the entry failed with, if any.
+	Err error
+}
+
+// SyncPlan represents the changes syncing a local directory and a repository path.
+// Applying it again once applied makes no changes.
+type SyncPlan struct {
+	Direction SyncDirection
+	LocalDir  string
+	Repo      string
+	Prefix    string
+
+	// Added, Changed and Deleted list the files to add to the side the
+	// files are copied to, to replace with different content, and to
+	// delete from it, in the order of their paths.
+	Added   []SyncEntry
+	Changed []SyncEntry
+	Deleted []SyncEntry
+
+	// Unchanged counts the files with the same checksums on both sides.
+	Unchanged int
+}
+
+// Empty reports whether the plan makes no changes.
+func (p *SyncPlan) Empty() bool {
+	return len(p.Added) == 0 && len(p.Changed) == 0 && len(p.Deleted) == 0
+}
+
+// String returns the plan in a readable form, one line per change, like
+// "+ path", "~ path" or "- path", followed by a summary line.
+func (p *SyncPlan) String() string {
+	var b strings.Builder
+
+	target := fmt.Sprintf("%s/%s", p.Repo, p.Prefix)
+	if p.Direction == SyncDownload {
+		target = p.LocalDir
+	}
+
+	fmt.Fprintf(&b, "sync %s to %s\n", p.Direction, target)
+
+	for _, e := range p.Added {
+		fmt.Fprintf(&b, "+ %s (%s)\n", e.Path, p.source(e))
+	}
+	for _, e := range p.Changed {
+		fmt.Fprintf(&b, "~ %s (%s -> %s)\n", e.Path, p.target(e), p.source(e))
+	}
+	for _, e := range p.Deleted {
+		fmt.Fprintf(&b, "- %s (%s)\n", e.Path, p.target(e))
+	}
+
+	fmt.Fprintf(&b, "%d to add, %d to change, %d to delete, %d unchanged",
+		len(p.Added), len(p.Changed), len(p.Deleted), p.Unchanged)
+
+	return b.String()
+}
+
+// Failed returns the entries that failed to apply.
+func (p *SyncPlan) Failed() []SyncEntry {
+	var failed []SyncEntry
+	for _, entries := range [][]SyncEntry{p.Added, p.Changed, p.Deleted} {
+		for _, e := range entries {
+			if e.Err != nil {
+				failed = append(failed, e)
+			}
+		}
+	}
+
+	return failed
+}
+
+// source returns the checksum of the entry on the side the files are copied from.
+func (p *SyncPlan) source(e SyncEntry) string {
+	if p.Direction == SyncDownload {
+		return e.RemoteSHA1
+	}
+
+	return e.LocalSHA1
+}
+
+// target returns the checksum of the entry on the side the files are copied to.
+func (p *SyncPlan) target(e SyncEntry) string {
+	if p.Direction == SyncDownload {
+		return e.LocalSHA1
+	}
+
+	return e.RemoteSHA1
+}
+
+// Sync makes the provided path of the repository mirror the localDir
+// directory, or the other way around for a SyncDownload, comparing the
+// files by SHA-1 checksum. It returns the plan of the changes, with the
+// errors of the entries that failed to apply, which are joined in the
+// returned error. With DryRun set, the plan is only computed.
+//
+// Sync is the same as PlanSync followed by ApplySync.
+func (s *ArtifactsService) Sync(localDir, repo, prefix string, opts *SyncOptions) (*SyncPlan, error) {
+	return s.SyncWithContext(context.Background(), localDir, repo, prefix, opts)
+}
+
+// SyncWithContext makes the provided path of the repository mirror the localDir directory using the provided context.
+func (s *ArtifactsService) SyncWithContext(ctx context.Context, localDir, repo, prefix string, opts *SyncOptions) (*SyncPlan, error) {
+	ctx = withOperation(ctx, "Artifacts.Sync", "/{repo}/{path}")
+
+	plan, err := s.planSync(ctx, localDir, repo, prefix, opts)
+	if err != nil || (opts != nil && opts.DryRun) {
+		return plan, err
+	}
+
+	return plan, s.applySync(ctx, plan, opts)
+}
+
+// PlanSync computes the changes syncing the localDir directory and the provided
+// path of the repository, without applying them. The artifacts are listed with
+// StorageService.GetFileList, and the local files are hashed concurrently.
+// A missing repository path is synced as an empty one.
+func (s *ArtifactsService) PlanSync(localDir, repo, prefix string, opts *SyncOptions) (*SyncPlan, error) {
+	return s.PlanSyncWithContext(context.Background(), localDir, repo, prefix, opts)
+}
+
+// PlanSyncWithContext computes the changes syncing the localDir directory and the provided path of the repository using the provided context.
+func (s *ArtifactsService) PlanSyncWithContext(ctx context.Context, localDir, repo, prefix string, opts *SyncOptions) (*SyncPlan, error) {
+	ctx = withOperation(ctx, "Artifacts.PlanSync", "/{repo}/{path}")
+
+	return s.planSync(ctx, localDir, repo, prefix, opts)
+}
+
+// ApplySync applies the provided plan, as computed by PlanSync. Entries failing
+// to apply don't stop the others; their errors are set in the plan and joined
+// in the returned error. Deleted entries are left out if NoDelete is set.
+func (s *ArtifactsService) ApplySync(plan *SyncPlan, opts *SyncOptions) error {
+	return s.ApplySyncWithContext(context.Background(), plan, opts)
+}
+
+// ApplySyncWithContext applies the provided plan using the provided context.
+func (s *ArtifactsService) ApplySyncWithContext(ctx context.Context, plan *SyncPlan, opts *SyncOptions) error {
+	ctx = withOperation(ctx, "Artifacts.ApplySync", "/{repo}/{path}")
+
+	return s.applySync(ctx, plan, opts)
+}
+
+// planSync computes the changes syncing the localDir directory and the provided path of the repository.
+func (s *ArtifactsService) planSync(ctx context.Context, localDir, repo, prefix string, opts *SyncOptions) (*SyncPlan, error) {
+	if opts == nil {
+		opts = new(SyncOptions)
+	}
+
+	plan := &SyncPlan{
+		Direction: opts.Direction,
+		LocalDir:  localDir,
+		Repo:      repo,
+		Prefix:    strings.Trim(prefix, "/"),
+	}
+
+	local, err := listLocal(localDir, opts.Include, opts.Exclude)
+	if err != nil && !(errors.Is(err, os.ErrNotExist) && opts.Direction == SyncDownload) {
+		return nil, err
+	}
+
+	remote, remoteSums, err := s.listRemote(ctx, repo, plan.Prefix, opts.Include, opts.Exclude)
+	if err != nil && !(IsNotFound(err) && opts.Direction == SyncUpload) {
+		return nil, err
+	}
+
+	localSums, err := hashFiles(ctx, localDir, local, opts.Workers)
+	if err != nil {
+		return nil, err
+	}
+
+	source, target := local, remote
+	sourceSums, targetSums := localSums, remoteSums
+	if opts.Direction == SyncDownload {
+		source, target = remote, local
+		sourceSums, targetSums = remoteSums, localSums
+	}
+
+	// entry returns the entry of the file with the checksums of both sides.
+	entry := func(f DirFile) SyncEntry {
+		return SyncEntry{Path: f.Path, LocalSHA1: localSums[f.Path], RemoteSHA1: remoteSums[f.Path], Size: f.Size}
+	}
+
+	for _, f := range source {
+		sum, ok := targetSums[f.Path]
+
+		switch {
+		case !ok:
+			plan.Added = append(plan.Added, entry(f))
+		case !strings.EqualFold(sum, sourceSums[f.Path]):
+			plan.Changed = append(plan.Changed, entry(f))
+		default:
+			plan.Unchanged++
+		}
+	}
+
+	for _, f := range target {
+		if _, ok := sourceSums[f.Path]; !ok && !opts.NoDelete {
+			plan.Deleted = append(plan.Deleted, entry(f))
+		}
+	}
+
+	for _, entries := range [][]SyncEntry{plan.Added, plan.Changed, plan.Deleted} {
+		sort.Slice(entries, func(i, j int) bool { return entries[i].Path < entries[j].Path })
+	}
+
+	return plan, nil
+}
+
+// applySync applies the provided plan.
+func (s *ArtifactsService) applySync(ctx context.Context, plan *SyncPlan, opts *SyncOptions) error {
+	if opts == nil {
+		opts = new(SyncOptions)
+	}
+
+	var entries []*SyncEntry
+	for _, list := range [][]SyncEntry{plan.Added, plan.Changed} {
+		for i := range list {
+			entries = append(entries, &list[i])
+		}
+	}
+
+	// The entries from firstDeleted on are deleted, the others copied.
+	firstDeleted := len(entries)
+	if !opts.NoDelete {
+		for i := range plan.Deleted {
+			entries = append(entries, &plan.Deleted[i])
+		}
+	}
+
+	errs := forEach(ctx, len(entries), opts.Workers, func(i int) error {
+		e := entries[i]
+
+		// Files can't be written or deleted outside of LocalDir, whatever their path.
+		if !filepath.IsLocal(filepath.FromSlash(e.Path)) {
+			return fmt.Errorf("invalid path %q under %s/%s", e.Path, plan.Repo, plan.Prefix)
+		}
+
+		local := filepath.Join(plan.LocalDir, filepath.FromSlash(e.Path))
+		remote := path.Join(plan.Prefix, e.Path)
+
+		switch {
+		case plan.Direction == SyncDownload && i >= firstDeleted:
+			return os.Remove(local)
+		case plan.Direction == SyncDownload:
+			return s.downloadFile(ctx, plan.Repo, remote, local)
+		case i >= firstDeleted:
+			_, _, err := s.DeleteWithContext(ctx, plan.Repo, remote)
+			return err
+		}
+
+		data, err := os.Open(local)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = data.Close() }()
+
+		uploadCtx := withOperation(ctx, "Artifacts.UploadReader", "/{repo}/{path}")
+
+		_, _, err = s.uploadReader(uploadCtx, plan.Repo, remote, data, -1, &UploadOptions{Properties: opts.Properties})
+		return err
+	})
+
+	var failed []error
+	for i, err := range errs {
+		entries[i].Err = err
+		if err != nil {
+			failed = append(failed, fmt.Errorf("%s: %w", entries[i].Path, err))
+		}
+	}
+
+	return errors.Join(failed...)
+}
+
+// hashFiles returns the SHA-1 checksums of the provided files of the localDir
+// directory, by path, hashing them concurrently.
+func hashFiles(ctx context.Context, localDir string, files []DirFile, workers int) (map[string]string, error) {
+	var mu sync.Mutex
+
+	sums := make(map[string]string, len(files))
+	errs := forEach(ctx, len(files), workers, func(i int) error {
+		sum, err := fileSHA1(filepath.Join(localDir, filepath.FromSlash(files[i].Path)))
+		if err != nil {
+			return err
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		sums[files[i].Path] = sum
+		return nil
+	})
+
+	return sums, errors.Join(errs...)
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifactory
+
+import (
+	"errors"
+	"testing"
+)
+
+func Test_SyncPlan(t *testing.T) {
+	plan := &SyncPlan{
+		LocalDir:  "site",
+		Repo:      "sites-local",
+		Prefix:    "www",
+		Added:     []SyncEntry{{Path: "new.html", LocalSHA1: "a1"}},
+		Changed:   []SyncEntry{{Path: "index.html", LocalSHA1: "b2", RemoteSHA1: "b1"}},
+		Deleted:   []SyncEntry{{Path: "old.html", RemoteSHA1: "c1", Err: errors.New("failure")}},
+		Unchanged: 3,
+	}
+
+	want := "sync upload to sites-local/www\n" +
+		"+ new.html (a1)\n" +
+		"~ index.html (b1 -> b2)\n" +
+		"- old.html (c1)\n" +
+		"1 to add, 1 to change, 1 to delete, 3 unchanged"
+
+	if got := plan.String(); got != want {
+		t.Errorf("String returned %q, want %q", got, want)
+	}
+
+	if plan.Empty() {
+		t.Errorf("Empty returned true, want false")
+	}
+
+	if failed := plan.Failed(); len(failed) != 1 || failed[0].Path != "old.html" {
+		t.Errorf("Failed returned %v, want old.html", failed)
+	}
+
+	plan = &SyncPlan{Direction: SyncDownload, LocalDir: "site", Changed: plan.Changed}
+
+	want = "sync download to site\n" +
+		"~ index.html (b2 -> b1)\n" +
+		"0 to add, 1 to change, 0 to delete, 0 unchanged"
+
+	if got := plan.String(); got != want {
+		t.Errorf("String returned %q, want %q", got, want)
+	}
+
+	if !(&SyncPlan{}).Empty() {
+		t.Errorf("Empty returned false, want true")
+	}
+}
@@ -18,9 +18,9 @@ package artifactory
 
 import (
 	"bytes"
+	"context"
 	"encoding/xml"
 	"gopkg.in/yaml.v2"
-	"net/http"
 )
 
 // SystemService handles communication with the system related
@@ -1138,10 +1138,17 @@ func (d DownloadRedirectConfig) MarshalYAML() (interface{}, error) {
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-SystemHealthPing
 func (s *SystemService) Ping() (*string, *Response, error) {
+	return s.PingWithContext(context.Background())
+}
+
+// PingWithContext returns a simple status response using the provided context.
+func (s *SystemService) PingWithContext(ctx context.Context) (*string, *Response, error) {
+	ctx = withOperation(ctx, "System.Ping", "/api/system/ping")
+
 	u := "/api/system/ping"
 	v := new(string)
 
-	resp, err := s.client.Call("GET", u, nil, v)
+	resp, err := s.client.CallContext(ctx, "GET", u, nil, v)
 	return v, resp, err
 }
 
@@ -1149,10 +1156,17 @@ func (s *SystemService) Ping() (*string, *Response, error) {
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-SystemInfo
 func (s *SystemService) Get() (*string, *Response, error) {
+	return s.GetWithContext(context.Background())
+}
+
+// GetWithContext returns the general system information using the provided context.
+func (s *SystemService) GetWithContext(ctx context.Context) (*string, *Response, error) {
+	ctx = withOperation(ctx, "System.Get", "/api/system")
+
 	u := "/api/system"
 	v := new(string)
 
-	resp, err := s.client.Call("GET", u, nil, v)
+	resp, err := s.client.CallContext(ctx, "GET", u, nil, v)
 	return v, resp, err
 }
 
@@ -1160,10 +1174,17 @@ func (s *SystemService) Get() (*string, *Response, error) {
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-VersionandAdd-onsinformation
 func (s *SystemService) GetVersionAndAddOns() (*Versions, *Response, error) {
+	return s.GetVersionAndAddOnsWithContext(context.Background())
+}
+
+// GetVersionAndAddOnsWithContext returns information about the current version, revision, and installed add-ons using the provided context.
+func (s *SystemService) GetVersionAndAddOnsWithContext(ctx context.Context) (*Versions, *Response, error) {
+	ctx = withOperation(ctx, "System.GetVersionAndAddOns", "/api/system/version")
+
 	u := "/api/system/version"
 	v := new(Versions)
 
-	resp, err := s.client.Call("GET", u, nil, v)
+	resp, err := s.client.CallContext(ctx, "GET", u, nil, v)
 	return v, resp, err
 }
 
@@ -1171,10 +1192,17 @@ func (s *SystemService) GetVersionAndAddOns() (*Versions, *Response, error) {
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-GeneralConfiguration
 func (s *SystemService) GetConfiguration() (*GlobalConfig, *Response, error) {
+	return s.GetConfigurationWithContext(context.Background())
+}
+
+// GetConfigurationWithContext returns the Global Artifactory Configuration Descriptor (artifactory.config.xml) using the provided context.
+func (s *SystemService) GetConfigurationWithContext(ctx context.Context) (*GlobalConfig, *Response, error) {
+	ctx = withOperation(ctx, "System.GetConfiguration", "/api/system/configuration")
+
 	u := "/api/system/configuration"
 	v := new(bytes.Buffer)
 
-	resp, err := s.client.Call("GET", u, nil, v)
+	resp, err := s.client.CallContext(ctx, "GET", u, nil, v)
 
 	config := new(GlobalConfig)
 	err = xml.Unmarshal(v.Bytes(), config)
@@ -1190,31 +1218,30 @@ func (s *SystemService) GetConfiguration() (*GlobalConfig, *Response, error) {
 //
 //	https://www.jfrog.com/confluence/display/RTF/YAML+Configuration+File#YAMLConfigurationFile-Advanced
 func (s *SystemService) UpdateConfiguration(config GlobalConfig) (*string, *Response, error) {
-	u, err := s.client.buildURLForRequest("/api/system/configuration")
-	if err != nil {
-		return nil, nil, err
-	}
+	return s.UpdateConfigurationWithContext(context.Background(), config)
+}
+
+// UpdateConfigurationWithContext applies the provided Global system configuration to Artifactory using the provided context.
+func (s *SystemService) UpdateConfigurationWithContext(ctx context.Context, config GlobalConfig) (*string, *Response, error) {
+	ctx = withOperation(ctx, "System.UpdateConfiguration", "/api/system/configuration")
+
+	u := "/api/system/configuration"
 
 	buf := new(bytes.Buffer)
-	err = yaml.NewEncoder(buf).Encode(config)
+	err := yaml.NewEncoder(buf).Encode(config)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", u, buf)
+	req, err := s.client.NewRequestWithContext(ctx, "PATCH", u, buf)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	// Apply authentication
-	if s.client.Authentication.HasAuth() {
-		s.client.addAuthentication(req)
-	}
-
 	// Set Content-Type header for YAML
-	req.Header.Add("Content-Type", "application/yaml")
+	req.Header.Set("Content-Type", "application/yaml")
 
 	v := new(bytes.Buffer)
-	resp, err := s.client.Do(req, v)
+	resp, err := s.client.DoContext(ctx, req, v)
 	return String(v.String()), resp, err
 }
@@ -30,40 +30,10 @@
 
 package artifactory
 
-import (
-	"strconv"
-	"time"
-)
+import "github.com/target/go-arty/v2/internal/rest"
 
 // Timestamp represents a time that can be unmarshalled from a JSON string
 // formatted as either an RFC3339 or ISO 8601 or Unix timestamp. This is necessary for some
 // fields since the Artifactory API is inconsistent in how it represents times. All
 // exported methods of time.Time can be called on Timestamp.
-type Timestamp struct {
-	time.Time
-}
-
-func (t Timestamp) String() string {
-	return t.Time.String()
-}
-
-// UnmarshalJSON implements the json.Unmarshaler interface.
-// Time is expected in RFC3339 or ISO 8601 or Unix format.
-func (t *Timestamp) UnmarshalJSON(data []byte) (err error) {
-	str := string(data)
-	i, err := strconv.ParseInt(str, 10, 64)
-	if err == nil {
-		t.Time = time.Unix(i, 0)
-	} else {
-		t.Time, err = time.Parse(`"`+time.RFC3339+`"`, str)
-		if err != nil {
-			t.Time, err = time.Parse(`"`+"2006-01-02 15:04:05"+`"`, str)
-		}
-	}
-	return
-}
-
-// Equal reports whether t and u are equal based on time.Equal
-func (t Timestamp) Equal(u Timestamp) bool {
-	return t.Time.Equal(u.Time)
-}
+type Timestamp = rest.Timestamp
@@ -17,6 +17,7 @@
 package artifactory
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -100,10 +101,17 @@ func (d DeleteAPIKey) String() string {
 //
 // Docs: This endpoint is currently undocumented by JFrog
 func (s *UsersService) GetAll() (*[]User, *Response, error) {
+	return s.GetAllWithContext(context.Background())
+}
+
+// GetAllWithContext returns a list of all users using the provided context.
+func (s *UsersService) GetAllWithContext(ctx context.Context) (*[]User, *Response, error) {
+	ctx = withOperation(ctx, "Users.GetAll", "/api/users")
+
 	u := fmt.Sprintf("/api/users")
 	v := new([]User)
 
-	resp, err := s.client.Call("GET", u, nil, v)
+	resp, err := s.client.CallContext(ctx, "GET", u, nil, v)
 	return v, resp, err
 }
 
@@ -111,10 +119,17 @@ func (s *UsersService) GetAll() (*[]User, *Response, error) {
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-GetUsers
 func (s *UsersService) GetAllSecurity() (*[]SecurityUser, *Response, error) {
+	return s.GetAllSecurityWithContext(context.Background())
+}
+
+// GetAllSecurityWithContext returns a list of all users using the provided context.
+func (s *UsersService) GetAllSecurityWithContext(ctx context.Context) (*[]SecurityUser, *Response, error) {
+	ctx = withOperation(ctx, "Users.GetAllSecurity", "/api/security/users")
+
 	u := fmt.Sprintf("/api/security/users")
 	v := new([]SecurityUser)
 
-	resp, err := s.client.Call("GET", u, nil, v)
+	resp, err := s.client.CallContext(ctx, "GET", u, nil, v)
 	return v, resp, err
 }
 
@@ -122,10 +137,17 @@ func (s *UsersService) GetAllSecurity() (*[]SecurityUser, *Response, error) {
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-GetUserDetails
 func (s *UsersService) GetSecurity(user string) (*SecurityUser, *Response, error) {
-	u := fmt.Sprintf("/api/security/users/%s", user)
+	return s.GetSecurityWithContext(context.Background(), user)
+}
+
+// GetSecurityWithContext returns the provided user using the provided context.
+func (s *UsersService) GetSecurityWithContext(ctx context.Context, user string) (*SecurityUser, *Response, error) {
+	ctx = withOperation(ctx, "Users.GetSecurity", "/api/security/users/{user}")
+
+	u := fmt.Sprintf("/api/security/users/%s", escapePath(user))
 	v := new(SecurityUser)
 
-	resp, err := s.client.Call("GET", u, nil, v)
+	resp, err := s.client.CallContext(ctx, "GET", u, nil, v)
 	return v, resp, err
 }
 
@@ -133,10 +155,17 @@ func (s *UsersService) GetSecurity(user string) (*SecurityUser, *Response, error
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-CreateorReplaceUser
 func (s *UsersService) CreateSecurity(user *SecurityUser) (*string, *Response, error) {
-	u := fmt.Sprintf("/api/security/users/%s", *user.Name)
+	return s.CreateSecurityWithContext(context.Background(), user)
+}
+
+// CreateSecurityWithContext constructs a user with the provided details using the provided context.
+func (s *UsersService) CreateSecurityWithContext(ctx context.Context, user *SecurityUser) (*string, *Response, error) {
+	ctx = withOperation(ctx, "Users.CreateSecurity", "/api/security/users/{user}")
+
+	u := fmt.Sprintf("/api/security/users/%s", escapePath(*user.Name))
 	v := new(string)
 
-	resp, err := s.client.Call("PUT", u, user, v)
+	resp, err := s.client.CallContext(ctx, "PUT", u, user, v)
 	return v, resp, err
 }
 
@@ -144,10 +173,17 @@ func (s *UsersService) CreateSecurity(user *SecurityUser) (*string, *Response, e
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-UpdateUser
 func (s *UsersService) UpdateSecurity(user *SecurityUser) (*string, *Response, error) {
-	u := fmt.Sprintf("/api/security/users/%s", *user.Name)
+	return s.UpdateSecurityWithContext(context.Background(), user)
+}
+
+// UpdateSecurityWithContext modifies a user with the provided details using the provided context.
+func (s *UsersService) UpdateSecurityWithContext(ctx context.Context, user *SecurityUser) (*string, *Response, error) {
+	ctx = withOperation(ctx, "Users.UpdateSecurity", "/api/security/users/{user}")
+
+	u := fmt.Sprintf("/api/security/users/%s", escapePath(*user.Name))
 	v := new(string)
 
-	resp, err := s.client.Call("POST", u, user, v)
+	resp, err := s.client.CallContext(ctx, "POST", u, user, v)
 	return v, resp, err
 }
 
@@ -155,10 +191,17 @@ func (s *UsersService) UpdateSecurity(user *SecurityUser) (*string, *Response, e
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-DeleteUser
 func (s *UsersService) DeleteSecurity(user string) (*string, *Response, error) {
-	u := fmt.Sprintf("/api/security/users/%s", user)
+	return s.DeleteSecurityWithContext(context.Background(), user)
+}
+
+// DeleteSecurityWithContext removes the provided user using the provided context.
+func (s *UsersService) DeleteSecurityWithContext(ctx context.Context, user string) (*string, *Response, error) {
+	ctx = withOperation(ctx, "Users.DeleteSecurity", "/api/security/users/{user}")
+
+	u := fmt.Sprintf("/api/security/users/%s", escapePath(user))
 	v := new(string)
 
-	resp, err := s.client.Call("DELETE", u, nil, v)
+	resp, err := s.client.CallContext(ctx, "DELETE", u, nil, v)
 	return v, resp, err
 }
 
@@ -166,10 +209,17 @@ func (s *UsersService) DeleteSecurity(user string) (*string, *Response, error) {
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-GetAPIKey
 func (s *UsersService) GetAPIKey() (*APIKey, *Response, error) {
+	return s.GetAPIKeyWithContext(context.Background())
+}
+
+// GetAPIKeyWithContext returns the api key of the authenticated user using the provided context.
+func (s *UsersService) GetAPIKeyWithContext(ctx context.Context) (*APIKey, *Response, error) {
+	ctx = withOperation(ctx, "Users.GetAPIKey", "/api/security/apiKey")
+
 	u := "/api/security/apiKey"
 	v := new(APIKey)
 
-	resp, err := s.client.Call("GET", u, nil, v)
+	resp, err := s.client.CallContext(ctx, "GET", u, nil, v)
 	return v, resp, err
 }
 
@@ -177,10 +227,17 @@ func (s *UsersService) GetAPIKey() (*APIKey, *Response, error) {
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-CreateAPIKey
 func (s *UsersService) CreateAPIKey() (*APIKey, *Response, error) {
+	return s.CreateAPIKeyWithContext(context.Background())
+}
+
+// CreateAPIKeyWithContext constructs an api key for the authenticated user using the provided context.
+func (s *UsersService) CreateAPIKeyWithContext(ctx context.Context) (*APIKey, *Response, error) {
+	ctx = withOperation(ctx, "Users.CreateAPIKey", "/api/security/apiKey")
+
 	u := "/api/security/apiKey"
 	v := new(APIKey)
 
-	resp, err := s.client.Call("POST", u, nil, v)
+	resp, err := s.client.CallContext(ctx, "POST", u, nil, v)
 	return v, resp, err
 }
 
@@ -188,10 +245,17 @@ func (s *UsersService) CreateAPIKey() (*APIKey, *Response, error) {
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-RegenerateAPIKey
 func (s *UsersService) RegenerateAPIKey() (*APIKey, *Response, error) {
+	return s.RegenerateAPIKeyWithContext(context.Background())
+}
+
+// RegenerateAPIKeyWithContext recreates an api key for the authenticated user using the provided context.
+func (s *UsersService) RegenerateAPIKeyWithContext(ctx context.Context) (*APIKey, *Response, error) {
+	ctx = withOperation(ctx, "Users.RegenerateAPIKey", "/api/security/apiKey")
+
 	u := "/api/security/apiKey"
 	v := new(APIKey)
 
-	resp, err := s.client.Call("PUT", u, nil, v)
+	resp, err := s.client.CallContext(ctx, "PUT", u, nil, v)
 	return v, resp, err
 }
 
@@ -199,10 +263,17 @@ func (s *UsersService) RegenerateAPIKey() (*APIKey, *Response, error) {
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-RevokeAPIKey
 func (s *UsersService) DeleteAPIKey() (*DeleteAPIKey, *Response, error) {
+	return s.DeleteAPIKeyWithContext(context.Background())
+}
+
+// DeleteAPIKeyWithContext removes an api key for the authenticated user using the provided context.
+func (s *UsersService) DeleteAPIKeyWithContext(ctx context.Context) (*DeleteAPIKey, *Response, error) {
+	ctx = withOperation(ctx, "Users.DeleteAPIKey", "/api/security/apiKey")
+
 	u := "/api/security/apiKey"
 	v := new(DeleteAPIKey)
 
-	resp, err := s.client.Call("DELETE", u, nil, v)
+	resp, err := s.client.CallContext(ctx, "DELETE", u, nil, v)
 	return v, resp, err
 }
 
@@ -210,10 +281,17 @@ func (s *UsersService) DeleteAPIKey() (*DeleteAPIKey, *Response, error) {
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-RevokeUserAPIKey
 func (s *UsersService) DeleteUserAPIKey(user string) (*DeleteAPIKey, *Response, error) {
-	u := fmt.Sprintf("/api/security/apiKey/%s", user)
+	return s.DeleteUserAPIKeyWithContext(context.Background(), user)
+}
+
+// DeleteUserAPIKeyWithContext removes an api key for the provided user using the provided context.
+func (s *UsersService) DeleteUserAPIKeyWithContext(ctx context.Context, user string) (*DeleteAPIKey, *Response, error) {
+	ctx = withOperation(ctx, "Users.DeleteUserAPIKey", "/api/security/apiKey/{user}")
+
+	u := fmt.Sprintf("/api/security/apiKey/%s", escapePath(user))
 	v := new(DeleteAPIKey)
 
-	resp, err := s.client.Call("DELETE", u, nil, v)
+	resp, err := s.client.CallContext(ctx, "DELETE", u, nil, v)
 	return v, resp, err
 }
 
@@ -221,10 +299,17 @@ func (s *UsersService) DeleteUserAPIKey(user string) (*DeleteAPIKey, *Response,
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-RevokeAllAPIKeys
 func (s *UsersService) DeleteAllAPIKeys() (*DeleteAPIKey, *Response, error) {
+	return s.DeleteAllAPIKeysWithContext(context.Background())
+}
+
+// DeleteAllAPIKeysWithContext removes all api keys using the provided context.
+func (s *UsersService) DeleteAllAPIKeysWithContext(ctx context.Context) (*DeleteAPIKey, *Response, error) {
+	ctx = withOperation(ctx, "Users.DeleteAllAPIKeys", "/api/security/apiKey")
+
 	u := "/api/security/apiKey?deleteAll=1"
 	v := new(DeleteAPIKey)
 
-	resp, err := s.client.Call("DELETE", u, nil, v)
+	resp, err := s.client.CallContext(ctx, "DELETE", u, nil, v)
 	return v, resp, err
 }
 
@@ -232,9 +317,16 @@ func (s *UsersService) DeleteAllAPIKeys() (*DeleteAPIKey, *Response, error) {
 //
 // Docs: https://www.jfrog.com/confluence/display/RTF/Artifactory+REST+API#ArtifactoryRESTAPI-GetUserEncryptedPassword
 func (s *UsersService) GetEncryptedPassword() (*string, *Response, error) {
+	return s.GetEncryptedPasswordWithContext(context.Background())
+}
+
+// GetEncryptedPasswordWithContext returns the encrypted password of the authenticated user using the provided context.
+func (s *UsersService) GetEncryptedPasswordWithContext(ctx context.Context) (*string, *Response, error) {
+	ctx = withOperation(ctx, "Users.GetEncryptedPassword", "/api/security/encryptedPassword")
+
 	u := "/api/security/encryptedPassword"
 	v := new(string)
 
-	resp, err := s.client.Call("GET", u, nil, v)
+	resp, err := s.client.CallContext(ctx, "GET", u, nil, v)
 	return v, resp, err
 }
@@ -0,0 +1,95 @@
+// Copyright (c) 2016 John E. Vincent
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Copyright (c) 2018 Target Brands, Inc.
+
+package rest
+
+const (
+	// HTTP Basic Authentication
+	authTypeBasic = 1
+	// Auth via API Token
+	authTypeToken = 2
+	// Auth via Bearer access token
+	authTypeBearer = 3
+)
+
+// Authentication holds the credentials applied to the requests of a Client.
+type Authentication struct {
+	username    *string
+	secret      *string
+	tokenSource TokenSource
+	authType    int
+}
+
+// SetBasicAuth sets the auth type as HTTP Basic auth.
+func (s *Authentication) SetBasicAuth(username, password string) {
+	s.username = &username
+	s.secret = &password
+	s.tokenSource = nil
+	s.authType = authTypeBasic
+}
+
+// SetTokenAuth sets the auth type as Token auth.
+func (s *Authentication) SetTokenAuth(token string) {
+	s.secret = &token
+	s.tokenSource = nil
+	s.authType = authTypeToken
+}
+
+// SetBearerAuth sets the auth type as Bearer auth with a static access token.
+func (s *Authentication) SetBearerAuth(token string) {
+	s.secret = &token
+	s.tokenSource = nil
+	s.authType = authTypeBearer
+}
+
+// SetTokenSource sets the auth type as Bearer auth with access tokens
+// obtained from the provided TokenSource before every request.
+// A request rejected with 401 Unauthorized is retried once after
+// refreshing the token.
+func (s *Authentication) SetTokenSource(ts TokenSource) {
+	s.secret = nil
+	s.tokenSource = ts
+	s.authType = authTypeBearer
+}
+
+// HasAuth checks if the auth type is set.
+func (s *Authentication) HasAuth() bool {
+	return s.authType > 0
+}
+
+// HasBasicAuth checks if the auth type is HTTP Basic auth.
+func (s *Authentication) HasBasicAuth() bool {
+	return s.authType == authTypeBasic
+}
+
+// HasTokenAuth checks if the auth type is Token auth.
+func (s *Authentication) HasTokenAuth() bool {
+	return s.authType == authTypeToken
+}
+
+// HasBearerAuth checks if the auth type is Bearer auth.
+func (s *Authentication) HasBearerAuth() bool {
+	return s.authType == authTypeBearer
+}
+
+// source returns the TokenSource used for Bearer auth, if any.
+func (s *Authentication) source() TokenSource {
+	if !s.HasBearerAuth() {
+		return nil
+	}
+
+	return s.tokenSource
+}
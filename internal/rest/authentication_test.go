@@ -0,0 +1,54 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/franela/goblin"
+)
+
+func Test_Authentication(t *testing.T) {
+	g := goblin.Goblin(t)
+	g.Describe("Authentication", func() {
+		a := new(Authentication)
+
+		g.It("- should set Bearer auth with SetBearerAuth()", func() {
+			a.SetBearerAuth("accessToken")
+			g.Assert(a.HasAuth()).IsTrue()
+			g.Assert(a.HasBearerAuth()).IsTrue()
+			g.Assert(a.HasTokenAuth()).IsFalse()
+		})
+
+		g.It("- should not use a TokenSource with SetBearerAuth()", func() {
+			a.SetBearerAuth("accessToken")
+			g.Assert(a.HasBearerAuth()).IsTrue()
+			g.Assert(a.source() == nil).IsTrue()
+		})
+
+		g.It("- should use the TokenSource set with SetTokenSource()", func() {
+			ts := NewRefreshTokenSource("http://localhost:8080/api/security/token", "accessToken", "refreshToken", time.Time{})
+
+			a.SetTokenSource(ts)
+			g.Assert(a.HasBearerAuth()).IsTrue()
+			g.Assert(a.source() == TokenSource(ts)).IsTrue()
+
+			a.SetBasicAuth("user", "pass")
+			g.Assert(a.HasBearerAuth()).IsFalse()
+			g.Assert(a.source() == nil).IsTrue()
+		})
+	})
+}
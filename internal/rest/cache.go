@@ -0,0 +1,265 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// defaultCacheSize is the number of responses held by the default cache.
+const defaultCacheSize = 1000
+
+// CachedResponse represents a response stored in a Cache.
+type CachedResponse struct {
+	// Header is the header of the response, holding its ETag and Last-Modified validators.
+	Header http.Header
+
+	// Body is the content of the response.
+	Body []byte
+
+	// StoredAt is when the response was stored or last revalidated.
+	StoredAt time.Time
+}
+
+// Cache stores the responses of the GET requests sent by a Client.
+// It must be safe for concurrent use.
+type Cache interface {
+	// Get returns the response stored for the key, if any.
+	Get(key string) (*CachedResponse, bool)
+
+	// Set stores the response for the key.
+	Set(key string, r *CachedResponse)
+}
+
+// responseCache represents the caching configured for a Client.
+type responseCache struct {
+	cache Cache
+	ttl   time.Duration
+}
+
+// WithCache caches the responses of the GET requests decoded by the Client in
+// the provided Cache, or in an in-memory LRU cache of 1000 responses if nil.
+// Streamed downloads are never cached.
+//
+// Requests for a cached response carry its validators in If-None-Match and
+// If-Modified-Since headers, and the cached response is returned if the server
+// replies 304 Not Modified. Responses are keyed by URL, so a Cache shouldn't be
+// shared by clients with different credentials.
+func WithCache(cache Cache) Option {
+	return func(o *options) error {
+		if cache == nil {
+			cache = NewLRUCache(defaultCacheSize)
+		}
+
+		o.cache = cache
+		return nil
+	}
+}
+
+// WithCacheTTL returns cached responses without contacting the server until
+// they are older than ttl, which also caches responses without validators.
+// Responses are cached in an in-memory LRU cache of 1000 responses, unless
+// another Cache is set with WithCache.
+func WithCacheTTL(ttl time.Duration) Option {
+	return func(o *options) error {
+		o.cacheTTL = ttl
+		return nil
+	}
+}
+
+// refreshContextKey is the context key marking calls bypassing the cache.
+type refreshContextKey struct{}
+
+// ContextWithoutCache returns a context for calls sent to the server even if their
+// response is cached. The cache is updated with the responses of the calls.
+func ContextWithoutCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, refreshContextKey{}, true)
+}
+
+// sendCached sends the GET request, unless its response is cached and fresh.
+// A cached response is revalidated with its validators, and returned if the
+// server replies 304 Not Modified. Responses returned from the cache carry
+// an X-From-Cache header.
+func (c *Client) sendCached(ctx context.Context, req *http.Request) (*http.Response, error) {
+	key := req.URL.String()
+
+	cached, ok := c.cache.cache.Get(key)
+	if refresh, _ := ctx.Value(refreshContextKey{}).(bool); refresh {
+		ok = false
+	}
+
+	if ok {
+		if c.cache.ttl > 0 && time.Since(cached.StoredAt) < c.cache.ttl {
+			return cached.response(req), nil
+		}
+
+		if etag := cached.Header.Get("ETag"); len(etag) > 0 && len(req.Header.Get("If-None-Match")) == 0 {
+			req.Header.Set("If-None-Match", etag)
+		}
+
+		if modified := cached.Header.Get("Last-Modified"); len(modified) > 0 && len(req.Header.Get("If-Modified-Since")) == 0 {
+			req.Header.Set("If-Modified-Since", modified)
+		}
+	}
+
+	resp, err := c.send(ctx, req)
+	if resp == nil {
+		return resp, err
+	}
+
+	if ok && resp.StatusCode == http.StatusNotModified {
+		Drain(resp)
+
+		revalidated := *cached
+		revalidated.StoredAt = time.Now()
+		c.cache.cache.Set(key, &revalidated)
+
+		return revalidated.response(req), nil
+	}
+
+	// Responses without validators can only be served until they expire.
+	if err != nil || resp.StatusCode != http.StatusOK {
+		return resp, err
+	}
+
+	if len(resp.Header.Get("ETag")) == 0 && len(resp.Header.Get("Last-Modified")) == 0 && c.cache.ttl <= 0 {
+		return resp, nil
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return resp, err
+	}
+
+	c.cache.cache.Set(key, &CachedResponse{Header: resp.Header.Clone(), Body: body, StoredAt: time.Now()})
+
+	return resp, nil
+}
+
+// response returns the cached response for the provided request.
+func (r *CachedResponse) response(req *http.Request) *http.Response {
+	header := r.Header.Clone()
+	header.Set("X-From-Cache", "1")
+
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(r.Body)),
+		ContentLength: int64(len(r.Body)),
+		Request:       req,
+	}
+}
+
+// cacheable reports whether the response to the request, decoded into v, can be cached.
+// Requests pinned to a node check its health, so they are always sent.
+func cacheable(ctx context.Context, req *http.Request, v interface{}) bool {
+	if req.Method != http.MethodGet || v == nil {
+		return false
+	}
+
+	if _, pinned := ctx.Value(nodeContextKey{}).(*node); pinned {
+		return false
+	}
+
+	_, stream := v.(io.Writer)
+
+	return !stream
+}
+
+// LRUCache is an in-memory Cache holding a limited number of responses.
+// The least recently used response is evicted to store a new one.
+type LRUCache struct {
+	mu      sync.Mutex
+	size    int
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+// lruEntry is an entry of an LRUCache.
+type lruEntry struct {
+	key      string
+	response *CachedResponse
+}
+
+// NewLRUCache returns a new LRUCache holding up to size responses.
+func NewLRUCache(size int) *LRUCache {
+	if size <= 0 {
+		size = defaultCacheSize
+	}
+
+	return &LRUCache{
+		size:    size,
+		order:   list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+// Get returns the response stored for the key, if any.
+func (c *LRUCache) Get(key string) (*CachedResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	c.order.MoveToFront(e)
+
+	return e.Value.(*lruEntry).response, true
+}
+
+// Set stores the response for the key, evicting the least recently used response if the cache is full.
+func (c *LRUCache) Set(key string, r *CachedResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e, ok := c.entries[key]; ok {
+		e.Value.(*lruEntry).response = r
+		c.order.MoveToFront(e)
+
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&lruEntry{key: key, response: r})
+
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*lruEntry).key)
+	}
+}
+
+// Len returns the number of responses in the cache.
+func (c *LRUCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len()
+}
@@ -0,0 +1,621 @@
+// Copyright (c) 2016 John E. Vincent
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Copyright (c) 2018 Target Brands, Inc.
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+
+	"github.com/google/go-querystring/query"
+)
+
+const (
+	userAgent = "go-arty"
+)
+
+// Config represents what differs between the JFrog products a Client talks to.
+type Config struct {
+	// Product is the name of the product, like "Artifactory".
+	Product string
+
+	// TokenAuth applies Token Authentication to a request.
+	TokenAuth func(req *http.Request, token string)
+
+	// HealthPath is the path of the endpoint pinged to check the health of a node.
+	HealthPath string
+
+	// EndpointClasses are the path prefixes of each class of endpoints.
+	EndpointClasses map[EndpointClass][]string
+}
+
+// Client is a client that manages communication with a JFrog REST API.
+type Client struct {
+	// HTTP client used to communicate with the API.
+	client *http.Client
+
+	// Base URL for API requests.
+	baseURL *url.URL
+
+	// What differs between products.
+	config Config
+
+	// User agent used when communicating with the API.
+	UserAgent string
+
+	// Headers sent with every request, unless the request sets them.
+	headers http.Header
+
+	// How response bodies are decoded, unless set for the call.
+	decodeMode DecodeMode
+
+	// Middlewares observing every request.
+	middlewares []Middleware
+
+	// Dumps every request and its response, if set.
+	debug *dumper
+
+	// Nodes requests are spread across. Requests are sent to baseURL if nil.
+	nodes *nodePool
+
+	// Cache of the responses to GET requests, if set.
+	cache *responseCache
+
+	// Limiters of all requests and of the requests to each class of endpoints, if set.
+	limiter  *limiter
+	limiters map[EndpointClass]*limiter
+
+	// Retry policy for failed requests. Requests are not retried if nil.
+	RetryPolicy *RetryPolicy
+
+	// Credentials applied to every request.
+	auth *Authentication
+}
+
+// New returns a new client of the API of the product described by config.
+// baseUrl has to be the HTTP endpoint of the API.
+// If no httpClient is provided, then the http.DefaultClient will be used.
+// The provided options configure a copy of the httpClient, leaving it untouched.
+func New(baseUrl string, httpClient *http.Client, config Config, opts ...Option) (*Client, error) {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	o, err := newOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	for class := range o.limiters {
+		if _, ok := config.EndpointClasses[class]; !ok {
+			return nil, fmt.Errorf("unknown endpoint class %q", class)
+		}
+	}
+
+	httpClient, err = o.httpClient(httpClient)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(baseUrl) == 0 {
+		return nil, fmt.Errorf("No %s baseUrl provided", config.Product)
+	}
+	baseURL, err := url.Parse(baseUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Client{
+		client:      httpClient,
+		baseURL:     baseURL,
+		config:      config,
+		UserAgent:   userAgent,
+		headers:     o.headers,
+		decodeMode:  o.decodeMode,
+		middlewares: o.middlewares,
+		debug:       o.debug,
+		limiter:     o.limiter,
+		limiters:    o.limiters,
+		auth:        new(Authentication),
+		RetryPolicy: o.retryPolicy,
+	}
+
+	if o.cache != nil || o.cacheTTL > 0 {
+		if o.cache == nil {
+			o.cache = NewLRUCache(defaultCacheSize)
+		}
+
+		c.cache = &responseCache{cache: o.cache, ttl: o.cacheTTL}
+	}
+
+	if len(o.nodes) > 0 {
+		c.nodes = newNodePool(o.failoverMode, o.nodeCooldown, append([]*url.URL{baseURL}, o.nodes...)...)
+	}
+
+	if len(o.userAgent) > 0 {
+		c.UserAgent = fmt.Sprintf("%s %s", c.UserAgent, o.userAgent)
+	}
+
+	return c, nil
+}
+
+// Auth returns the credentials applied to every request sent by the provided Client.
+func Auth(c *Client) *Authentication {
+	return c.auth
+}
+
+// HTTPClient returns the HTTP client used by the provided Client.
+func HTTPClient(c *Client) *http.Client {
+	return c.client
+}
+
+// BuildURL returns the URL the provided Client calls for urlStr.
+func BuildURL(c *Client, urlStr string) (string, error) {
+	return c.buildURLForRequest(urlStr)
+}
+
+// buildURLForRequest will build the URL (as a string) that will be called.
+// It does several cleaning tasks for us.
+func (c *Client) buildURLForRequest(urlStr string) (string, error) {
+	u := c.baseURL.String()
+
+	// If there is no / at the end, add one.
+	if strings.HasSuffix(u, "/") == false {
+		u += "/"
+	}
+
+	// If there is a "/" at the start, remove it.
+	if strings.HasPrefix(urlStr, "/") == true {
+		urlStr = urlStr[1:]
+	}
+
+	rel, err := url.Parse(urlStr)
+	if err != nil {
+		return "", err
+	}
+	u += rel.String()
+
+	return u, nil
+}
+
+// addAuthentication adds the necessary authentication to the request.
+func (c *Client) addAuthentication(req *http.Request) {
+	// Apply HTTP Basic Authentication.
+	if c.auth.HasBasicAuth() {
+		req.SetBasicAuth(*c.auth.username, *c.auth.secret)
+
+		// Apply Token Authentication.
+	} else if c.auth.HasTokenAuth() {
+		c.config.TokenAuth(req, *c.auth.secret)
+
+		// Apply static Bearer Authentication.
+		// Tokens from a TokenSource are applied for every attempt in send.
+	} else if c.auth.HasBearerAuth() && c.auth.secret != nil {
+		req.Header.Add("Authorization", "Bearer "+*c.auth.secret)
+	}
+}
+
+// AddOptions adds the parameters in opt as URL query parameters to s.
+// opt must be a struct whose fields may contain "url" tags.
+func AddOptions(s string, opt interface{}) (string, error) {
+	v := reflect.ValueOf(opt)
+	if v.Kind() == reflect.Ptr && v.IsNil() {
+		return s, nil
+	}
+
+	u, err := url.Parse(s)
+	if err != nil {
+		return s, err
+	}
+
+	qs, err := query.Values(opt)
+	if err != nil {
+		return s, err
+	}
+
+	u.RawQuery = qs.Encode()
+	return u.String(), nil
+}
+
+// NewRequest creates an API request.
+// A relative URL can be provided in urlStr,
+// in which case it is resolved relative to the baseURL of the Client.
+// Relative URLs should always be specified without a preceding slash.
+// If specified, the value pointed to by body is JSON encoded and included as the request body.
+// If body implements the io.Reader interface, it is sent as the raw request body instead.
+func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Request, error) {
+	return c.NewRequestWithContext(context.Background(), method, urlStr, body)
+}
+
+// NewRequestWithContext creates an API request with the provided context.
+// The context controls the entire lifetime of the request and its response,
+// including reading the response body.
+func (c *Client) NewRequestWithContext(ctx context.Context, method, urlStr string, body interface{}) (*http.Request, error) {
+	u, err := c.buildURLForRequest(urlStr)
+	if err != nil {
+		return nil, err
+	}
+
+	contentType := "application/json"
+
+	var buf io.Reader
+	switch b := body.(type) {
+	case nil:
+	case io.Reader:
+		buf = b
+		contentType = "application/octet-stream"
+	default:
+		w := new(bytes.Buffer)
+		err := json.NewEncoder(w).Encode(body)
+		if err != nil {
+			return nil, err
+		}
+		buf = w
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u, buf)
+	if err != nil {
+		return nil, err
+	}
+
+	// Seekable sources, like an *os.File, are rewound if the request is retried.
+	if rs, ok := body.(io.ReadSeeker); ok {
+		err = SetSeekableBody(req, rs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Apply Authentication.
+	if c.auth.HasAuth() {
+		c.addAuthentication(req)
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if len(c.UserAgent) > 0 {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+
+	for k, v := range c.headers {
+		if _, ok := req.Header[k]; !ok {
+			req.Header[k] = v
+		}
+	}
+
+	return req, nil
+}
+
+// Response represents an API response.
+// This wraps the standard http.Response returned from the API.
+type Response struct {
+	*http.Response
+}
+
+// Call is a combine function for Client.NewRequest and Client.Do.
+//
+// Most API methods are quite the same.
+// Get the URL, apply options, make a request, and get the response.
+// Without adding special headers or something.
+// To avoid a big amount of code duplication you can Client.Call.
+//
+// method is the HTTP method you want to call.
+// u is the URL you want to call.
+// body is the HTTP body.
+// v is the HTTP response.
+//
+// For more information read https://github.com/google/go-github/issues/234
+func (c *Client) Call(method, u string, body interface{}, v interface{}) (*Response, error) {
+	return c.CallContext(context.Background(), method, u, body, v)
+}
+
+// CallContext is a combine function for Client.NewRequestWithContext and Client.DoContext.
+// Cancelling ctx aborts the request, including any in-flight request or response body.
+func (c *Client) CallContext(ctx context.Context, method, u string, body interface{}, v interface{}) (*Response, error) {
+	req, err := c.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.DoContext(ctx, req, v)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, err
+}
+
+// Do sends an API request and returns the API response.
+// The API response is JSON decoded and stored in the value pointed to by v,
+// or returned as an error if an API error has occurred.
+// If v implements the io.Writer interface, the raw response body will be written to v,
+// without attempting to first decode it.
+func (c *Client) Do(req *http.Request, v interface{}) (*Response, error) {
+	return c.DoContext(req.Context(), req, v)
+}
+
+// DoContext sends an API request with the provided context and returns the API response.
+// If ctx is cancelled or its deadline is exceeded, ctx.Err() is returned
+// in place of the underlying transport error.
+func (c *Client) DoContext(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+	if ctx == nil {
+		return nil, errors.New("context must be non-nil")
+	}
+	req = req.WithContext(ctx)
+
+	send := c.send
+	if c.cache != nil && cacheable(ctx, req, v) {
+		send = c.sendCached
+	}
+
+	resp, err := send(ctx, req)
+	if resp == nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	// Wrap response
+	response := &Response{Response: resp}
+
+	if err != nil {
+		// even though there was an error, we still return the response
+		// in case the caller wants to inspect it further
+		return response, err
+	}
+
+	if v != nil {
+		if w, ok := v.(io.Writer); ok {
+			_, err = io.Copy(w, resp.Body)
+			if err != nil {
+				// the copy is aborted if ctx is cancelled mid-stream
+				return response, err
+			}
+		} else {
+			var body []byte
+			body, err = ioutil.ReadAll(resp.Body)
+			// This ensures the response body is not empty in the event the user
+			// wants to inspect the response body further
+			resp.Body = ioutil.NopCloser(bytes.NewBuffer(body))
+			if err != nil {
+				// even though there was an error, we still return the response
+				// in case the caller wants to inspect it further
+				return response, err
+			}
+			// Since the API we integrate with doesn't always return JSON
+			// we only return an error if we can't unmarshal the body in strict mode
+			err = c.decode(ctx, resp, body, v)
+		}
+	}
+	return response, err
+}
+
+// Send sends the request with the provided Client, retrying it as allowed by
+// its RetryPolicy. It returns the last response received, along with its error
+// from CheckResponse. The response body is left for the caller to read and close.
+func Send(ctx context.Context, c *Client, req *http.Request) (*http.Response, error) {
+	return c.send(ctx, req)
+}
+
+// RoundTrip sends a single attempt of the request with the provided Client,
+// without retrying it or checking its response.
+func RoundTrip(c *Client, req *http.Request) (*http.Response, error) {
+	return c.roundTrip(req)
+}
+
+// send sends the request, retrying it as allowed by the RetryPolicy of the Client.
+// It returns the last response received, along with its error from CheckResponse.
+func (c *Client) send(ctx context.Context, req *http.Request) (*http.Response, error) {
+	if len(c.middlewares) > 0 {
+		return c.observe(ctx, req, c.sendAttempts)
+	}
+
+	return c.sendAttempts(ctx, req)
+}
+
+// sendAttempts sends the request until it succeeds or runs out of attempts.
+func (c *Client) sendAttempts(ctx context.Context, req *http.Request) (*http.Response, error) {
+	attempts := c.RetryPolicy.attempts(req)
+	if ctx.Value(noRetryContextKey{}) != nil {
+		attempts = 1
+	}
+
+	tokens := c.auth.source()
+
+	// Requests to the base URL are sent to the nodes of the Client, if any.
+	// A request pinned to a node checks its health, so it is sent only once.
+	var (
+		rel      string
+		routed   bool
+		pinned   *node
+		failover bool
+		tried    = map[*node]bool{}
+	)
+
+	if c.nodes != nil {
+		rel, routed = relativeURL(c.baseURL, req.URL)
+		if routed {
+			pinned, _ = ctx.Value(nodeContextKey{}).(*node)
+			if pinned != nil {
+				attempts = 1
+			}
+
+			failover = pinned == nil && isIdempotent(req.Method)
+		}
+	}
+
+	// Retries replay the body, so make sure it can be read again.
+	// A body too large to be buffered is sent only once.
+	replayable := true
+	if (attempts > 1 || tokens != nil || failover) && req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		var err error
+		replayable, err = bufferBody(req)
+		if err != nil {
+			return nil, err
+		}
+
+		if !replayable {
+			attempts, failover = 1, false
+		}
+	}
+
+	sent, refreshed := false, false
+	for attempt := 1; ; attempt++ {
+		if sent && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+
+		if tokens != nil {
+			token, err := tokens.Token(ctx)
+			if err != nil {
+				return nil, err
+			}
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+
+		var n *node
+		if routed {
+			n = pinned
+			if n == nil {
+				n = c.nodes.pick(ctx, c, tried)
+			}
+
+			err := rebaseURL(req, n, rel)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		sent = true
+		resp, err := c.roundTrip(req)
+		if err != nil {
+			// If we got an error, and the context has been canceled,
+			// the context's error is probably more useful.
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			default:
+			}
+
+			// Fail over to another node, without counting as an attempt.
+			if n != nil {
+				c.nodes.down(n)
+
+				tried[n] = true
+				if failover && len(tried) < len(c.nodes.nodes) {
+					attempt--
+					continue
+				}
+			}
+
+			if attempt >= attempts {
+				return nil, err
+			}
+
+			if err := Sleep(ctx, c.RetryPolicy.backoff(attempt)); err != nil {
+				return nil, err
+			}
+
+			tried = map[*node]bool{}
+			continue
+		}
+
+		err = CheckResponse(resp)
+
+		if n != nil {
+			if resp.StatusCode < http.StatusInternalServerError {
+				c.nodes.up(n)
+			} else {
+				c.nodes.down(n)
+
+				tried[n] = true
+				if failover && len(tried) < len(c.nodes.nodes) {
+					Drain(resp)
+
+					attempt--
+					continue
+				}
+			}
+		}
+
+		// A rejected access token is refreshed once, without counting as an attempt.
+		if resp.StatusCode == http.StatusUnauthorized && tokens != nil && !refreshed && replayable {
+			refreshed = true
+			Drain(resp)
+
+			if err := tokens.Refresh(ctx); err != nil {
+				return nil, err
+			}
+
+			attempt--
+			continue
+		}
+
+		if err == nil || attempt >= attempts || !c.RetryPolicy.retryStatus(resp.StatusCode) {
+			return resp, err
+		}
+
+		wait, ok := retryAfter(resp)
+		if !ok {
+			wait = c.RetryPolicy.backoff(attempt)
+		}
+		Drain(resp)
+
+		if err := Sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+
+		tried = map[*node]bool{}
+	}
+}
+
+// roundTrip sends a single attempt of the request once allowed by the limiters
+// of the Client, dumping it if the Client is in debug mode.
+func (c *Client) roundTrip(req *http.Request) (*http.Response, error) {
+	release, err := c.limit(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp *http.Response
+	if c.debug == nil {
+		resp, err = c.client.Do(req)
+	} else {
+		resp, err = c.debug.roundTrip(c.client, req)
+	}
+
+	if err != nil || resp == nil || resp.Body == nil {
+		release()
+		return resp, err
+	}
+
+	resp.Body = &releasingBody{ReadCloser: resp.Body, release: release}
+
+	return resp, nil
+}
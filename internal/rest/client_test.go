@@ -0,0 +1,250 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/franela/goblin"
+)
+
+// testConfig describes a product sending tokens in a header.
+var testConfig = Config{
+	Product: "Test",
+	TokenAuth: func(req *http.Request, token string) {
+		req.Header.Set("X-Test-Token", token)
+	},
+	HealthPath: "/api/ping",
+	EndpointClasses: map[EndpointClass][]string{
+		"search": {"/api/search/"},
+	},
+}
+
+func Test_client(t *testing.T) {
+	g := goblin.Goblin(t)
+	g.Describe("New", func() {
+		g.It("- should name the product of a client without url", func() {
+			_, err := New("", nil, testConfig)
+
+			g.Assert(err.Error()).Equal("No Test baseUrl provided")
+		})
+
+		g.It("- should fail with an unknown endpoint class", func() {
+			_, err := New("https://some.company.com", nil, testConfig, WithEndpointLimit("storage", Limit{Rate: 1}))
+
+			g.Assert(err.Error()).Equal(`unknown endpoint class "storage"`)
+		})
+
+		g.It("- should create a client with a known endpoint class", func() {
+			_, err := New("https://some.company.com", nil, testConfig, WithEndpointLimit("search", Limit{Rate: 1}))
+
+			g.Assert(err == nil).IsTrue()
+		})
+	})
+
+	g.Describe("BuildURLForRequest", func() {
+		client, _ := New("https://some.company.com", nil, testConfig)
+
+		g.BeforeEach(func() {
+			client, _ = New("https://some.company.com", nil, testConfig)
+		})
+
+		g.It("- should be able to build url for request", func() {
+			actual, err := client.buildURLForRequest("test")
+
+			g.Assert(actual).Equal("https://some.company.com/test")
+			g.Assert(err == nil).IsTrue()
+		})
+
+		g.It("- should be able to build url for request with a leading slash", func() {
+			actual, err := client.buildURLForRequest("/test")
+
+			g.Assert(actual).Equal("https://some.company.com/test")
+			g.Assert(err == nil).IsTrue()
+		})
+
+		g.It("- should be able to build url for request with a trailing slash", func() {
+			actual, err := client.buildURLForRequest("test/")
+
+			g.Assert(actual).Equal("https://some.company.com/test/")
+			g.Assert(err == nil).IsTrue()
+		})
+
+		g.It("- should keep the path and query of the base url", func() {
+			for _, base := range []string{"https://some.company.com/artifactory", "https://some.company.com/artifactory/"} {
+				client, _ := New(base, nil, testConfig)
+
+				actual, err := client.buildURLForRequest("/api/search/gavc?g=org.acme")
+
+				g.Assert(actual).Equal("https://some.company.com/artifactory/api/search/gavc?g=org.acme")
+				g.Assert(err == nil).IsTrue()
+			}
+		})
+
+		g.It("- should fail to build url for request", func() {
+			actual, err := client.buildURLForRequest("@$(:LKS24poihwekf1203")
+
+			g.Assert(actual == "").IsTrue()
+			g.Assert(err != nil).IsTrue()
+		})
+	})
+
+	g.Describe("AddAuthentication", func() {
+		client, _ := New("https://some.company.com", nil, testConfig)
+
+		g.BeforeEach(func() {
+			client, _ = New("https://some.company.com", nil, testConfig)
+		})
+
+		g.It("- should apply token authentication as configured", func() {
+			Auth(client).SetTokenAuth("someToken")
+			request, _ := client.NewRequest("GET", "/ping", nil)
+
+			g.Assert(request.Header.Get("X-Test-Token")).Equal("someToken")
+			g.Assert(request.Header.Get("Authorization")).Equal("")
+		})
+
+		g.It("- should not apply authentication if none is set", func() {
+			request, _ := client.NewRequest("GET", "/ping", nil)
+
+			g.Assert(request.Header.Get("X-Test-Token")).Equal("")
+			g.Assert(request.Header.Get("Authorization")).Equal("")
+		})
+	})
+
+	g.Describe("HealthPath", func() {
+		g.It("- should check nodes with the configured path", func() {
+			var (
+				mu    sync.Mutex
+				paths []string
+			)
+
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+
+				paths = append(paths, r.URL.Path)
+			}))
+			defer s.Close()
+
+			c, _ := New(s.URL, nil, testConfig, WithNodes(RoundRobin, s.URL+"/"))
+			statuses := c.CheckNodes()
+
+			g.Assert(len(statuses)).Equal(2)
+			g.Assert(statuses[0].Healthy).IsTrue()
+			g.Assert(paths).Equal([]string{"/api/ping", "/api/ping"})
+		})
+	})
+
+	g.Describe("Context", func() {
+		type key struct{}
+
+		// handler that blocks until the request context is done
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			<-r.Context().Done()
+		}))
+
+		client, _ := New(s.URL, nil, testConfig)
+
+		g.After(func() {
+			s.Close()
+		})
+
+		g.It("- should attach the context to a new request", func() {
+			ctx := context.WithValue(context.Background(), key{}, "value")
+
+			actual, err := client.NewRequestWithContext(ctx, "GET", "/ping", nil)
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(actual.Context().Value(key{})).Equal("value")
+			g.Assert(actual.Header.Get("Content-Type")).Equal("application/json")
+		})
+
+		g.It("- should send an io.Reader body as is", func() {
+			actual, err := client.NewRequestWithContext(context.Background(), "PUT", "/file", strings.NewReader("raw"))
+
+			g.Assert(err == nil).IsTrue()
+
+			body, _ := ioutil.ReadAll(actual.Body)
+			g.Assert(string(body)).Equal("raw")
+			g.Assert(actual.Header.Get("Content-Type")).Equal("application/octet-stream")
+		})
+
+		g.It("- should return the context error when cancelled", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			resp, err := client.CallContext(ctx, "GET", "/ping", nil, nil)
+
+			g.Assert(resp == nil).IsTrue()
+			g.Assert(err == context.Canceled).IsTrue()
+		})
+
+		g.It("- should return the context error when the deadline is exceeded", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+
+			req, _ := client.NewRequest("GET", "/ping", nil)
+			resp, err := client.DoContext(ctx, req, nil)
+
+			g.Assert(resp == nil).IsTrue()
+			g.Assert(err == context.DeadlineExceeded).IsTrue()
+		})
+	})
+
+	g.Describe("AddOptions", func() {
+		type options struct {
+			ShowAll bool `url:"all"`
+			Page    int  `url:"page"`
+		}
+		g.It("- call AddOptions - valid case", func() {
+			options := options{ShowAll: true, Page: 1}
+			actual, err := AddOptions("http://www.somestring.com", options)
+
+			g.Assert(actual).Equal("http://www.somestring.com?all=true&page=1")
+			g.Assert(err == nil).IsTrue()
+		})
+
+		g.It("- call AddOptions - valid url with bad options type", func() {
+			actual, err := AddOptions("http://www.somestring.com", 87)
+
+			g.Assert(actual).Equal("http://www.somestring.com")
+			g.Assert(err != nil).IsTrue()
+			g.Assert(err.Error()).Equal("query: Values() expects struct input. Got int")
+		})
+
+		g.It("- call AddOptions - bad url parse", func() {
+			options := options{ShowAll: true, Page: 1}
+			_, err := AddOptions("!@*&^%%", options)
+			g.Assert(err != nil).IsTrue()
+			g.Assert(err.Error()).Equal("parse \"!@*&^%%\": invalid URL escape \"%%\"")
+		})
+
+		g.It("- call AddOptions - nil options passed", func() {
+			options := (*options)(nil)
+			actual, err := AddOptions("http://www.somestring.com", options)
+			g.Assert(actual).Equal("http://www.somestring.com")
+			g.Assert(err == nil).IsTrue()
+		})
+	})
+
+}
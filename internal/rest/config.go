@@ -0,0 +1,228 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// configFiles are the JFrog CLI config files, by order of preference.
+var configFiles = []string{"jfrog-cli.conf.v6", "jfrog-cli.conf.v5", "jfrog-cli.conf"}
+
+// cliConfig represents a JFrog CLI config file.
+type cliConfig struct {
+	Servers []*Server `json:"servers"`
+
+	// Artifactory lists the servers of version 1 config files,
+	// whose url is the URL of Artifactory.
+	Artifactory []*Server `json:"artifactory"`
+
+	// Enc is set if the secrets of the servers are encrypted with a master key.
+	Enc bool `json:"enc"`
+}
+
+// Server represents a server configured for the JFrog CLI.
+type Server struct {
+	ServerID       string `json:"serverId"`
+	URL            string `json:"url"`
+	ArtifactoryURL string `json:"artifactoryUrl"`
+	XrayURL        string `json:"xrayUrl"`
+	User           string `json:"user"`
+	Password       string `json:"password"`
+	APIKey         string `json:"apiKey"`
+	AccessToken    string `json:"accessToken"`
+	RefreshToken   string `json:"refreshToken"`
+	IsDefault      bool   `json:"isDefault"`
+}
+
+// WithConfigDir sets the directory of the JFrog CLI config files read by LoadServer.
+// Defaults to $JFROG_CLI_HOME_DIR, or ~/.jfrog.
+func WithConfigDir(dir string) Option {
+	return func(o *options) error {
+		o.configDir = dir
+		return nil
+	}
+}
+
+// WithMasterKey sets the master key decrypting the JFrog CLI config read by LoadServer.
+// Defaults to $JFROG_CLI_ENCRYPTION_KEY.
+func WithMasterKey(key string) Option {
+	return func(o *options) error {
+		o.masterKey = key
+		return nil
+	}
+}
+
+// LoadServer returns the server with the provided ID in the JFrog CLI config,
+// or the default server if serverID is empty. It returns nil if there is no
+// such server.
+//
+// The config is read from the jfrog-cli.conf.v6, jfrog-cli.conf.v5 or
+// jfrog-cli.conf file of the config dir set with WithConfigDir, whichever
+// comes first. Encrypted configs are decrypted with the master key set with
+// WithMasterKey.
+func LoadServer(serverID string, opts ...Option) (*Server, error) {
+	o, err := newOptions(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return loadServerConfig(o.configDir, o.masterKey, serverID)
+}
+
+// loadServerConfig returns the server with the provided ID, or the default server,
+// from the JFrog CLI config in dir. It returns nil if there is no such server.
+func loadServerConfig(dir, masterKey, serverID string) (*Server, error) {
+	if len(dir) == 0 {
+		dir = os.Getenv("JFROG_CLI_HOME_DIR")
+	}
+
+	if len(dir) == 0 {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, nil
+		}
+
+		dir = filepath.Join(home, ".jfrog")
+	}
+
+	if len(masterKey) == 0 {
+		masterKey = os.Getenv("JFROG_CLI_ENCRYPTION_KEY")
+	}
+
+	for _, name := range configFiles {
+		b, err := ioutil.ReadFile(filepath.Join(dir, name))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		config := new(cliConfig)
+		if err := json.Unmarshal(b, config); err != nil {
+			return nil, fmt.Errorf("invalid JFrog CLI config %s: %w", name, err)
+		}
+
+		// The url of the servers of version 1 configs is the URL of Artifactory,
+		// usually found under the URL of the platform.
+		for _, server := range config.Artifactory {
+			server.ArtifactoryURL, server.URL = server.URL, ""
+			if u := strings.TrimSuffix(server.ArtifactoryURL, "/"); strings.HasSuffix(u, "/artifactory") {
+				server.URL = strings.TrimSuffix(u, "artifactory")
+			}
+
+			config.Servers = append(config.Servers, server)
+		}
+
+		server := config.server(serverID)
+		if server != nil && config.Enc {
+			err := server.decrypt(masterKey)
+			if err != nil {
+				return nil, fmt.Errorf("unable to decrypt JFrog CLI config %s: %w", name, err)
+			}
+		}
+
+		return server, nil
+	}
+
+	return nil, nil
+}
+
+// server returns the server with the provided ID, or the default server if serverID is empty.
+func (c *cliConfig) server(serverID string) *Server {
+	for _, server := range c.Servers {
+		if (len(serverID) == 0 && server.IsDefault) || (len(serverID) > 0 && server.ServerID == serverID) {
+			return server
+		}
+	}
+
+	// The only server of a config is its default one.
+	if len(serverID) == 0 && len(c.Servers) == 1 {
+		return c.Servers[0]
+	}
+
+	return nil
+}
+
+// decrypt decrypts the secrets of the server with the provided master key.
+// The JFrog CLI encrypts secrets with AES-GCM, prefixing them with their nonce.
+func (s *Server) decrypt(masterKey string) error {
+	if len(masterKey) == 0 {
+		return errors.New("the config is encrypted, and no master key was provided")
+	}
+
+	block, err := aes.NewCipher([]byte(masterKey))
+	if err != nil {
+		return err
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return err
+	}
+
+	for _, secret := range []*string{&s.Password, &s.APIKey, &s.AccessToken, &s.RefreshToken} {
+		if len(*secret) == 0 {
+			continue
+		}
+
+		b, err := base64.StdEncoding.DecodeString(*secret)
+		if err != nil {
+			return err
+		}
+
+		if len(b) < gcm.NonceSize() {
+			return errors.New("encrypted secret too short")
+		}
+
+		plain, err := gcm.Open(nil, b[:gcm.NonceSize()], b[gcm.NonceSize():], nil)
+		if err != nil {
+			return err
+		}
+
+		*secret = string(plain)
+	}
+
+	return nil
+}
+
+// EnvServer returns the server configured by the environment, or nil if there is none.
+func EnvServer() *Server {
+	server := &Server{
+		URL:            os.Getenv("JFROG_URL"),
+		ArtifactoryURL: os.Getenv("JFROG_ARTIFACTORY_URL"),
+		XrayURL:        os.Getenv("JFROG_XRAY_URL"),
+		User:           os.Getenv("JFROG_USER"),
+		Password:       os.Getenv("JFROG_PASSWORD"),
+		APIKey:         os.Getenv("JFROG_API_KEY"),
+		AccessToken:    os.Getenv("JFROG_ACCESS_TOKEN"),
+	}
+
+	if len(server.URL) == 0 && len(server.ArtifactoryURL) == 0 && len(server.XrayURL) == 0 {
+		return nil
+	}
+
+	return server
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/base64"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/franela/goblin"
+)
+
+// encryptSecret encrypts the secret like the JFrog CLI does.
+func encryptSecret(secret, key string) string {
+	block, _ := aes.NewCipher([]byte(key))
+	gcm, _ := cipher.NewGCM(block)
+
+	nonce := make([]byte, gcm.NonceSize())
+
+	return base64.StdEncoding.EncodeToString(gcm.Seal(nonce, nonce, []byte(secret), nil))
+}
+
+func Test_Config(t *testing.T) {
+	const masterKey = "0123456789abcdef0123456789abcdef"
+
+	g := goblin.Goblin(t)
+	g.Describe("Config", func() {
+		var dir string
+
+		write := func(name, config string) {
+			_ = ioutil.WriteFile(filepath.Join(dir, name), []byte(config), 0600)
+		}
+
+		g.BeforeEach(func() {
+			dir = t.TempDir()
+
+			t.Setenv("JFROG_CLI_HOME_DIR", dir)
+			t.Setenv("JFROG_CLI_ENCRYPTION_KEY", "")
+
+			for _, env := range []string{"JFROG_URL", "JFROG_ARTIFACTORY_URL", "JFROG_XRAY_URL", "JFROG_USER", "JFROG_PASSWORD", "JFROG_API_KEY", "JFROG_ACCESS_TOKEN"} {
+				t.Setenv(env, "")
+			}
+		})
+
+		g.It("- should load the default server", func() {
+			write("jfrog-cli.conf.v6", `{
+				"servers": [
+					{"serverId": "other", "url": "http://localhost:1/", "accessToken": "other"},
+					{"serverId": "acme", "url": "http://localhost:2/", "accessToken": "token", "isDefault": true}
+				],
+				"version": "6"
+			}`)
+
+			server, err := LoadServer("")
+			g.Assert(err == nil).IsTrue()
+			g.Assert(server.ServerID).Equal("acme")
+			g.Assert(server.URL).Equal("http://localhost:2/")
+			g.Assert(server.AccessToken).Equal("token")
+		})
+
+		g.It("- should load the only server as the default one", func() {
+			write("jfrog-cli.conf.v6", `{"servers": [{"serverId": "acme", "url": "http://localhost:2/"}]}`)
+
+			server, err := LoadServer("")
+			g.Assert(err == nil).IsTrue()
+			g.Assert(server.ServerID).Equal("acme")
+		})
+
+		g.It("- should load the server with the provided ID from the preferred config", func() {
+			write("jfrog-cli.conf.v6", `{"servers": [{"serverId": "acme", "artifactoryUrl": "http://localhost:2/arty/", "xrayUrl": "http://localhost:2/xr/", "user": "admin", "password": "password"}]}`)
+			write("jfrog-cli.conf.v5", `{"servers": [{"serverId": "acme", "artifactoryUrl": "http://localhost:1/"}]}`)
+
+			server, err := LoadServer("acme")
+			g.Assert(err == nil).IsTrue()
+			g.Assert(server.ArtifactoryURL).Equal("http://localhost:2/arty/")
+			g.Assert(server.XrayURL).Equal("http://localhost:2/xr/")
+			g.Assert(server.User).Equal("admin")
+			g.Assert(server.Password).Equal("password")
+		})
+
+		g.It("- should read the config from the provided dir", func() {
+			other := t.TempDir()
+			_ = ioutil.WriteFile(filepath.Join(other, "jfrog-cli.conf.v6"), []byte(`{"servers": [{"serverId": "acme", "url": "http://localhost:2/"}]}`), 0600)
+
+			server, err := LoadServer("acme", WithConfigDir(other))
+			g.Assert(err == nil).IsTrue()
+			g.Assert(server.URL).Equal("http://localhost:2/")
+		})
+
+		g.It("- should read version 1 configs", func() {
+			write("jfrog-cli.conf", `{"artifactory": [{"serverId": "acme", "url": "http://localhost:2/artifactory/", "apiKey": "key"}], "version": "1"}`)
+
+			server, err := LoadServer("")
+			g.Assert(err == nil).IsTrue()
+			g.Assert(server.URL).Equal("http://localhost:2/")
+			g.Assert(server.ArtifactoryURL).Equal("http://localhost:2/artifactory/")
+			g.Assert(server.APIKey).Equal("key")
+		})
+
+		g.It("- should decrypt encrypted configs", func() {
+			write("jfrog-cli.conf.v5", `{"servers": [{"serverId": "acme", "url": "http://localhost:2/", "accessToken": "`+encryptSecret("token", masterKey)+`"}], "enc": true}`)
+
+			server, err := LoadServer("acme", WithMasterKey(masterKey))
+			g.Assert(err == nil).IsTrue()
+			g.Assert(server.AccessToken).Equal("token")
+
+			t.Setenv("JFROG_CLI_ENCRYPTION_KEY", masterKey)
+
+			server, err = LoadServer("acme")
+			g.Assert(err == nil).IsTrue()
+			g.Assert(server.AccessToken).Equal("token")
+		})
+
+		g.It("- should fail to read encrypted configs without the master key", func() {
+			write("jfrog-cli.conf.v5", `{"servers": [{"serverId": "acme", "url": "http://localhost:2/", "accessToken": "`+encryptSecret("token", masterKey)+`"}], "enc": true}`)
+
+			_, err := LoadServer("acme")
+			g.Assert(err != nil).IsTrue()
+
+			_, err = LoadServer("acme", WithMasterKey("fedcba9876543210fedcba9876543210"))
+			g.Assert(err != nil).IsTrue()
+		})
+
+		g.It("- should return nil without a server", func() {
+			server, err := LoadServer("")
+			g.Assert(err == nil).IsTrue()
+			g.Assert(server == nil).IsTrue()
+
+			write("jfrog-cli.conf.v6", `{"servers": [{"serverId": "acme", "url": "http://localhost:2/"}]}`)
+
+			server, err = LoadServer("other")
+			g.Assert(err == nil).IsTrue()
+			g.Assert(server == nil).IsTrue()
+		})
+
+		g.It("- should fail with an invalid config", func() {
+			write("jfrog-cli.conf.v6", `{"servers": {}}`)
+
+			_, err := LoadServer("")
+			g.Assert(err != nil).IsTrue()
+		})
+
+		g.It("- should read the server from the environment", func() {
+			g.Assert(EnvServer() == nil).IsTrue()
+
+			t.Setenv("JFROG_XRAY_URL", "http://localhost:2/xray/")
+			t.Setenv("JFROG_USER", "admin")
+			t.Setenv("JFROG_API_KEY", "key")
+
+			server := EnvServer()
+			g.Assert(server.XrayURL).Equal("http://localhost:2/xray/")
+			g.Assert(server.User).Equal("admin")
+			g.Assert(server.APIKey).Equal("key")
+		})
+	})
+}
@@ -0,0 +1,196 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// dumper writes the requests sent by the Client and their responses to a writer.
+type dumper struct {
+	mu      sync.Mutex
+	w       io.Writer
+	maxBody int
+}
+
+// WithDebug dumps every request sent by the Client and its response to the
+// provided writer, including retries. The headers are dumped along with a
+// preview of the bodies, cut at maxBody bytes. Bodies aren't dumped if
+// maxBody is 0.
+//
+// Credentials are redacted from the dump: the Authorization and
+// X-JFrog-Art-Api headers, the secret query parameters, and the secret
+// fields of the bodies, like the password of a SecurityUser or a Replication
+// and the key of a LicenseRequest.
+func WithDebug(w io.Writer, maxBody int) Option {
+	return func(o *options) error {
+		if w == nil {
+			return fmt.Errorf("no debug writer provided")
+		}
+
+		if maxBody < 0 {
+			return fmt.Errorf("invalid debug body size %d", maxBody)
+		}
+
+		o.debug = &dumper{w: w, maxBody: maxBody}
+		return nil
+	}
+}
+
+// roundTrip sends the request with the provided client, and dumps it with its response.
+func (d *dumper) roundTrip(client *http.Client, req *http.Request) (*http.Response, error) {
+	buf := new(bytes.Buffer)
+
+	fmt.Fprintf(buf, "> %s %s\n", req.Method, redactURL(req.URL))
+	d.dumpHeader(buf, ">", req.Header)
+
+	// The request body is only previewed if it can be read again,
+	// which is the case for everything but streamed uploads.
+	if d.maxBody > 0 && req.Body != nil && req.Body != http.NoBody {
+		if req.GetBody == nil {
+			fmt.Fprintln(buf, ">")
+			fmt.Fprintln(buf, "> [streamed body not shown]")
+		} else if body, err := req.GetBody(); err == nil {
+			preview, rest, _ := d.preview(body)
+			_ = body.Close()
+
+			d.dumpBody(buf, ">", preview, rest)
+
+			// The body of a seekable upload shares its reader with the preview,
+			// so it is rewound for sending.
+			req.Body, err = req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	start := time.Now()
+	resp, err := client.Do(req)
+	elapsed := time.Since(start).Round(time.Millisecond)
+
+	if err != nil {
+		fmt.Fprintf(buf, "< error after %s: %s\n\n", elapsed, redactError(err))
+		d.write(buf)
+
+		return resp, err
+	}
+
+	fmt.Fprintf(buf, "< %s %s (%s)\n", resp.Proto, resp.Status, elapsed)
+	d.dumpHeader(buf, "<", resp.Header)
+
+	// The previewed part of the response body is put back in front of the rest of it.
+	if d.maxBody > 0 && resp.Body != nil && resp.Body != http.NoBody {
+		preview, rest, perr := d.preview(resp.Body)
+		resp.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(preview), resp.Body), resp.Body}
+
+		if len(preview) > 0 || perr == nil {
+			d.dumpBody(buf, "<", preview, rest)
+		}
+	}
+
+	fmt.Fprintln(buf)
+	d.write(buf)
+
+	return resp, nil
+}
+
+// preview reads up to maxBody bytes of the body, and reports whether there is more.
+func (d *dumper) preview(body io.Reader) ([]byte, bool, error) {
+	b, err := io.ReadAll(io.LimitReader(body, int64(d.maxBody)+1))
+	if len(b) > d.maxBody {
+		return b, true, err
+	}
+
+	return b, false, err
+}
+
+// dumpHeader writes the header, sorted by name, with the credentials redacted.
+func (d *dumper) dumpHeader(buf *bytes.Buffer, prefix string, h http.Header) {
+	h = redactHeader(h)
+
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		for _, v := range h[k] {
+			fmt.Fprintf(buf, "%s %s: %s\n", prefix, k, v)
+		}
+	}
+}
+
+// dumpBody writes the preview of a body, with its secrets redacted.
+func (d *dumper) dumpBody(buf *bytes.Buffer, prefix string, preview []byte, truncated bool) {
+	if len(preview) == 0 {
+		return
+	}
+
+	if truncated {
+		preview = preview[:d.maxBody]
+	}
+
+	fmt.Fprintln(buf, prefix)
+
+	if !isText(preview, truncated) {
+		fmt.Fprintf(buf, "%s [binary body not shown]\n", prefix)
+		return
+	}
+
+	for _, line := range strings.Split(redactBody(string(preview)), "\n") {
+		fmt.Fprintf(buf, "%s %s\n", prefix, line)
+	}
+
+	if truncated {
+		fmt.Fprintf(buf, "%s [truncated at %d bytes]\n", prefix, d.maxBody)
+	}
+}
+
+// isText reports whether the preview of a body is text, ignoring a character
+// cut by the end of a truncated preview.
+func isText(b []byte, truncated bool) bool {
+	if bytes.IndexByte(b, 0) >= 0 {
+		return false
+	}
+
+	if truncated {
+		for i := 1; i < utf8.UTFMax && len(b) > 0 && !utf8.Valid(b); i++ {
+			b = b[:len(b)-1]
+		}
+	}
+
+	return utf8.Valid(b)
+}
+
+// write writes the dump of a request at once, so concurrent requests don't interleave.
+func (d *dumper) write(buf *bytes.Buffer) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	_, _ = d.w.Write(buf.Bytes())
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// maxDecodeSnippet is the maximum length of the body kept in a DecodeError.
+const maxDecodeSnippet = 1024
+
+// DecodeMode controls how JSON response bodies are decoded.
+type DecodeMode int
+
+const (
+	// DecodeLenient ignores response bodies that can't be decoded,
+	// since the API doesn't always return JSON. This is the default.
+	DecodeLenient DecodeMode = iota
+
+	// DecodeStrict returns a *DecodeError if a response body can't be decoded.
+	DecodeStrict
+
+	// DecodeStrictUnknownFields is like DecodeStrict, and also returns a
+	// *DecodeError if a response body has fields the Go type doesn't have.
+	DecodeStrictUnknownFields
+)
+
+// DecodeError reports a response body that couldn't be decoded.
+type DecodeError struct {
+	// Response is the response the body was read from.
+	Response *http.Response
+
+	// Type is the Go type the body was decoded into.
+	Type string
+
+	// Body is the start of the body, up to 1024 bytes.
+	Body string
+
+	Err error
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("decoding %v %v response into %s: %v: %q",
+		e.Response.Request.Method, e.Response.Request.URL, e.Type, e.Err, e.Body)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// WithDecodeMode sets how the Client decodes response bodies.
+func WithDecodeMode(mode DecodeMode) Option {
+	return func(o *options) error {
+		o.decodeMode = mode
+		return nil
+	}
+}
+
+// decodeModeContextKey is the context key of the DecodeMode of a call.
+type decodeModeContextKey struct{}
+
+// ContextWithDecodeMode returns a context overriding the DecodeMode of the
+// Client for the calls made with it.
+func ContextWithDecodeMode(ctx context.Context, mode DecodeMode) context.Context {
+	return context.WithValue(ctx, decodeModeContextKey{}, mode)
+}
+
+// decode unmarshals the response body into v, as set by the DecodeMode
+// of the call or of the Client.
+func (c *Client) decode(ctx context.Context, resp *http.Response, body []byte, v interface{}) error {
+	mode := c.decodeMode
+	if m, ok := ctx.Value(decodeModeContextKey{}).(DecodeMode); ok {
+		mode = m
+	}
+
+	if mode == DecodeLenient {
+		_ = json.Unmarshal(body, v)
+		return nil
+	}
+
+	// There is nothing to decode in an empty response.
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil
+	}
+
+	// String targets get the body as is, like the plain text response to a
+	// ping or the JSON one to an upload, unless the body is a JSON string.
+	if s, ok := v.(*string); ok {
+		if err := json.Unmarshal(body, s); err != nil {
+			*s = string(body)
+		}
+		return nil
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(body))
+	if mode == DecodeStrictUnknownFields {
+		dec.DisallowUnknownFields()
+	}
+
+	err := dec.Decode(v)
+	if err == nil {
+		return nil
+	}
+
+	snippet := string(body)
+	if len(snippet) > maxDecodeSnippet {
+		snippet = snippet[:maxDecodeSnippet]
+	}
+
+	return &DecodeError{
+		Response: resp,
+		Type:     fmt.Sprintf("%T", v),
+		Body:     snippet,
+		Err:      err,
+	}
+}
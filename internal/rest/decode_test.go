@@ -0,0 +1,161 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/franela/goblin"
+)
+
+// decodeTarget is the Go type the test responses are decoded into.
+type decodeTarget struct {
+	Name *string `json:"name,omitempty"`
+}
+
+func Test_Decode(t *testing.T) {
+	// Create http test server returning a different body for every path
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/valid":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{"name":"readers"}`)
+		case "/unknown":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{"name":"readers","realm":"ldap"}`)
+		case "/invalid":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{"name":["readers"]}`)
+		case "/large":
+			w.Header().Set("Content-Type", "text/html")
+			fmt.Fprint(w, "<html>"+strings.Repeat("x", 2000))
+		case "/created":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"repo":"libs-local","path":"/a/b.txt"}`)
+		case "/quoted":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `"OK"`)
+		case "/text":
+			w.Header().Set("Content-Type", "text/plain")
+			fmt.Fprint(w, "OK")
+		case "/empty":
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+
+	// Create the clients to interact with the http test server
+	lenient, _ := New(s.URL, nil, testConfig)
+	strict, _ := New(s.URL, nil, testConfig, WithDecodeMode(DecodeStrict))
+	unknown, _ := New(s.URL, nil, testConfig, WithDecodeMode(DecodeStrictUnknownFields))
+
+	g := goblin.Goblin(t)
+	g.Describe("Decode", func() {
+		// Close http test server after we're done using it
+		g.After(func() {
+			s.Close()
+		})
+
+		g.It("- should ignore invalid bodies by default", func() {
+			_, err := lenient.Call("GET", "/invalid", nil, new(decodeTarget))
+
+			g.Assert(err == nil).IsTrue()
+		})
+
+		g.It("- should return a decode error in strict mode", func() {
+			v := new(decodeTarget)
+			resp, err := strict.Call("GET", "/invalid", nil, v)
+
+			dErr := new(DecodeError)
+			g.Assert(errors.As(err, &dErr)).IsTrue()
+			g.Assert(dErr.Type).Equal("*rest.decodeTarget")
+			g.Assert(dErr.Body).Equal(`{"name":["readers"]}`)
+			g.Assert(resp.StatusCode).Equal(200)
+		})
+
+		g.It("- should keep the start of a large body", func() {
+			_, err := strict.Call("GET", "/large", nil, new(decodeTarget))
+
+			dErr := new(DecodeError)
+			g.Assert(errors.As(err, &dErr)).IsTrue()
+			g.Assert(len(dErr.Body)).Equal(1024)
+			g.Assert(strings.HasPrefix(dErr.Body, "<html>")).IsTrue()
+		})
+
+		g.It("- should set strict mode for a call", func() {
+			ctx := ContextWithDecodeMode(context.Background(), DecodeStrict)
+			_, err := lenient.CallContext(ctx, "GET", "/invalid", nil, new(decodeTarget))
+
+			g.Assert(err != nil).IsTrue()
+		})
+
+		g.It("- should set lenient mode for a call", func() {
+			ctx := ContextWithDecodeMode(context.Background(), DecodeLenient)
+			_, err := strict.CallContext(ctx, "GET", "/invalid", nil, new(decodeTarget))
+
+			g.Assert(err == nil).IsTrue()
+		})
+
+		g.It("- should only flag unknown fields when asked", func() {
+			v := new(decodeTarget)
+			_, err := strict.Call("GET", "/unknown", nil, v)
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(*v.Name).Equal("readers")
+
+			_, err = unknown.Call("GET", "/unknown", nil, new(decodeTarget))
+
+			dErr := new(DecodeError)
+			g.Assert(errors.As(err, &dErr)).IsTrue()
+			g.Assert(strings.Contains(dErr.Err.Error(), "realm")).IsTrue()
+
+			_, err = unknown.Call("GET", "/valid", nil, new(decodeTarget))
+			g.Assert(err == nil).IsTrue()
+		})
+
+		g.It("- should keep plain text responses in strict mode", func() {
+			v := new(string)
+			_, err := strict.Call("GET", "/text", nil, v)
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(*v).Equal("OK")
+		})
+
+		g.It("- should keep JSON responses as is in strings in strict mode", func() {
+			v := new(string)
+			_, err := strict.Call("PUT", "/created", nil, v)
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(*v).Equal(`{"repo":"libs-local","path":"/a/b.txt"}`)
+
+			_, err = strict.Call("GET", "/quoted", nil, v)
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(*v).Equal("OK")
+		})
+
+		g.It("- should accept empty responses in strict mode", func() {
+			_, err := strict.Call("DELETE", "/empty", nil, new(string))
+
+			g.Assert(err == nil).IsTrue()
+		})
+	})
+}
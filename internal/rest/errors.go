@@ -0,0 +1,179 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// maxErrorMessage caps the length of a non-JSON error body kept as the message.
+const maxErrorMessage = 1024
+
+// maxErrorBody caps the size of an error body read into an ErrorResponse.
+const maxErrorBody = 1 << 20
+
+// ErrorDetail represents a single error returned by a JFrog API.
+type ErrorDetail struct {
+	Status  *int    `json:"status,omitempty"`
+	Message *string `json:"message,omitempty"`
+}
+
+func (e ErrorDetail) String() string {
+	return Stringify("", e)
+}
+
+// ErrorResponse reports an error caused by an API request.
+//
+// Artifactory reports errors as {"errors":[{"status":..,"message":..}]},
+// Xray mostly as {"error":..}, and some endpoints use a plain text body.
+// Whichever shape was returned is decoded into Errors or Message.
+//
+// Docs: https://www.jfrog.com/confluence/display/JFROG/Artifactory+REST+API#ArtifactoryRESTAPI-ERRORRESPONSES
+type ErrorResponse struct {
+	// HTTP response that caused this error.
+	Response *http.Response `json:"-"`
+	// HTTP status code of the response.
+	StatusCode int `json:"-"`
+	// Raw body of the response, for endpoints returning more than an error in it.
+	Body []byte `json:"-"`
+
+	Errors  *[]ErrorDetail `json:"errors,omitempty"`
+	Message *string        `json:"error,omitempty"`
+}
+
+func (r *ErrorResponse) Error() string {
+	msg := r.messages()
+	if r.Response == nil || r.Response.Request == nil {
+		return fmt.Sprintf("API call failed: %d %s", r.StatusCode, msg)
+	}
+
+	status := r.Response.Status
+	if len(status) == 0 {
+		status = fmt.Sprintf("%d %s", r.StatusCode, http.StatusText(r.StatusCode))
+	}
+
+	if len(msg) == 0 {
+		return fmt.Sprintf("API call to %s failed: %s", r.Response.Request.URL.String(), status)
+	}
+	return fmt.Sprintf("API call to %s failed: %s: %s", r.Response.Request.URL.String(), status, msg)
+}
+
+// messages joins all messages decoded from the error body.
+func (r *ErrorResponse) messages() string {
+	var msgs []string
+	if r.Message != nil && len(*r.Message) > 0 {
+		msgs = append(msgs, *r.Message)
+	}
+	for _, e := range r.GetErrors() {
+		if e.Message != nil && len(*e.Message) > 0 {
+			msgs = append(msgs, *e.Message)
+		}
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// CheckResponse checks the API response for errors, and returns them if present.
+// A response is considered an error if it has a status code outside the 200 range.
+// The returned error is an *ErrorResponse holding the raw and decoded error body,
+// of which only the first MiB is read. That part of the response body is left
+// readable for callers that want to inspect it further.
+func CheckResponse(r *http.Response) error {
+	if c := r.StatusCode; 200 <= c && c <= 299 {
+		return nil
+	}
+
+	errorResponse := &ErrorResponse{Response: r, StatusCode: r.StatusCode}
+
+	if r.Body != nil {
+		data, err := ioutil.ReadAll(io.LimitReader(r.Body, maxErrorBody))
+		// The original body is closed, which also releases the slots its request holds.
+		_ = r.Body.Close()
+		r.Body = ioutil.NopCloser(bytes.NewBuffer(data))
+		if err == nil && len(data) > 0 {
+			errorResponse.Body = data
+			decodeErrorBody(errorResponse, data)
+		}
+	}
+
+	return errorResponse
+}
+
+// decodeErrorBody fills the error response from the provided body.
+func decodeErrorBody(r *ErrorResponse, data []byte) {
+	// Standard {"errors":[...]} or {"error":"..."} shape.
+	if json.Unmarshal(data, r) == nil && (r.Errors != nil || r.Message != nil) {
+		return
+	}
+	r.Errors, r.Message = nil, nil
+
+	// A single {"status":..,"message":..} object.
+	var detail ErrorDetail
+	if json.Unmarshal(data, &detail) == nil && detail.Message != nil {
+		r.Errors = &[]ErrorDetail{detail}
+		return
+	}
+
+	// Some endpoints return the list of errors as the top level value.
+	var list []ErrorDetail
+	if json.Unmarshal(data, &list) == nil && len(list) > 0 {
+		r.Errors = &list
+		return
+	}
+
+	// Others return a JSON string or plain text.
+	var msg string
+	if json.Unmarshal(data, &msg) != nil {
+		msg = strings.TrimSpace(string(data))
+		if len(msg) > maxErrorMessage {
+			msg = msg[:maxErrorMessage]
+		}
+	}
+	if len(msg) > 0 {
+		r.Message = &msg
+	}
+}
+
+// hasStatus reports whether err is an *ErrorResponse with the provided status code.
+func hasStatus(err error, code int) bool {
+	var r *ErrorResponse
+	return errors.As(err, &r) && r.StatusCode == code
+}
+
+// IsNotFound reports whether err is an *ErrorResponse for a 404 Not Found response.
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// IsUnauthorized reports whether err is an *ErrorResponse for a 401 Unauthorized response.
+func IsUnauthorized(err error) bool {
+	return hasStatus(err, http.StatusUnauthorized)
+}
+
+// IsForbidden reports whether err is an *ErrorResponse for a 403 Forbidden response.
+func IsForbidden(err error) bool {
+	return hasStatus(err, http.StatusForbidden)
+}
+
+// IsConflict reports whether err is an *ErrorResponse for a 409 Conflict response.
+func IsConflict(err error) bool {
+	return hasStatus(err, http.StatusConflict)
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/franela/goblin"
+)
+
+func Test_Errors(t *testing.T) {
+	// Create http test server that replies with the status and body requested in the path
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/errors":
+			w.WriteHeader(404)
+			fmt.Fprint(w, `{"errors":[{"status":404,"message":"Item not found"}]}`)
+		case "/error":
+			w.WriteHeader(409)
+			fmt.Fprint(w, `{"error":"Conflict"}`)
+		case "/list":
+			w.WriteHeader(400)
+			fmt.Fprint(w, `[{"status":400,"message":"Bad repository"}]`)
+		case "/large":
+			w.WriteHeader(500)
+			fmt.Fprint(w, strings.Repeat("x", 2*maxErrorBody))
+		case "/string":
+			w.WriteHeader(401)
+			fmt.Fprint(w, `"Bad credentials"`)
+		default:
+			w.WriteHeader(403)
+			fmt.Fprint(w, "Forbidden\n")
+		}
+	}))
+
+	// Create the client to interact with the http test server
+	c, _ := New(s.URL, nil, testConfig)
+
+	g := goblin.Goblin(t)
+	g.Describe("ErrorResponse", func() {
+		// Close http test server after we're done using it
+		g.After(func() {
+			s.Close()
+		})
+
+		g.It("- should decode the errors list", func() {
+			resp, err := c.Call("GET", "/errors", nil, nil)
+
+			var actual *ErrorResponse
+			g.Assert(errors.As(err, &actual)).IsTrue()
+			g.Assert(actual.Response == resp.Response).IsTrue()
+			g.Assert(actual.StatusCode).Equal(404)
+			g.Assert(actual.GetErrors()[0].GetStatus()).Equal(404)
+			g.Assert(actual.GetErrors()[0].GetMessage()).Equal("Item not found")
+			g.Assert(strings.HasSuffix(err.Error(), "404 Not Found: Item not found")).IsTrue()
+			g.Assert(IsNotFound(err)).IsTrue()
+		})
+
+		g.It("- should decode the error message", func() {
+			_, err := c.Call("GET", "/error", nil, nil)
+
+			var actual *ErrorResponse
+			g.Assert(errors.As(err, &actual)).IsTrue()
+			g.Assert(actual.GetMessage()).Equal("Conflict")
+			g.Assert(IsConflict(err)).IsTrue()
+		})
+
+		g.It("- should decode a top level list of errors", func() {
+			_, err := c.Call("GET", "/list", nil, nil)
+
+			var actual *ErrorResponse
+			g.Assert(errors.As(err, &actual)).IsTrue()
+			g.Assert(actual.GetErrors()[0].GetMessage()).Equal("Bad repository")
+		})
+
+		g.It("- should decode a JSON string", func() {
+			_, err := c.Call("GET", "/string", nil, nil)
+
+			var actual *ErrorResponse
+			g.Assert(errors.As(err, &actual)).IsTrue()
+			g.Assert(actual.GetMessage()).Equal("Bad credentials")
+			g.Assert(IsUnauthorized(err)).IsTrue()
+		})
+
+		g.It("- should keep a plain text body as the message", func() {
+			resp, err := c.Call("GET", "/text", nil, nil)
+
+			var actual *ErrorResponse
+			g.Assert(errors.As(err, &actual)).IsTrue()
+			g.Assert(actual.GetMessage()).Equal("Forbidden")
+			g.Assert(IsForbidden(err)).IsTrue()
+
+			// the body is still readable for the caller
+			body, _ := ioutil.ReadAll(resp.Body)
+			g.Assert(string(body)).Equal("Forbidden\n")
+		})
+
+		g.It("- should keep the raw body", func() {
+			resp, err := c.Call("GET", "/errors", nil, nil)
+			resp.Body.Close()
+
+			var actual *ErrorResponse
+			g.Assert(errors.As(err, &actual)).IsTrue()
+			g.Assert(string(actual.Body)).Equal(`{"errors":[{"status":404,"message":"Item not found"}]}`)
+		})
+
+		g.It("- should cap the size of the body", func() {
+			resp, err := c.Call("GET", "/large", nil, nil)
+
+			var actual *ErrorResponse
+			g.Assert(errors.As(err, &actual)).IsTrue()
+			g.Assert(len(actual.Body)).Equal(maxErrorBody)
+
+			body, _ := ioutil.ReadAll(resp.Body)
+			g.Assert(len(body)).Equal(maxErrorBody)
+		})
+
+		g.It("- should match wrapped errors", func() {
+			_, err := c.Call("GET", "/errors", nil, nil)
+
+			wrapped := fmt.Errorf("cleanup failed: %w", err)
+
+			g.Assert(IsNotFound(wrapped)).IsTrue()
+			g.Assert(IsConflict(wrapped)).IsFalse()
+			g.Assert(IsNotFound(errors.New("404"))).IsFalse()
+			g.Assert(IsNotFound(nil)).IsFalse()
+		})
+	})
+}
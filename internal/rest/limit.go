@@ -0,0 +1,241 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// EndpointClass represents a class of API endpoints limited together.
+type EndpointClass string
+
+// Limit represents the rate and the concurrency requests are limited to.
+// Requests exceeding the limit block until they are allowed, or until
+// their context is done.
+type Limit struct {
+	// Rate is the number of requests per second, or unlimited if 0.
+	Rate float64
+
+	// Burst is the number of requests sent at once before they are limited to Rate.
+	// Defaults to 1.
+	Burst int
+
+	// MaxInFlight is the number of requests sent concurrently, until their
+	// response body is closed, or unlimited if 0.
+	MaxInFlight int
+}
+
+// WithLimit limits all requests sent by the Client, including retries.
+func WithLimit(limit Limit) Option {
+	return func(o *options) error {
+		l, err := newLimiter(limit)
+		if err != nil {
+			return err
+		}
+
+		o.limiter = l
+		return nil
+	}
+}
+
+// WithEndpointLimit limits the requests sent by the Client to a class of endpoints,
+// in addition to the limit set with WithLimit.
+func WithEndpointLimit(class EndpointClass, limit Limit) Option {
+	return func(o *options) error {
+		l, err := newLimiter(limit)
+		if err != nil {
+			return err
+		}
+
+		if o.limiters == nil {
+			o.limiters = make(map[EndpointClass]*limiter)
+		}
+
+		o.limiters[class] = l
+		return nil
+	}
+}
+
+// limiter is a token bucket limiting the rate of requests,
+// along with a semaphore limiting the requests in flight.
+type limiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+	slots  chan struct{}
+}
+
+// newLimiter returns a limiter enforcing the provided limit.
+func newLimiter(limit Limit) (*limiter, error) {
+	if limit.Rate < 0 || limit.Burst < 0 || limit.MaxInFlight < 0 {
+		return nil, fmt.Errorf("invalid limit %+v", limit)
+	}
+
+	burst := limit.Burst
+	if burst == 0 {
+		burst = 1
+	}
+
+	l := &limiter{
+		rate:   limit.Rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+
+	if limit.MaxInFlight > 0 {
+		l.slots = make(chan struct{}, limit.MaxInFlight)
+	}
+
+	return l, nil
+}
+
+// wait blocks until a request is allowed by the rate limit.
+func (l *limiter) wait(ctx context.Context) error {
+	if l.rate <= 0 {
+		return nil
+	}
+
+	// A token is reserved right away, so concurrent requests wait in turn.
+	l.mu.Lock()
+	now := time.Now()
+	l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	l.tokens--
+	wait := time.Duration(-l.tokens / l.rate * float64(time.Second))
+	l.mu.Unlock()
+
+	err := Sleep(ctx, wait)
+	if err != nil {
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+	}
+
+	return err
+}
+
+// acquire blocks until a request is allowed by the limiter,
+// and returns the function releasing its slot once it is done.
+func (l *limiter) acquire(ctx context.Context) (func(), error) {
+	err := l.wait(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if l.slots == nil {
+		return func() {}, nil
+	}
+
+	select {
+	case l.slots <- struct{}{}:
+		return func() { <-l.slots }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// limitersFor returns the limiters of the request, global first.
+func (c *Client) limitersFor(req *http.Request) []*limiter {
+	var limiters []*limiter
+	if c.limiter != nil {
+		limiters = append(limiters, c.limiter)
+	}
+
+	if len(c.limiters) == 0 {
+		return limiters
+	}
+
+	path := c.endpointPath(req)
+	for class, prefixes := range c.config.EndpointClasses {
+		for _, prefix := range prefixes {
+			if l, ok := c.limiters[class]; ok && strings.HasPrefix(path, prefix) {
+				limiters = append(limiters, l)
+			}
+		}
+	}
+
+	return limiters
+}
+
+// endpointPath returns the path of the request relative to the node it is
+// sent to, or to the base URL of the Client, with a leading slash.
+func (c *Client) endpointPath(req *http.Request) string {
+	bases := []*url.URL{c.baseURL}
+	if c.nodes != nil {
+		for _, n := range c.nodes.nodes {
+			bases = append(bases, n.url)
+		}
+	}
+
+	u := *req.URL
+	u.RawQuery, u.Fragment = "", ""
+
+	for _, base := range bases {
+		if rel, ok := relativeURL(base, &u); ok {
+			return "/" + rel
+		}
+	}
+
+	return req.URL.Path
+}
+
+// limit blocks until the request is allowed by the limiters of the Client,
+// and returns the function releasing their slots once it is done.
+func (c *Client) limit(req *http.Request) (func(), error) {
+	var releases []func()
+	release := func() {
+		for i := len(releases) - 1; i >= 0; i-- {
+			releases[i]()
+		}
+	}
+
+	for _, l := range c.limitersFor(req) {
+		r, err := l.acquire(req.Context())
+		if err != nil {
+			release()
+			return nil, err
+		}
+
+		releases = append(releases, r)
+	}
+
+	return release, nil
+}
+
+// releasingBody calls release once the response body is closed.
+type releasingBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+// Close closes the body, and releases the slots of its request.
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+
+	return err
+}
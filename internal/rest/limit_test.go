@@ -0,0 +1,210 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/franela/goblin"
+)
+
+func Test_Limit(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		inFlight int
+		peak     int
+		barrier  int
+	)
+
+	// Create http test server recording the requests in flight. The requests
+	// wait until barrier of them were in flight together, so that the peak
+	// doesn't depend on how fast they are sent.
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		if inFlight > peak {
+			peak = inFlight
+		}
+		mu.Unlock()
+
+		for deadline := time.Now().Add(time.Second); time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+			mu.Lock()
+			arrived := peak >= barrier
+			mu.Unlock()
+
+			if arrived {
+				break
+			}
+		}
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+
+		if r.URL.Path == "/api/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"errors":[{"status":404,"message":"Not Found"}]}`))
+			return
+		}
+
+		w.Write([]byte(`{}`))
+	}))
+
+	g := goblin.Goblin(t)
+	g.Describe("Limit", func() {
+		g.BeforeEach(func() {
+			inFlight, peak, barrier = 0, 0, 0
+		})
+
+		// Close http test server after we're done using it
+		g.After(func() {
+			s.Close()
+		})
+
+		// timed returns how long it takes to send n requests to u concurrently.
+		timed := func(c *Client, u string, n int) time.Duration {
+			start := time.Now()
+
+			var wg sync.WaitGroup
+			for i := 0; i < n; i++ {
+				wg.Add(1)
+
+				go func() {
+					defer wg.Done()
+					_, _ = c.Call("GET", u, nil, nil)
+				}()
+			}
+			wg.Wait()
+
+			return time.Since(start)
+		}
+
+		g.It("- should limit the rate of requests", func() {
+			c, _ := New(s.URL, nil, testConfig, WithLimit(Limit{Rate: 20}))
+
+			g.Assert(timed(c, "/api/users", 5) >= 190*time.Millisecond).IsTrue()
+		})
+
+		g.It("- should allow bursts of requests", func() {
+			c, _ := New(s.URL, nil, testConfig, WithLimit(Limit{Rate: 1, Burst: 5}))
+
+			barrier = 5
+
+			g.Assert(timed(c, "/api/users", 5) < 500*time.Millisecond).IsTrue()
+			g.Assert(peak).Equal(5)
+		})
+
+		g.It("- should limit the requests in flight", func() {
+			c, _ := New(s.URL, nil, testConfig, WithLimit(Limit{MaxInFlight: 2}))
+
+			barrier = 2
+
+			timed(c, "/api/users", 6)
+
+			g.Assert(peak).Equal(2)
+		})
+
+		g.It("- should limit the requests to a class of endpoints", func() {
+			c, _ := New(s.URL, nil, testConfig, WithEndpointLimit("search", Limit{MaxInFlight: 1}))
+
+			barrier = 4
+
+			timed(c, "/api/users", 4)
+			g.Assert(peak).Equal(4)
+
+			peak, barrier = 0, 0
+			timed(c, "/api/search/gavc?g=org.acme", 4)
+			g.Assert(peak).Equal(1)
+		})
+
+		g.It("- should classify the requests sent to another node", func() {
+			c, _ := New(s.URL+"/artifactory", nil, testConfig,
+				WithNodes(RoundRobin, s.URL+"/node/artifactory"),
+				WithEndpointLimit("search", Limit{MaxInFlight: 1}),
+			)
+
+			req, _ := c.NewRequest("GET", "/api/search/gavc?g=org.acme", nil)
+			g.Assert(len(c.limitersFor(req))).Equal(1)
+
+			err := rebaseURL(req, c.nodes.nodes[1], "api/search/gavc?g=org.acme")
+			g.Assert(err == nil).IsTrue()
+			g.Assert(len(c.limitersFor(req))).Equal(1)
+		})
+
+		g.It("- should hold a slot until the body is closed", func() {
+			c, _ := New(s.URL, nil, testConfig, WithLimit(Limit{MaxInFlight: 1}))
+
+			req, _ := c.NewRequest("GET", "/files/file.txt", nil)
+
+			resp, err := Send(context.Background(), c, req)
+			g.Assert(err == nil).IsTrue()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+
+			_, err = c.CallContext(ctx, "GET", "/api/users", nil, nil)
+			g.Assert(err).Equal(context.DeadlineExceeded)
+
+			resp.Body.Close()
+
+			_, err = c.Call("GET", "/api/users", nil, nil)
+			g.Assert(err == nil).IsTrue()
+		})
+
+		g.It("- should release the slots of error responses", func() {
+			c, _ := New(s.URL, nil, testConfig, WithLimit(Limit{MaxInFlight: 2}))
+
+			for i := 0; i < 3; i++ {
+				_, err := c.Call("GET", "/api/missing", nil, nil)
+				g.Assert(IsNotFound(err)).IsTrue()
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			_, err := c.CallContext(ctx, "GET", "/api/users", nil, nil)
+			g.Assert(err == nil).IsTrue()
+		})
+
+		g.It("- should stop waiting when the context is done", func() {
+			c, _ := New(s.URL, nil, testConfig, WithLimit(Limit{Rate: 0.1}))
+
+			_, err := c.Call("GET", "/api/users", nil, nil)
+			g.Assert(err == nil).IsTrue()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+
+			start := time.Now()
+			_, err = c.CallContext(ctx, "GET", "/api/users", nil, nil)
+
+			g.Assert(err).Equal(context.DeadlineExceeded)
+			g.Assert(time.Since(start) < time.Second).IsTrue()
+		})
+
+		g.It("- should fail with an invalid limit", func() {
+			_, err := New(s.URL, nil, testConfig, WithLimit(Limit{Rate: -1}))
+			g.Assert(err != nil).IsTrue()
+
+			_, err = New(s.URL, nil, testConfig, WithEndpointLimit("storage", Limit{Rate: 1}))
+			g.Assert(err != nil).IsTrue()
+		})
+	})
+}
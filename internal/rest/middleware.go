@@ -0,0 +1,190 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// RequestInfo describes a request sent by the Client, as seen by a Middleware.
+type RequestInfo struct {
+	// Operation is the service method sending the request, like "Repositories.GetAll".
+	// It is empty for requests sent with Call or Do.
+	Operation string
+
+	// Method is the HTTP method of the request.
+	Method string
+
+	// Path is the templated path of the operation, like "/api/repositories/{repo}",
+	// or the path of the request URL for requests sent with Call or Do.
+	Path string
+
+	// Request is the request being sent.
+	Request *http.Request
+
+	// StatusCode is the status code of the response, or 0 if there is none.
+	// It is set once the request is done.
+	StatusCode int
+
+	// Duration is the time from sending the request, including its retries,
+	// to receiving the response headers. It is set once the request is done.
+	Duration time.Duration
+
+	// Err is the error the request failed with, if any.
+	// It is set once the request is done.
+	Err error
+}
+
+// Middleware observes the requests sent by a Client.
+type Middleware interface {
+	// BeforeRequest is called before a request is sent. The returned context
+	// is used to send the request, and is passed to AfterResponse.
+	BeforeRequest(ctx context.Context, info *RequestInfo) context.Context
+
+	// AfterResponse is called once the request is done, after its retries.
+	AfterResponse(ctx context.Context, info *RequestInfo)
+}
+
+// Hooks is a Middleware calling the provided functions, if set.
+type Hooks struct {
+	Before func(ctx context.Context, info *RequestInfo) context.Context
+	After  func(ctx context.Context, info *RequestInfo)
+}
+
+// BeforeRequest calls the Before hook, if set.
+func (h Hooks) BeforeRequest(ctx context.Context, info *RequestInfo) context.Context {
+	if h.Before == nil {
+		return ctx
+	}
+
+	return h.Before(ctx, info)
+}
+
+// AfterResponse calls the After hook, if set.
+func (h Hooks) AfterResponse(ctx context.Context, info *RequestInfo) {
+	if h.After != nil {
+		h.After(ctx, info)
+	}
+}
+
+// WithMiddleware adds the provided middlewares to the Client.
+// BeforeRequest is called in the order the middlewares are provided,
+// and AfterResponse in the reverse order.
+func WithMiddleware(middlewares ...Middleware) Option {
+	return func(o *options) error {
+		o.middlewares = append(o.middlewares, middlewares...)
+		return nil
+	}
+}
+
+// operation is the service method sending a request.
+type operation struct {
+	name string
+	path string
+}
+
+// operationContextKey is the context key of the operation sending a request.
+type operationContextKey struct{}
+
+// WithOperation returns a context naming the operation sending the requests made with it.
+func WithOperation(ctx context.Context, name, path string) context.Context {
+	return context.WithValue(ctx, operationContextKey{}, operation{name: name, path: path})
+}
+
+// observe sends the request, calling the middlewares of the Client around it.
+func (c *Client) observe(ctx context.Context, req *http.Request, send func(context.Context, *http.Request) (*http.Response, error)) (*http.Response, error) {
+	info := &RequestInfo{
+		Method:  req.Method,
+		Path:    req.URL.Path,
+		Request: req,
+	}
+
+	if op, ok := ctx.Value(operationContextKey{}).(operation); ok {
+		info.Operation, info.Path = op.name, op.path
+	}
+
+	for _, m := range c.middlewares {
+		ctx = m.BeforeRequest(ctx, info)
+	}
+
+	req = req.WithContext(ctx)
+	info.Request = req
+
+	start := time.Now()
+	resp, err := send(ctx, req)
+
+	info.Duration = time.Since(start)
+	info.Err = err
+	if resp != nil {
+		info.StatusCode = resp.StatusCode
+	}
+
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		c.middlewares[i].AfterResponse(ctx, info)
+	}
+
+	return resp, err
+}
+
+// NewLoggingMiddleware returns a Middleware logging every request to the provided logger.
+// Requests are logged at the Info level, or at the Error level if they failed.
+// Secrets in the URL and the error of a request are redacted.
+func NewLoggingMiddleware(logger *slog.Logger) Middleware {
+	return Hooks{
+		After: func(ctx context.Context, info *RequestInfo) {
+			level := slog.LevelInfo
+			attrs := []slog.Attr{
+				slog.String("operation", info.Operation),
+				slog.String("method", info.Method),
+				slog.String("path", info.Path),
+				slog.String("url", redactURL(info.Request.URL)),
+				slog.Int("status", info.StatusCode),
+				slog.Duration("duration", info.Duration),
+			}
+
+			if info.Err != nil {
+				level = slog.LevelError
+				attrs = append(attrs, slog.String("error", redactError(info.Err)))
+			}
+
+			logger.LogAttrs(ctx, level, "request", attrs...)
+		},
+	}
+}
+
+// Metrics records the latency and the errors of the requests sent by a Client.
+type Metrics interface {
+	// ObserveLatency records the duration of a request.
+	ObserveLatency(operation, method, path string, status int, d time.Duration)
+
+	// IncErrors counts a request that failed.
+	IncErrors(operation, method, path string, status int)
+}
+
+// NewMetricsMiddleware returns a Middleware recording every request in the provided Metrics.
+func NewMetricsMiddleware(m Metrics) Middleware {
+	return Hooks{
+		After: func(ctx context.Context, info *RequestInfo) {
+			m.ObserveLatency(info.Operation, info.Method, info.Path, info.StatusCode, info.Duration)
+
+			if info.Err != nil {
+				m.IncErrors(info.Operation, info.Method, info.Path, info.StatusCode)
+			}
+		},
+	}
+}
@@ -0,0 +1,230 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/franela/goblin"
+)
+
+// recordingMiddleware records the hooks called on it.
+type recordingMiddleware struct {
+	name  string
+	calls *[]string
+	infos []RequestInfo
+}
+
+type middlewareKey struct{}
+
+func (m *recordingMiddleware) BeforeRequest(ctx context.Context, info *RequestInfo) context.Context {
+	*m.calls = append(*m.calls, m.name+" before")
+	info.Request.Header.Set("X-Trace-"+m.name, "traced")
+
+	return context.WithValue(ctx, middlewareKey{}, m.name)
+}
+
+func (m *recordingMiddleware) AfterResponse(ctx context.Context, info *RequestInfo) {
+	*m.calls = append(*m.calls, m.name+" after "+ctx.Value(middlewareKey{}).(string))
+	m.infos = append(m.infos, *info)
+}
+
+// fakeMetrics records the observations of the metrics middleware.
+type fakeMetrics struct {
+	latencies []string
+	errors    []string
+}
+
+func (m *fakeMetrics) ObserveLatency(operation, method, path string, status int, d time.Duration) {
+	m.latencies = append(m.latencies, strings.Join([]string{operation, method, path, http.StatusText(status)}, " "))
+}
+
+func (m *fakeMetrics) IncErrors(operation, method, path string, status int) {
+	m.errors = append(m.errors, strings.Join([]string{operation, method, path, http.StatusText(status)}, " "))
+}
+
+func Test_Middleware(t *testing.T) {
+	var (
+		header   http.Header
+		attempts int
+	)
+
+	// Create http test server that fails requests to missing users
+	// and the first attempt of flaky requests
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header
+		attempts++
+
+		switch {
+		case strings.Contains(r.URL.Path, "missing"):
+			w.WriteHeader(http.StatusNotFound)
+		case strings.Contains(r.URL.Path, "flaky") && attempts == 1:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			w.Write([]byte(`{"name":"reader"}`))
+		}
+	}))
+
+	// getUser and deleteUser send the requests of the Users.Get and Users.Delete operations.
+	getUser := func(c *Client, user string) error {
+		ctx := WithOperation(context.Background(), "Users.Get", "/api/users/{user}")
+		_, err := c.CallContext(ctx, "GET", "/api/users/"+user, nil, nil)
+
+		return err
+	}
+
+	deleteUser := func(c *Client, user string) error {
+		ctx := WithOperation(context.Background(), "Users.Delete", "/api/users/{user}")
+		_, err := c.CallContext(ctx, "DELETE", "/api/users/"+user, nil, nil)
+
+		return err
+	}
+
+	g := goblin.Goblin(t)
+	g.Describe("Middleware", func() {
+		var (
+			calls []string
+			a, b  *recordingMiddleware
+			c     *Client
+		)
+
+		g.BeforeEach(func() {
+			calls, attempts = nil, 0
+			a = &recordingMiddleware{name: "a", calls: &calls}
+			b = &recordingMiddleware{name: "b", calls: &calls}
+
+			c, _ = New(s.URL, nil, testConfig, WithMiddleware(a, b))
+		})
+
+		// Close http test server after we're done using it
+		g.After(func() {
+			s.Close()
+		})
+
+		g.It("- should call the hooks in order", func() {
+			err := getUser(c, "reader")
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(calls).Equal([]string{"a before", "b before", "b after b", "a after b"})
+			g.Assert(header.Get("X-Trace-a")).Equal("traced")
+			g.Assert(header.Get("X-Trace-b")).Equal("traced")
+		})
+
+		g.It("- should describe the operation", func() {
+			err := getUser(c, "reader")
+
+			g.Assert(err == nil).IsTrue()
+
+			info := a.infos[0]
+			g.Assert(info.Operation).Equal("Users.Get")
+			g.Assert(info.Method).Equal("GET")
+			g.Assert(info.Path).Equal("/api/users/{user}")
+			g.Assert(info.StatusCode).Equal(200)
+			g.Assert(info.Duration > 0).IsTrue()
+			g.Assert(info.Err == nil).IsTrue()
+		})
+
+		g.It("- should describe requests sent without an operation", func() {
+			_, err := c.Call("GET", "/api/users/reader", nil, nil)
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(a.infos[0].Operation).Equal("")
+			g.Assert(a.infos[0].Path).Equal("/api/users/reader")
+		})
+
+		g.It("- should describe failed requests", func() {
+			err := getUser(c, "missing")
+
+			g.Assert(IsNotFound(err)).IsTrue()
+			g.Assert(a.infos[0].StatusCode).Equal(404)
+			g.Assert(IsNotFound(a.infos[0].Err)).IsTrue()
+		})
+
+		g.It("- should observe a retried request once", func() {
+			c.RetryPolicy = &RetryPolicy{MaxAttempts: 2, RetryableStatuses: []int{503}}
+
+			err := getUser(c, "flaky")
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(attempts).Equal(2)
+			g.Assert(len(a.infos)).Equal(1)
+			g.Assert(a.infos[0].StatusCode).Equal(200)
+		})
+
+		g.It("- should log requests with redacted secrets", func() {
+			buf := new(bytes.Buffer)
+			c, _ := New(s.URL, nil, testConfig, WithMiddleware(NewLoggingMiddleware(slog.New(slog.NewJSONHandler(buf, nil)))))
+
+			err := getUser(c, "reader")
+			g.Assert(err == nil).IsTrue()
+
+			_, err = c.Call("GET", "/api/missing?password=hunter2", nil, nil)
+			g.Assert(err != nil).IsTrue()
+
+			lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+			g.Assert(len(lines)).Equal(2)
+
+			var ok, failed map[string]interface{}
+			_ = json.Unmarshal([]byte(lines[0]), &ok)
+			_ = json.Unmarshal([]byte(lines[1]), &failed)
+
+			g.Assert(ok["level"]).Equal("INFO")
+			g.Assert(ok["operation"]).Equal("Users.Get")
+			g.Assert(ok["path"]).Equal("/api/users/{user}")
+			g.Assert(ok["status"]).Equal(float64(200))
+
+			g.Assert(failed["level"]).Equal("ERROR")
+			g.Assert(failed["status"]).Equal(float64(404))
+			g.Assert(strings.Contains(lines[1], "hunter2")).IsFalse()
+			g.Assert(strings.Contains(failed["url"].(string), "password=REDACTED")).IsTrue()
+		})
+
+		g.It("- should redact secrets from connection errors", func() {
+			dead := httptest.NewServer(http.NotFoundHandler())
+			dead.Close()
+
+			buf := new(bytes.Buffer)
+			c, _ := New(dead.URL, nil, testConfig, WithMiddleware(NewLoggingMiddleware(slog.New(slog.NewJSONHandler(buf, nil)))))
+
+			_, err := c.Call("GET", "/api/ping?token=hunter2", nil, nil)
+
+			g.Assert(err != nil).IsTrue()
+			g.Assert(strings.Contains(buf.String(), "hunter2")).IsFalse()
+			g.Assert(strings.Contains(buf.String(), "token=REDACTED")).IsTrue()
+		})
+
+		g.It("- should record metrics", func() {
+			m := new(fakeMetrics)
+			c, _ := New(s.URL, nil, testConfig, WithMiddleware(NewMetricsMiddleware(m)))
+
+			_ = getUser(c, "reader")
+			_ = deleteUser(c, "missing")
+
+			g.Assert(m.latencies).Equal([]string{
+				"Users.Get GET /api/users/{user} OK",
+				"Users.Delete DELETE /api/users/{user} Not Found",
+			})
+			g.Assert(m.errors).Equal([]string{"Users.Delete DELETE /api/users/{user} Not Found"})
+		})
+	})
+}
@@ -0,0 +1,288 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultNodeCooldown is how long a failed node is avoided before it is checked again.
+const defaultNodeCooldown = 30 * time.Second
+
+// FailoverMode is how requests are spread across the nodes of a Client.
+type FailoverMode int
+
+const (
+	// RoundRobin spreads requests evenly across the healthy nodes.
+	RoundRobin FailoverMode = iota
+
+	// PrimarySecondary sends requests to the first healthy node, in the order
+	// the nodes were provided.
+	PrimarySecondary
+)
+
+// NodeStatus represents the health of a node as tracked by the Client.
+type NodeStatus struct {
+	URL     string
+	Healthy bool
+}
+
+// WithNodes spreads requests across several nodes of a high availability cluster.
+// The baseUrl of the Client is the first node, followed by the provided URLs.
+//
+// A node that returns a connection error or a 5xx status is marked unhealthy,
+// and idempotent requests fail over to the next healthy node. An unhealthy node
+// is pinged once its cooldown is over, before it receives requests again.
+func WithNodes(mode FailoverMode, urls ...string) Option {
+	return func(o *options) error {
+		for _, u := range urls {
+			node, err := url.Parse(u)
+			if err != nil {
+				return fmt.Errorf("invalid node URL: %w", err)
+			}
+
+			o.nodes = append(o.nodes, node)
+		}
+
+		o.failoverMode = mode
+		return nil
+	}
+}
+
+// WithNodeCooldown sets how long a failed node is avoided before it is checked again.
+// Defaults to 30 seconds.
+func WithNodeCooldown(cooldown time.Duration) Option {
+	return func(o *options) error {
+		o.nodeCooldown = cooldown
+		return nil
+	}
+}
+
+// node is a base URL requests can be sent to.
+type node struct {
+	url *url.URL
+
+	mu        sync.Mutex
+	healthy   bool
+	downUntil time.Time
+}
+
+// base returns the URL of the node with a trailing slash.
+func (n *node) base() string {
+	u := n.url.String()
+	if !strings.HasSuffix(u, "/") {
+		u += "/"
+	}
+
+	return u
+}
+
+// relativeURL returns the part of u relative to the provided base URL,
+// and false if u is not under it.
+func relativeURL(base, u *url.URL) (string, bool) {
+	prefix := base.String()
+	if !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+
+	s := u.String()
+	if !strings.HasPrefix(s, prefix) {
+		return "", false
+	}
+
+	return strings.TrimPrefix(s, prefix), true
+}
+
+// rebaseURL points the request at the provided node.
+func rebaseURL(req *http.Request, n *node, rel string) error {
+	u, err := url.Parse(n.base() + rel)
+	if err != nil {
+		return err
+	}
+
+	req.URL = u
+	req.Host = u.Host
+
+	return nil
+}
+
+// nodePool tracks the health of the nodes of a Client and picks the node for each request.
+type nodePool struct {
+	mode     FailoverMode
+	cooldown time.Duration
+	nodes    []*node
+
+	mu   sync.Mutex
+	next int
+}
+
+// newNodePool returns a pool of the provided nodes, all assumed healthy.
+func newNodePool(mode FailoverMode, cooldown time.Duration, urls ...*url.URL) *nodePool {
+	if cooldown <= 0 {
+		cooldown = defaultNodeCooldown
+	}
+
+	p := &nodePool{mode: mode, cooldown: cooldown}
+	for _, u := range urls {
+		p.nodes = append(p.nodes, &node{url: u, healthy: true})
+	}
+
+	return p
+}
+
+// nodeContextKey is the context key pinning a request to a node.
+type nodeContextKey struct{}
+
+// withNode returns a context pinning the requests made with it to the provided node.
+func withNode(ctx context.Context, n *node) context.Context {
+	return context.WithValue(ctx, nodeContextKey{}, n)
+}
+
+// order returns the nodes in the order they should be tried for the next request.
+func (p *nodePool) order() []*node {
+	if p.mode == PrimarySecondary {
+		return p.nodes
+	}
+
+	p.mu.Lock()
+	start := p.next
+	p.next = (p.next + 1) % len(p.nodes)
+	p.mu.Unlock()
+
+	return append(append([]*node(nil), p.nodes[start:]...), p.nodes[:start]...)
+}
+
+// pick returns the node to send a request to, skipping the provided nodes.
+// A node whose cooldown is over is checked with a ping before it is picked.
+// If no node is healthy, the first node not skipped is returned anyway.
+func (p *nodePool) pick(ctx context.Context, c *Client, skip map[*node]bool) *node {
+	var fallback *node
+
+	for _, n := range p.order() {
+		if skip[n] {
+			continue
+		}
+
+		if fallback == nil {
+			fallback = n
+		}
+
+		n.mu.Lock()
+		healthy, due := n.healthy, time.Now().After(n.downUntil)
+		n.mu.Unlock()
+
+		if healthy {
+			return n
+		}
+
+		if due && p.check(ctx, c, n) {
+			return n
+		}
+	}
+
+	return fallback
+}
+
+// check pings the provided node and records its health.
+func (p *nodePool) check(ctx context.Context, c *Client, n *node) bool {
+	ctx = WithOperation(withNode(ctx, n), "System.Ping", c.config.HealthPath)
+
+	// The response is written rather than decoded, so it is never cached.
+	_, err := c.CallContext(ctx, "GET", c.config.HealthPath, nil, new(bytes.Buffer))
+
+	// The node responds even if the ping is rejected, e.g. without anonymous access.
+	var errResp *ErrorResponse
+	if err == nil || (errors.As(err, &errResp) && errResp.StatusCode < http.StatusInternalServerError) {
+		p.up(n)
+		return true
+	}
+
+	p.down(n)
+	return false
+}
+
+// up marks the provided node healthy.
+func (p *nodePool) up(n *node) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.healthy = true
+}
+
+// down marks the provided node unhealthy until its cooldown is over.
+func (p *nodePool) down(n *node) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.healthy = false
+	n.downUntil = time.Now().Add(p.cooldown)
+}
+
+// status returns the health of every node.
+func (p *nodePool) status() []NodeStatus {
+	var statuses []NodeStatus
+
+	for _, n := range p.nodes {
+		n.mu.Lock()
+		statuses = append(statuses, NodeStatus{URL: n.url.String(), Healthy: n.healthy})
+		n.mu.Unlock()
+	}
+
+	return statuses
+}
+
+// Nodes returns the health of the nodes of the Client, as of their last request or check.
+// It returns nil if the Client was not created with WithNodes.
+func (c *Client) Nodes() []NodeStatus {
+	if c.nodes == nil {
+		return nil
+	}
+
+	return c.nodes.status()
+}
+
+// CheckNodes pings every node of the Client and returns their health.
+func (c *Client) CheckNodes() []NodeStatus {
+	return c.CheckNodesContext(context.Background())
+}
+
+// CheckNodesContext pings every node of the Client using the provided context and returns their health.
+func (c *Client) CheckNodesContext(ctx context.Context) []NodeStatus {
+	if c.nodes == nil {
+		return nil
+	}
+
+	var wg sync.WaitGroup
+	for _, n := range c.nodes.nodes {
+		wg.Add(1)
+
+		go func(n *node) {
+			defer wg.Done()
+			c.nodes.check(ctx, c, n)
+		}(n)
+	}
+
+	wg.Wait()
+
+	return c.nodes.status()
+}
@@ -0,0 +1,206 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Option configures a Client created with New.
+type Option func(*options) error
+
+// options represents the configuration applied by the provided Options.
+type options struct {
+	timeout      time.Duration
+	rootCAs      *x509.CertPool
+	certificates []tls.Certificate
+	proxy        *url.URL
+	maxIdleConns int
+	userAgent    string
+	headers      http.Header
+	decodeMode   DecodeMode
+	middlewares  []Middleware
+	debug        *dumper
+	nodes        []*url.URL
+	failoverMode FailoverMode
+	nodeCooldown time.Duration
+	configDir    string
+	masterKey    string
+	cache        Cache
+	cacheTTL     time.Duration
+	limiter      *limiter
+	limiters     map[EndpointClass]*limiter
+	retryPolicy  *RetryPolicy
+}
+
+// WithTimeout sets the time limit for requests made by the Client,
+// including reading the response body.
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *options) error {
+		o.timeout = timeout
+		return nil
+	}
+}
+
+// WithCABundle trusts the PEM encoded certificates in the provided bundle,
+// in addition to the system certificate pool.
+func WithCABundle(pem []byte) Option {
+	return func(o *options) error {
+		if o.rootCAs == nil {
+			pool, err := x509.SystemCertPool()
+			if err != nil {
+				pool = x509.NewCertPool()
+			}
+
+			o.rootCAs = pool
+		}
+
+		if !o.rootCAs.AppendCertsFromPEM(pem) {
+			return errors.New("no certificates found in CA bundle")
+		}
+
+		return nil
+	}
+}
+
+// WithClientCertificate presents the provided certificate for mutual TLS authentication.
+func WithClientCertificate(cert tls.Certificate) Option {
+	return func(o *options) error {
+		o.certificates = append(o.certificates, cert)
+		return nil
+	}
+}
+
+// WithProxy sends requests through the provided HTTP proxy.
+func WithProxy(proxyURL string) Option {
+	return func(o *options) error {
+		u, err := url.Parse(proxyURL)
+		if err != nil {
+			return fmt.Errorf("invalid proxy URL: %w", err)
+		}
+
+		o.proxy = u
+		return nil
+	}
+}
+
+// WithMaxIdleConns sets the maximum number of idle connections kept open to the API.
+func WithMaxIdleConns(n int) Option {
+	return func(o *options) error {
+		o.maxIdleConns = n
+		return nil
+	}
+}
+
+// WithUserAgentSuffix appends the provided suffix to the user agent of the Client.
+func WithUserAgentSuffix(suffix string) Option {
+	return func(o *options) error {
+		o.userAgent = suffix
+		return nil
+	}
+}
+
+// WithHeaders sends the provided headers with every request,
+// unless the request sets them itself.
+func WithHeaders(headers http.Header) Option {
+	return func(o *options) error {
+		if o.headers == nil {
+			o.headers = http.Header{}
+		}
+
+		for k, v := range headers {
+			o.headers[http.CanonicalHeaderKey(k)] = append([]string(nil), v...)
+		}
+
+		return nil
+	}
+}
+
+// newOptions returns the configuration applied by the provided Options.
+func newOptions(opts ...Option) (*options, error) {
+	o := new(options)
+	for _, opt := range opts {
+		if opt == nil {
+			continue
+		}
+
+		err := opt(o)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return o, nil
+}
+
+// httpClient returns the HTTP client to use, based on the provided one.
+// The provided client is left untouched; it is copied if any option changes it.
+func (o *options) httpClient(base *http.Client) (*http.Client, error) {
+	transport := o.rootCAs != nil || len(o.certificates) > 0 || o.proxy != nil || o.maxIdleConns > 0
+
+	if o.timeout <= 0 && !transport {
+		return base, nil
+	}
+
+	c := *base
+	if o.timeout > 0 {
+		c.Timeout = o.timeout
+	}
+
+	if !transport {
+		return &c, nil
+	}
+
+	var t *http.Transport
+	switch rt := c.Transport.(type) {
+	case nil:
+		t = http.DefaultTransport.(*http.Transport).Clone()
+	case *http.Transport:
+		t = rt.Clone()
+	default:
+		return nil, fmt.Errorf("transport options require an *http.Transport, got %T", rt)
+	}
+
+	if o.rootCAs != nil || len(o.certificates) > 0 {
+		if t.TLSClientConfig == nil {
+			t.TLSClientConfig = new(tls.Config)
+		}
+
+		if o.rootCAs != nil {
+			t.TLSClientConfig.RootCAs = o.rootCAs
+		}
+
+		t.TLSClientConfig.Certificates = append(t.TLSClientConfig.Certificates, o.certificates...)
+	}
+
+	if o.proxy != nil {
+		t.Proxy = http.ProxyURL(o.proxy)
+	}
+
+	if o.maxIdleConns > 0 {
+		t.MaxIdleConns = o.maxIdleConns
+		t.MaxIdleConnsPerHost = o.maxIdleConns
+	}
+
+	c.Transport = t
+
+	return &c, nil
+}
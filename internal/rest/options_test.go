@@ -0,0 +1,188 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"crypto/tls"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/franela/goblin"
+)
+
+func Test_Options(t *testing.T) {
+	var (
+		mu     sync.Mutex
+		header http.Header
+		host   string
+		certs  int
+	)
+
+	// The handler may still run after a request timed out, so the
+	// captured request is guarded.
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		header, host = r.Header, r.Host
+		if r.TLS != nil {
+			certs = len(r.TLS.PeerCertificates)
+		}
+		mu.Unlock()
+
+		if r.URL.Path == "/api/system/slow" {
+			time.Sleep(100 * time.Millisecond)
+		}
+
+		w.Write([]byte(`"OK"`))
+	})
+
+	// Create http test servers, one of them requiring a client certificate
+	s := httptest.NewServer(handler)
+
+	tlsServer := httptest.NewUnstartedServer(handler)
+	tlsServer.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	tlsServer.StartTLS()
+
+	caBundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: tlsServer.Certificate().Raw})
+
+	g := goblin.Goblin(t)
+	g.Describe("Options", func() {
+		g.BeforeEach(func() {
+			mu.Lock()
+			header, host, certs = nil, "", 0
+			mu.Unlock()
+		})
+
+		// received returns the captured header, host and number of client certificates.
+		received := func() (http.Header, string, int) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			return header, host, certs
+		}
+
+		// Close http test servers after we're done using them
+		g.After(func() {
+			s.Close()
+			tlsServer.Close()
+		})
+
+		g.It("- should keep the provided http client", func() {
+			base := &http.Client{}
+
+			c, err := New(s.URL, base, testConfig)
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(HTTPClient(c) == base).IsTrue()
+		})
+
+		g.It("- should not modify the provided http client", func() {
+			base := &http.Client{}
+
+			c, err := New(s.URL, base, testConfig, WithTimeout(time.Second), WithMaxIdleConns(5))
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(base.Timeout).Equal(time.Duration(0))
+			g.Assert(base.Transport == nil).IsTrue()
+			g.Assert(HTTPClient(c).Timeout).Equal(time.Second)
+			g.Assert(HTTPClient(c).Transport.(*http.Transport).MaxIdleConns).Equal(5)
+		})
+
+		g.It("- should time out slow requests", func() {
+			c, _ := New(s.URL, nil, testConfig, WithTimeout(10*time.Millisecond))
+
+			_, err := c.Call("GET", "/api/system/slow", nil, nil)
+
+			g.Assert(err != nil).IsTrue()
+		})
+
+		g.It("- should send the user agent with its suffix", func() {
+			c, _ := New(s.URL, nil, testConfig, WithUserAgentSuffix("ci-agent/1.0"))
+
+			_, err := c.Call("GET", "/api/system/ping", nil, nil)
+
+			header, _, _ := received()
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(header.Get("User-Agent")).Equal("go-arty ci-agent/1.0")
+		})
+
+		g.It("- should send the default headers", func() {
+			c, _ := New(s.URL, nil, testConfig, WithHeaders(http.Header{
+				"x-team":       {"platform"},
+				"Content-Type": {"text/plain"},
+			}))
+
+			_, err := c.Call("GET", "/api/system/ping", nil, nil)
+
+			header, _, _ := received()
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(header.Get("X-Team")).Equal("platform")
+			g.Assert(header.Get("Content-Type")).Equal("application/json")
+		})
+
+		g.It("- should send requests through the proxy", func() {
+			c, _ := New("http://test.example.com", nil, testConfig, WithProxy(s.URL))
+
+			_, err := c.Call("GET", "/api/system/ping", nil, nil)
+
+			_, host, _ := received()
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(host).Equal("test.example.com")
+		})
+
+		g.It("- should trust the CA bundle and present the client certificate", func() {
+			c, err := New(tlsServer.URL, nil, testConfig,
+				WithCABundle(caBundle),
+				WithClientCertificate(tlsServer.TLS.Certificates[0]),
+			)
+			g.Assert(err == nil).IsTrue()
+
+			_, err = c.Call("GET", "/api/system/ping", nil, nil)
+
+			_, _, certs := received()
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(certs).Equal(1)
+		})
+
+		g.It("- should reject an untrusted server", func() {
+			c, _ := New(tlsServer.URL, nil, testConfig, WithClientCertificate(tlsServer.TLS.Certificates[0]))
+
+			_, err := c.Call("GET", "/api/system/ping", nil, nil)
+
+			g.Assert(err != nil).IsTrue()
+		})
+
+		g.It("- should return an error for an invalid CA bundle", func() {
+			_, err := New(s.URL, nil, testConfig, WithCABundle([]byte("not a certificate")))
+
+			g.Assert(err != nil).IsTrue()
+		})
+
+		g.It("- should return an error for a custom transport", func() {
+			base := &http.Client{Transport: http.NewFileTransport(http.Dir("."))}
+
+			_, err := New(s.URL, base, testConfig, WithProxy(s.URL))
+
+			g.Assert(err != nil).IsTrue()
+		})
+	})
+}
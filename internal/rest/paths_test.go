@@ -0,0 +1,36 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import "testing"
+
+func Test_EscapePath(t *testing.T) {
+	var tests = []struct {
+		in  string
+		out string
+	}{
+		{"", ""},
+		{"org/app/app-1.0.jar", "org/app/app-1.0.jar"},
+		{"lib/my lib;v=1 (copy).jar", "lib/my%20lib%3Bv=1%20%28copy%29.jar"},
+		{"a?b#c%d,e", "a%3Fb%23c%25d%2Ce"},
+		{"folder/", "folder/"},
+	}
+
+	for i, tt := range tests {
+		if got := EscapePath(tt.in); got != tt.out {
+			t.Errorf("EscapePath #%d(%q) returned %q, want %q", i, tt.in, got, tt.out)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// redacted replaces the secrets removed from logs.
+const redacted = "REDACTED"
+
+// sensitiveParams are the query parameters holding secrets, including the
+// signatures of the pre-signed URLs used for multipart uploads.
+var sensitiveParams = []string{"token", "password", "apiKey", "access_token", "refresh_token", "X-Amz-Signature", "Signature", "sig"}
+
+// sensitiveHeaders are the headers holding credentials, including the token
+// of a multipart upload.
+var sensitiveHeaders = []string{"Authorization", "Proxy-Authorization", "X-JFrog-Art-Api", "X-JFrog-Upload-Token", "Cookie", "Set-Cookie"}
+
+// sensitiveFields are the names of the body fields holding secrets, like the
+// password of a user or a replication and the key of a license.
+const sensitiveFields = `[A-Za-z_]*[Pp]assword|licenseKey|apiKey|token|access_token|refresh_token`
+
+var (
+	// sensitiveJSON matches the string value of a sensitive field in JSON,
+	// including a value cut short by the end of a body preview.
+	sensitiveJSON = regexp.MustCompile(`("(?:` + sensitiveFields + `)"\s*:\s*)"(?:[^"\\]|\\.)*(?:"|\\?$)`)
+
+	// sensitiveXML matches the value of a sensitive element in XML.
+	sensitiveXML = regexp.MustCompile(`(<(` + sensitiveFields + `)>)[^<]*`)
+
+	// sensitiveYAML matches the value of a sensitive key in YAML.
+	sensitiveYAML = regexp.MustCompile(`(?m)^(\s*(?:- )?(?:` + sensitiveFields + `):[ \t]*)\S.*$`)
+)
+
+// redactURL returns the provided URL with the password and the secret query parameters redacted.
+func redactURL(u *url.URL) string {
+	if u == nil {
+		return ""
+	}
+
+	r := *u
+
+	if _, ok := r.User.Password(); ok {
+		r.User = url.UserPassword(r.User.Username(), redacted)
+	}
+
+	if len(r.RawQuery) > 0 {
+		q := r.Query()
+		for k := range q {
+			for _, param := range sensitiveParams {
+				if strings.EqualFold(k, param) {
+					q.Set(k, redacted)
+				}
+			}
+		}
+
+		r.RawQuery = q.Encode()
+	}
+
+	return r.String()
+}
+
+// redactError returns the message of the provided error,
+// with the URL of a failed request redacted.
+func redactError(err error) string {
+	msg := err.Error()
+
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		if u, perr := url.Parse(urlErr.URL); perr == nil {
+			msg = strings.ReplaceAll(msg, urlErr.URL, redactURL(u))
+		}
+	}
+
+	var errResp *ErrorResponse
+	if errors.As(err, &errResp) && errResp.Response != nil && errResp.Response.Request != nil {
+		u := errResp.Response.Request.URL
+		msg = strings.ReplaceAll(msg, u.String(), redactURL(u))
+	}
+
+	return msg
+}
+
+// redactHeader returns a copy of the provided header with the credentials redacted.
+func redactHeader(h http.Header) http.Header {
+	r := h.Clone()
+
+	for _, k := range sensitiveHeaders {
+		if len(r.Values(k)) > 0 {
+			r.Set(k, redacted)
+		}
+	}
+
+	return r
+}
+
+// redactBody returns the provided JSON, XML or YAML body with the values of the secret fields redacted.
+func redactBody(body string) string {
+	body = sensitiveJSON.ReplaceAllString(body, `$1"`+redacted+`"`)
+	body = sensitiveXML.ReplaceAllString(body, `${1}`+redacted)
+	body = sensitiveYAML.ReplaceAllString(body, `${1}`+redacted)
+
+	return body
+}
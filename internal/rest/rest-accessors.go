@@ -0,0 +1,88 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+// Code generated by gen-accessors; DO NOT EDIT.
+
+package rest
+
+// GetAccessToken returns the AccessToken field if it's non-nil, zero value otherwise.
+func (a *AccessToken) GetAccessToken() string {
+	if a == nil || a.AccessToken == nil {
+		return ""
+	}
+	return *a.AccessToken
+}
+
+// GetExpiresIn returns the ExpiresIn field if it's non-nil, zero value otherwise.
+func (a *AccessToken) GetExpiresIn() int {
+	if a == nil || a.ExpiresIn == nil {
+		return 0
+	}
+	return *a.ExpiresIn
+}
+
+// GetRefreshToken returns the RefreshToken field if it's non-nil, zero value otherwise.
+func (a *AccessToken) GetRefreshToken() string {
+	if a == nil || a.RefreshToken == nil {
+		return ""
+	}
+	return *a.RefreshToken
+}
+
+// GetScope returns the Scope field if it's non-nil, zero value otherwise.
+func (a *AccessToken) GetScope() string {
+	if a == nil || a.Scope == nil {
+		return ""
+	}
+	return *a.Scope
+}
+
+// GetTokenType returns the TokenType field if it's non-nil, zero value otherwise.
+func (a *AccessToken) GetTokenType() string {
+	if a == nil || a.TokenType == nil {
+		return ""
+	}
+	return *a.TokenType
+}
+
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (e *ErrorDetail) GetMessage() string {
+	if e == nil || e.Message == nil {
+		return ""
+	}
+	return *e.Message
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (e *ErrorDetail) GetStatus() int {
+	if e == nil || e.Status == nil {
+		return 0
+	}
+	return *e.Status
+}
+
+// GetErrors returns the Errors field if it's non-nil, zero value otherwise.
+func (e *ErrorResponse) GetErrors() []ErrorDetail {
+	if e == nil || e.Errors == nil {
+		return nil
+	}
+	return *e.Errors
+}
+
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (e *ErrorResponse) GetMessage() string {
+	if e == nil || e.Message == nil {
+		return ""
+	}
+	return *e.Message
+}
@@ -0,0 +1,23 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:generate go run gen-accessors.go
+
+// Package rest implements the request pipeline shared by the clients of the
+// artifactory and xray packages: authentication, retries, errors, decoding,
+// middlewares, debugging, caching, rate limiting and high availability.
+//
+// Both packages expose its types as aliases, so they share the same Response
+// and error semantics.
+package rest
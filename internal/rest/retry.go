@@ -0,0 +1,270 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures how the Client retries failed requests.
+//
+// A request is retried when the transport fails or the response has one of
+// the RetryableStatuses. Only idempotent requests are retried unless
+// RetryNonIdempotent is set.
+//
+// The body of a retried request is replayed on every attempt. Seekable
+// bodies are rewound, while other bodies are buffered in memory up to
+// MaxBufferedBody; a larger body is sent once, without retries.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, including the first one.
+	MaxAttempts int
+
+	// MinBackoff is the backoff before the first retry.
+	// It doubles with every retry, up to MaxBackoff.
+	MinBackoff time.Duration
+
+	// MaxBackoff caps the backoff between attempts.
+	MaxBackoff time.Duration
+
+	// RetryableStatuses lists the HTTP status codes that are retried.
+	RetryableStatuses []int
+
+	// RetryNonIdempotent allows retrying POST and PATCH requests.
+	RetryNonIdempotent bool
+}
+
+// MaxBufferedBody is the size of the largest non-seekable request body
+// buffered in memory to be replayed by retries, token refreshes and failover.
+const MaxBufferedBody = 8 << 20
+
+// WithRetryPolicy retries the failed requests of the Client as allowed by the provided policy.
+func WithRetryPolicy(policy *RetryPolicy) Option {
+	return func(o *options) error {
+		o.retryPolicy = policy
+		return nil
+	}
+}
+
+// noRetryContextKey is the context key marking calls sent without retries.
+type noRetryContextKey struct{}
+
+// ContextWithoutRetry returns a context for calls sent once whatever the
+// RetryPolicy of the Client, for callers retrying them on their own.
+func ContextWithoutRetry(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noRetryContextKey{}, true)
+}
+
+// DefaultRetryPolicy returns a RetryPolicy that makes up to 4 attempts
+// for 429, 502, 503 and 504 responses and transport errors.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 4,
+		MinBackoff:  500 * time.Millisecond,
+		MaxBackoff:  30 * time.Second,
+		RetryableStatuses: []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+// Attempts returns the number of attempts the provided policy allows for the request.
+// A nil policy allows a single attempt.
+func Attempts(p *RetryPolicy, req *http.Request) int {
+	return p.attempts(req)
+}
+
+// Backoff returns the wait the provided policy sets before the provided retry.
+func Backoff(p *RetryPolicy, retry int) time.Duration {
+	return p.backoff(retry)
+}
+
+// attempts returns the number of attempts allowed for the provided request.
+func (p *RetryPolicy) attempts(req *http.Request) int {
+	if p == nil || p.MaxAttempts < 1 {
+		return 1
+	}
+
+	if !p.RetryNonIdempotent && !isIdempotent(req.Method) {
+		return 1
+	}
+
+	return p.MaxAttempts
+}
+
+// retryStatus checks if the provided status code should be retried.
+func (p *RetryPolicy) retryStatus(code int) bool {
+	for _, c := range p.RetryableStatuses {
+		if c == code {
+			return true
+		}
+	}
+
+	return false
+}
+
+// backoff returns the wait before the provided retry, with jitter applied.
+// retry starts at 1 for the first retry.
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	d := p.MinBackoff
+	for i := 1; i < retry && d < p.MaxBackoff; i++ {
+		d *= 2
+	}
+
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+
+	if d <= 0 {
+		return 0
+	}
+
+	// Equal jitter keeps at least half of the backoff.
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// isIdempotent checks if the provided HTTP method is idempotent.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// retryAfter parses the Retry-After header of the provided response.
+// It returns false if the header is missing or invalid.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	v := resp.Header.Get("Retry-After")
+	if len(v) == 0 {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(v); err == nil {
+		d := time.Until(t)
+		if d < 0 {
+			d = 0
+		}
+
+		return d, true
+	}
+
+	return 0, false
+}
+
+// Sleep waits for the provided duration or until ctx is done.
+func Sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// SetSeekableBody makes a seekable request body replayable.
+// The body is rewound to its current offset for every attempt,
+// so sources like an *os.File are re-read instead of buffered.
+func SetSeekableBody(req *http.Request, rs io.ReadSeeker) error {
+	start, err := rs.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+
+	end, err := rs.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+
+	_, err = rs.Seek(start, io.SeekStart)
+	if err != nil {
+		return err
+	}
+
+	// The transport closes the request body, which must not close the source.
+	req.ContentLength = end - start
+	req.Body = ioutil.NopCloser(rs)
+	req.GetBody = func() (io.ReadCloser, error) {
+		if _, err := rs.Seek(start, io.SeekStart); err != nil {
+			return nil, err
+		}
+
+		return ioutil.NopCloser(rs), nil
+	}
+
+	if req.ContentLength == 0 {
+		req.Body = http.NoBody
+	}
+
+	return nil
+}
+
+// bufferBody reads the request body into memory so it can be replayed,
+// and reports whether it can. A body larger than MaxBufferedBody is sent
+// as is instead, and isn't replayed.
+func bufferBody(req *http.Request) (bool, error) {
+	data, err := ioutil.ReadAll(io.LimitReader(req.Body, MaxBufferedBody+1))
+	if err != nil {
+		_ = req.Body.Close()
+		return false, err
+	}
+
+	if len(data) > MaxBufferedBody {
+		req.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(data), req.Body), req.Body}
+
+		return false, nil
+	}
+
+	_ = req.Body.Close()
+
+	req.ContentLength = int64(len(data))
+	req.Body = ioutil.NopCloser(bytes.NewReader(data))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(data)), nil
+	}
+
+	return true, nil
+}
+
+// Drain discards the rest of the response body so the connection can be reused.
+func Drain(resp *http.Response) {
+	_, _ = io.Copy(ioutil.Discard, io.LimitReader(resp.Body, 4096))
+	_ = resp.Body.Close()
+}
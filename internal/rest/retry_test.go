@@ -0,0 +1,287 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/franela/goblin"
+)
+
+func Test_Retry(t *testing.T) {
+	g := goblin.Goblin(t)
+	g.Describe("backoff", func() {
+		g.It("- should grow exponentially up to the max backoff", func() {
+			p := &RetryPolicy{MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+
+			for retry, max := range map[int]time.Duration{1: 100, 2: 200, 3: 400, 4: 800, 5: 1000, 10: 1000} {
+				d := p.backoff(retry)
+
+				g.Assert(d >= max*time.Millisecond/2).IsTrue()
+				g.Assert(d <= max*time.Millisecond).IsTrue()
+			}
+		})
+
+		g.It("- should parse the Retry-After header", func() {
+			resp := &http.Response{Header: http.Header{}}
+
+			_, ok := retryAfter(resp)
+			g.Assert(ok).IsFalse()
+
+			resp.Header.Set("Retry-After", "3")
+			d, ok := retryAfter(resp)
+			g.Assert(ok).IsTrue()
+			g.Assert(d).Equal(3 * time.Second)
+
+			resp.Header.Set("Retry-After", time.Now().Add(time.Hour).UTC().Format(http.TimeFormat))
+			d, ok = retryAfter(resp)
+			g.Assert(ok).IsTrue()
+			g.Assert(d > 59*time.Minute).IsTrue()
+
+			resp.Header.Set("Retry-After", "soon")
+			_, ok = retryAfter(resp)
+			g.Assert(ok).IsFalse()
+		})
+	})
+	g.Describe("bufferBody", func() {
+		g.It("- should buffer a small body to replay it", func() {
+			req, _ := http.NewRequest("PUT", "https://some.company.com", ioutil.NopCloser(strings.NewReader("content")))
+
+			replayable, err := bufferBody(req)
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(replayable).IsTrue()
+			g.Assert(req.ContentLength).Equal(int64(7))
+
+			for i := 0; i < 2; i++ {
+				body, _ := req.GetBody()
+				data, _ := ioutil.ReadAll(body)
+				g.Assert(string(data)).Equal("content")
+			}
+		})
+
+		g.It("- should send a large body as is without replaying it", func() {
+			content := strings.Repeat("x", MaxBufferedBody+10)
+			req, _ := http.NewRequest("PUT", "https://some.company.com", ioutil.NopCloser(strings.NewReader(content)))
+
+			replayable, err := bufferBody(req)
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(replayable).IsFalse()
+			g.Assert(req.GetBody == nil).IsTrue()
+
+			data, _ := ioutil.ReadAll(req.Body)
+			g.Assert(len(data)).Equal(len(content))
+		})
+	})
+	g.Describe("Attempts", func() {
+		g.It("- should allow a single attempt without a policy", func() {
+			req, _ := http.NewRequest("GET", "https://some.company.com", nil)
+
+			g.Assert(Attempts(nil, req)).Equal(1)
+		})
+
+		g.It("- should not retry non-idempotent requests by default", func() {
+			p := &RetryPolicy{MaxAttempts: 3}
+			get, _ := http.NewRequest("GET", "https://some.company.com", nil)
+			post, _ := http.NewRequest("POST", "https://some.company.com", nil)
+
+			g.Assert(Attempts(p, get)).Equal(3)
+			g.Assert(Attempts(p, post)).Equal(1)
+		})
+	})
+}
+
+func Test_RetryPolicy(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		attempts int
+		failures int
+		bodies   []string
+		status   = http.StatusServiceUnavailable
+		header   = ""
+	)
+
+	// Create http test server that fails the first requests
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		attempts++
+		body, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+
+		if attempts <= failures {
+			if len(header) > 0 {
+				w.Header().Set("Retry-After", header)
+			}
+			w.WriteHeader(status)
+			return
+		}
+
+		w.Write([]byte(`"ok"`))
+	}))
+
+	// Create the client to interact with the http test server
+	c, _ := New(s.URL, nil, testConfig)
+
+	g := goblin.Goblin(t)
+	g.Describe("RetryPolicy", func() {
+		g.BeforeEach(func() {
+			attempts, failures, bodies = 0, 2, nil
+			status, header = http.StatusServiceUnavailable, ""
+
+			c.RetryPolicy = DefaultRetryPolicy()
+			c.RetryPolicy.MinBackoff = time.Millisecond
+			c.RetryPolicy.MaxBackoff = 5 * time.Millisecond
+		})
+
+		// Close http test server after we're done using it
+		g.After(func() {
+			s.Close()
+		})
+
+		g.It("- should not retry without a policy", func() {
+			c.RetryPolicy = nil
+
+			_, err := c.Call("GET", "/api/ping", nil, nil)
+
+			g.Assert(err != nil).IsTrue()
+			g.Assert(attempts).Equal(1)
+		})
+
+		g.It("- should retry retryable statuses", func() {
+			v := new(string)
+			resp, err := c.Call("GET", "/api/ping", nil, v)
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(resp.StatusCode).Equal(200)
+			g.Assert(*v).Equal("ok")
+			g.Assert(attempts).Equal(3)
+		})
+
+		g.It("- should retry with the policy passed as an option", func() {
+			policy := c.RetryPolicy
+			policy.MaxAttempts = 3
+
+			c, _ := New(s.URL, nil, testConfig, WithRetryPolicy(policy))
+
+			_, err := c.Call("GET", "/api/ping", nil, nil)
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(attempts).Equal(3)
+		})
+
+		g.It("- should not retry a large body that can't be rewound", func() {
+			body := io.MultiReader(strings.NewReader(strings.Repeat("x", MaxBufferedBody+1)))
+
+			_, err := c.Call("PUT", "/api/ping", body, nil)
+
+			g.Assert(err != nil).IsTrue()
+			g.Assert(attempts).Equal(1)
+			g.Assert(len(bodies[0])).Equal(MaxBufferedBody + 1)
+		})
+
+		g.It("- should not retry the calls made without retries", func() {
+			_, err := c.CallContext(ContextWithoutRetry(context.Background()), "GET", "/api/ping", nil, nil)
+
+			g.Assert(err != nil).IsTrue()
+			g.Assert(attempts).Equal(1)
+		})
+
+		g.It("- should return the last error after max attempts", func() {
+			failures = 10
+
+			resp, err := c.Call("GET", "/api/ping", nil, nil)
+
+			g.Assert(err != nil).IsTrue()
+			g.Assert(resp.StatusCode).Equal(503)
+			g.Assert(attempts).Equal(4)
+		})
+
+		g.It("- should not retry other statuses", func() {
+			status = http.StatusInternalServerError
+
+			_, err := c.Call("GET", "/api/ping", nil, nil)
+
+			g.Assert(err != nil).IsTrue()
+			g.Assert(attempts).Equal(1)
+		})
+
+		g.It("- should not retry non idempotent requests by default", func() {
+			_, err := c.Call("POST", "/api/copy", nil, nil)
+
+			g.Assert(err != nil).IsTrue()
+			g.Assert(attempts).Equal(1)
+		})
+
+		g.It("- should retry non idempotent requests when opted in", func() {
+			c.RetryPolicy.RetryNonIdempotent = true
+
+			_, err := c.Call("POST", "/api/groups", map[string]string{"name": "g"}, nil)
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(attempts).Equal(3)
+			g.Assert(bodies[0]).Equal(bodies[2])
+			g.Assert(strings.Contains(bodies[2], `"name":"g"`)).IsTrue()
+		})
+
+		g.It("- should honor the Retry-After header", func() {
+			status, header = http.StatusTooManyRequests, "1"
+			failures = 1
+
+			start := time.Now()
+			_, err := c.Call("GET", "/api/ping", nil, nil)
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(time.Since(start) >= time.Second).IsTrue()
+		})
+
+		g.It("- should stop retrying when the context is done", func() {
+			status, header = http.StatusTooManyRequests, "60"
+
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+
+			_, err := c.CallContext(ctx, "GET", "/api/ping", nil, nil)
+
+			g.Assert(err == context.DeadlineExceeded).IsTrue()
+			g.Assert(attempts).Equal(1)
+		})
+
+		g.It("- should replay a non seekable body", func() {
+			r, w, _ := os.Pipe()
+			go func() {
+				w.Write([]byte("streamed"))
+				w.Close()
+			}()
+
+			req, _ := c.NewRequest("PUT", "/upload/foo.txt", ioutil.NopCloser(r))
+			_, err := c.Do(req, nil)
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(bodies).Equal([]string{"streamed", "streamed", "streamed"})
+		})
+	})
+}
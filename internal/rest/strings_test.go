@@ -0,0 +1,48 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/franela/goblin"
+)
+
+func Test_Stringify(t *testing.T) {
+	g := goblin.Goblin(t)
+	g.Describe("Stringify", func() {
+		ts := Timestamp{Time: time.Date(2006, time.January, 02, 15, 04, 05, 0, time.UTC)}
+
+		g.It("- should qualify the types of this package with the provided package", func() {
+			g.Assert(Stringify("artifactory", ts)).Equal("artifactory.Timestamp{2006-01-02 15:04:05 +0000 UTC}")
+			g.Assert(Stringify("xray", &ErrorDetail{Status: new(int)})).Equal("xray.ErrorDetail{Status:0}")
+		})
+
+		g.It("- should leave the types of this package unqualified without a package", func() {
+			g.Assert(Stringify("", ts)).Equal("Timestamp{2006-01-02 15:04:05 +0000 UTC}")
+			g.Assert(ErrorDetail{Status: new(int)}.String()).Equal("ErrorDetail{Status:0}")
+		})
+
+		g.It("- should qualify the nested types of this package", func() {
+			type wrapper struct {
+				Created *Timestamp
+				Elapsed time.Duration
+			}
+
+			g.Assert(Stringify("xray", wrapper{Created: &ts, Elapsed: time.Second})).Equal("xray.wrapper{Created:xray.Timestamp{2006-01-02 15:04:05 +0000 UTC}, Elapsed:1s}")
+		})
+	})
+}
@@ -28,7 +28,7 @@
 
 // Copyright (c) 2018 Target Brands, Inc.
 
-package artifactory
+package rest
 
 import (
 	"encoding/json"
@@ -57,7 +57,7 @@ func TestTimestamp_Marshal(t *testing.T) {
 		wantErr bool
 		equal   bool
 	}{
-		{"Reference", Timestamp{referenceTime}, referenceTimeStr, false, true},
+		{"Reference", Timestamp{Time: referenceTime}, referenceTimeStr, false, true},
 		{"Empty", Timestamp{}, emptyTimeStr, false, true},
 		{"Mismatch", Timestamp{}, referenceTimeStr, false, false},
 	}
@@ -82,14 +82,14 @@ func TestTimestamp_Unmarshal(t *testing.T) {
 		wantErr bool
 		equal   bool
 	}{
-		{"Reference", referenceTimeStr, Timestamp{referenceTime}, false, true},
-		{"ReferenceISO", referenceISOTimeStr, Timestamp{referenceTime}, false, true},
-		{"ReferenceUnix", referenceUnixTimeStr, Timestamp{referenceTime}, false, true},
+		{"Reference", referenceTimeStr, Timestamp{Time: referenceTime}, false, true},
+		{"ReferenceISO", referenceISOTimeStr, Timestamp{Time: referenceTime}, false, true},
+		{"ReferenceUnix", referenceUnixTimeStr, Timestamp{Time: referenceTime}, false, true},
 		{"Empty", emptyTimeStr, Timestamp{}, false, true},
-		{"UnixStart", `0`, Timestamp{unixOrigin}, false, true},
+		{"UnixStart", `0`, Timestamp{Time: unixOrigin}, false, true},
 		{"Mismatch", referenceTimeStr, Timestamp{}, false, false},
 		{"MismatchUnix", `0`, Timestamp{}, false, false},
-		{"Invalid", `"asdf"`, Timestamp{referenceTime}, true, false},
+		{"Invalid", `"asdf"`, Timestamp{Time: referenceTime}, true, false},
 	}
 	for _, tc := range testCases {
 		var got Timestamp
@@ -110,7 +110,7 @@ func TestTimstamp_MarshalReflexivity(t *testing.T) {
 		desc string
 		data Timestamp
 	}{
-		{"Reference", Timestamp{referenceTime}},
+		{"Reference", Timestamp{Time: referenceTime}},
 		{"Empty", Timestamp{}},
 	}
 	for _, tc := range testCases {
@@ -142,7 +142,7 @@ func TestWrappedTimstamp_Marshal(t *testing.T) {
 		wantErr bool
 		equal   bool
 	}{
-		{"Reference", WrappedTimestamp{0, Timestamp{referenceTime}}, fmt.Sprintf(`{"A":0,"Time":%s}`, referenceTimeStr), false, true},
+		{"Reference", WrappedTimestamp{0, Timestamp{Time: referenceTime}}, fmt.Sprintf(`{"A":0,"Time":%s}`, referenceTimeStr), false, true},
 		{"Empty", WrappedTimestamp{}, fmt.Sprintf(`{"A":0,"Time":%s}`, emptyTimeStr), false, true},
 		{"Mismatch", WrappedTimestamp{}, fmt.Sprintf(`{"A":0,"Time":%s}`, referenceTimeStr), false, false},
 	}
@@ -167,14 +167,14 @@ func TestWrappedTimstamp_Unmarshal(t *testing.T) {
 		wantErr bool
 		equal   bool
 	}{
-		{"Reference", referenceTimeStr, WrappedTimestamp{0, Timestamp{referenceTime}}, false, true},
-		{"ReferenceISO", referenceISOTimeStr, WrappedTimestamp{0, Timestamp{referenceTime}}, false, true},
-		{"ReferenceUnix", referenceUnixTimeStr, WrappedTimestamp{0, Timestamp{referenceTime}}, false, true},
+		{"Reference", referenceTimeStr, WrappedTimestamp{0, Timestamp{Time: referenceTime}}, false, true},
+		{"ReferenceISO", referenceISOTimeStr, WrappedTimestamp{0, Timestamp{Time: referenceTime}}, false, true},
+		{"ReferenceUnix", referenceUnixTimeStr, WrappedTimestamp{0, Timestamp{Time: referenceTime}}, false, true},
 		{"Empty", emptyTimeStr, WrappedTimestamp{0, Timestamp{}}, false, true},
-		{"UnixStart", `0`, WrappedTimestamp{0, Timestamp{unixOrigin}}, false, true},
+		{"UnixStart", `0`, WrappedTimestamp{0, Timestamp{Time: unixOrigin}}, false, true},
 		{"Mismatch", referenceTimeStr, WrappedTimestamp{0, Timestamp{}}, false, false},
 		{"MismatchUnix", `0`, WrappedTimestamp{0, Timestamp{}}, false, false},
-		{"Invalid", `"asdf"`, WrappedTimestamp{0, Timestamp{referenceTime}}, true, false},
+		{"Invalid", `"asdf"`, WrappedTimestamp{0, Timestamp{Time: referenceTime}}, true, false},
 	}
 	for _, tc := range testCases {
 		var got Timestamp
@@ -195,7 +195,7 @@ func TestWrappedTimstamp_MarshalReflexivity(t *testing.T) {
 		desc string
 		data WrappedTimestamp
 	}{
-		{"Reference", WrappedTimestamp{0, Timestamp{referenceTime}}},
+		{"Reference", WrappedTimestamp{0, Timestamp{Time: referenceTime}}},
 		{"Empty", WrappedTimestamp{0, Timestamp{}}},
 	}
 	for _, tc := range testCases {
@@ -0,0 +1,176 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// expiryDelta is how long before its expiry an access token is refreshed.
+const expiryDelta = time.Minute
+
+// TokenSource supplies access tokens for Bearer authentication.
+//
+// Token is called before every request, so implementations should cache
+// the token and refresh it before it expires. Refresh is called once when
+// a request fails with 401 Unauthorized, before the request is retried.
+type TokenSource interface {
+	// Token returns the access token to send with a request.
+	Token(ctx context.Context) (string, error)
+
+	// Refresh obtains a new access token after the current one was rejected.
+	Refresh(ctx context.Context) error
+}
+
+// AccessToken represents an access token in Artifactory.
+//
+// Docs: https://www.jfrog.com/confluence/display/JFROG/Artifactory+REST+API#ArtifactoryRESTAPI-CreateToken
+type AccessToken struct {
+	AccessToken  *string `json:"access_token,omitempty"`
+	ExpiresIn    *int    `json:"expires_in,omitempty"`
+	Scope        *string `json:"scope,omitempty"`
+	TokenType    *string `json:"token_type,omitempty"`
+	RefreshToken *string `json:"refresh_token,omitempty"`
+}
+
+func (a AccessToken) String() string {
+	return Stringify("", a)
+}
+
+// RefreshTokenSource is a TokenSource that refreshes an access token with
+// its refresh token, using the Artifactory token endpoint.
+//
+// Docs: https://www.jfrog.com/confluence/display/JFROG/Artifactory+REST+API#ArtifactoryRESTAPI-RefreshToken
+type RefreshTokenSource struct {
+	// TokenURL is the URL of the Artifactory /api/security/token endpoint.
+	TokenURL string
+
+	// HTTPClient is used to refresh the access token.
+	// If nil, http.DefaultClient is used.
+	HTTPClient *http.Client
+
+	mu           sync.Mutex
+	accessToken  string
+	refreshToken string
+	expiry       time.Time
+}
+
+// NewRefreshTokenSource returns a TokenSource for the provided access and refresh tokens.
+// expiry is when the access token expires; if zero, the token is only refreshed
+// after it is rejected.
+func NewRefreshTokenSource(tokenURL, accessToken, refreshToken string, expiry time.Time) *RefreshTokenSource {
+	return &RefreshTokenSource{
+		TokenURL:     tokenURL,
+		accessToken:  accessToken,
+		refreshToken: refreshToken,
+		expiry:       expiry,
+	}
+}
+
+// Token returns the current access token, refreshing it first if it is about to expire.
+func (s *RefreshTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.expiry.IsZero() && time.Now().Add(expiryDelta).After(s.expiry) {
+		err := s.refresh(ctx)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return s.accessToken, nil
+}
+
+// Refresh obtains a new access token using the refresh token.
+func (s *RefreshTokenSource) Refresh(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.refresh(ctx)
+}
+
+// refresh obtains a new access token. The caller must hold s.mu.
+func (s *RefreshTokenSource) refresh(ctx context.Context) error {
+	if len(s.refreshToken) == 0 {
+		return errors.New("no refresh token provided")
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "refresh_token")
+	form.Set("refresh_token", s.refreshToken)
+	form.Set("access_token", s.accessToken)
+
+	req, err := http.NewRequestWithContext(ctx, "POST", s.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+
+	client := s.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	err = CheckResponse(resp)
+	if err != nil {
+		return err
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	token := new(AccessToken)
+	err = json.Unmarshal(body, token)
+	if err != nil {
+		return err
+	}
+
+	if len(token.GetAccessToken()) == 0 {
+		return errors.New("no access token returned by token refresh")
+	}
+
+	s.accessToken = token.GetAccessToken()
+
+	// The refresh token is rotated on every refresh.
+	if len(token.GetRefreshToken()) > 0 {
+		s.refreshToken = token.GetRefreshToken()
+	}
+
+	s.expiry = time.Time{}
+	if token.GetExpiresIn() > 0 {
+		s.expiry = time.Now().Add(time.Duration(token.GetExpiresIn()) * time.Second)
+	}
+
+	return nil
+}
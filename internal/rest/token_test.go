@@ -0,0 +1,164 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/franela/goblin"
+)
+
+func Test_Token(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		valid    string
+		refresh  string
+		refreshs int
+		seen     []string
+	)
+
+	// Create http test server that only accepts the current access token
+	// and rotates both tokens on refresh
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if r.URL.Path == "/api/security/token" {
+			_ = r.ParseForm()
+
+			if r.Form.Get("grant_type") != "refresh_token" || r.Form.Get("refresh_token") != refresh {
+				w.WriteHeader(401)
+				fmt.Fprint(w, `{"errors":[{"status":401,"message":"Bad refresh token"}]}`)
+				return
+			}
+
+			refreshs++
+			valid = fmt.Sprintf("access-%d", refreshs)
+			refresh = fmt.Sprintf("refresh-%d", refreshs)
+
+			fmt.Fprintf(w, `{"access_token":"%s","expires_in":3600,"token_type":"Bearer","refresh_token":"%s"}`, valid, refresh)
+			return
+		}
+
+		seen = append(seen, r.Header.Get("Authorization"))
+
+		if r.Header.Get("Authorization") != "Bearer "+valid {
+			w.WriteHeader(401)
+			return
+		}
+
+		fmt.Fprint(w, `"OK"`)
+	}))
+
+	// Create the client to interact with the http test server
+	c, _ := New(s.URL, nil, testConfig)
+
+	g := goblin.Goblin(t)
+	g.Describe("TokenSource", func() {
+		g.BeforeEach(func() {
+			valid, refresh, refreshs, seen = "access-0", "refresh-0", 0, nil
+		})
+
+		// Close http test server after we're done using it
+		g.After(func() {
+			s.Close()
+		})
+
+		g.It("- should send the access token", func() {
+			Auth(c).SetTokenSource(NewRefreshTokenSource(s.URL+"/api/security/token", "access-0", "refresh-0", time.Now().Add(time.Hour)))
+
+			_, err := c.Call("GET", "/api/ping", nil, nil)
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(refreshs).Equal(0)
+			g.Assert(seen).Equal([]string{"Bearer access-0"})
+		})
+
+		g.It("- should refresh the access token before it expires", func() {
+			Auth(c).SetTokenSource(NewRefreshTokenSource(s.URL+"/api/security/token", "access-0", "refresh-0", time.Now().Add(time.Second)))
+
+			_, err := c.Call("GET", "/api/ping", nil, nil)
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(refreshs).Equal(1)
+			g.Assert(seen).Equal([]string{"Bearer access-1"})
+		})
+
+		g.It("- should refresh and retry once when the token is rejected", func() {
+			Auth(c).SetTokenSource(NewRefreshTokenSource(s.URL+"/api/security/token", "revoked", "refresh-0", time.Time{}))
+
+			v := new(string)
+			_, err := c.Call("POST", "/api/groups", map[string]string{"name": "readers"}, v)
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(*v).Equal("OK")
+			g.Assert(refreshs).Equal(1)
+			g.Assert(seen).Equal([]string{"Bearer revoked", "Bearer access-1"})
+		})
+
+		g.It("- should use the rotated refresh token", func() {
+			ts := NewRefreshTokenSource(s.URL+"/api/security/token", "access-0", "refresh-0", time.Time{})
+
+			g.Assert(ts.Refresh(context.Background()) == nil).IsTrue()
+			g.Assert(ts.Refresh(context.Background()) == nil).IsTrue()
+
+			token, err := ts.Token(context.Background())
+			g.Assert(err == nil).IsTrue()
+			g.Assert(token).Equal("access-2")
+		})
+
+		g.It("- should return an error when the refresh fails", func() {
+			Auth(c).SetTokenSource(NewRefreshTokenSource(s.URL+"/api/security/token", "revoked", "revoked", time.Time{}))
+
+			_, err := c.Call("GET", "/api/ping", nil, nil)
+
+			g.Assert(IsUnauthorized(err)).IsTrue()
+			g.Assert(seen).Equal([]string{"Bearer revoked"})
+		})
+
+		g.It("- should not retry twice when the refreshed token is rejected", func() {
+			ts := &staticTokenSource{token: "revoked"}
+			Auth(c).SetTokenSource(ts)
+
+			resp, err := c.Call("GET", "/api/ping", nil, nil)
+
+			g.Assert(resp != nil).IsTrue()
+			g.Assert(IsUnauthorized(err)).IsTrue()
+			g.Assert(ts.refreshs).Equal(1)
+			g.Assert(seen).Equal([]string{"Bearer revoked", "Bearer revoked"})
+		})
+	})
+}
+
+// staticTokenSource is a TokenSource that never changes its token.
+type staticTokenSource struct {
+	token    string
+	refreshs int
+}
+
+func (s *staticTokenSource) Token(ctx context.Context) (string, error) {
+	return s.token, nil
+}
+
+func (s *staticTokenSource) Refresh(ctx context.Context) error {
+	s.refreshs++
+	return nil
+}
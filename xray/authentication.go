@@ -14,45 +14,14 @@
 
 package xray
 
-const (
-	// HTTP Basic Authentication
-	authTypeBasic = 1
-	// Auth via API Token
-	authTypeToken = 2
-)
+import "github.com/target/go-arty/v2/internal/rest"
+
+// authentication holds the credentials applied to the requests of the Client.
+type authentication = rest.Authentication
 
 // AuthenticationService contains authentication related functions.
 type AuthenticationService struct {
-	client   *Client
-	username *string
-	secret   *string
-	authType int
-}
-
-// SetBasicAuth sets the auth type as HTTP Basic auth.
-func (s *AuthenticationService) SetBasicAuth(username, password string) {
-	s.username = String(username)
-	s.secret = String(password)
-	s.authType = authTypeBasic
-}
-
-// SetTokenAuth sets the auth type as Token auth.
-func (s *AuthenticationService) SetTokenAuth(token string) {
-	s.secret = String(token)
-	s.authType = authTypeToken
-}
-
-// HasAuth checks if the auth type is set.
-func (s *AuthenticationService) HasAuth() bool {
-	return s.authType > 0
-}
-
-// HasBasicAuth checks if the auth type is HTTP Basic auth.
-func (s *AuthenticationService) HasBasicAuth() bool {
-	return s.authType == authTypeBasic
-}
+	*authentication
 
-// HasTokenAuth checks if the auth type is Token auth.
-func (s *AuthenticationService) HasTokenAuth() bool {
-	return s.authType == authTypeToken
+	client *Client
 }
@@ -0,0 +1,79 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xray
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/franela/goblin"
+)
+
+func Test_Cache(t *testing.T) {
+	var requests, conditional int
+
+	// Create http test server with a validated resource
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("ETag", `"v1"`)
+
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			conditional++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.Write([]byte(`{"xray_version":"3.0.0"}`))
+	}))
+
+	g := goblin.Goblin(t)
+	g.Describe("Cache", func() {
+		g.BeforeEach(func() {
+			requests, conditional = 0, 0
+		})
+
+		// Close http test server after we're done using it
+		g.After(func() {
+			s.Close()
+		})
+
+		g.It("- should revalidate cached responses", func() {
+			c, _ := NewClient(s.URL, nil, WithCache(nil))
+
+			_, _, err := c.System.Version()
+			g.Assert(err == nil).IsTrue()
+
+			v, resp, err := c.System.Version()
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(v.GetXrayVersion()).Equal("3.0.0")
+			g.Assert(resp.Header.Get("X-From-Cache")).Equal("1")
+			g.Assert(requests).Equal(2)
+			g.Assert(conditional).Equal(1)
+		})
+
+		g.It("- should not cache without WithCache", func() {
+			c, _ := NewClient(s.URL, nil)
+
+			_, _, _ = c.System.Version()
+			_, resp, err := c.System.Version()
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(resp.Header.Get("X-From-Cache")).Equal("")
+			g.Assert(conditional).Equal(0)
+		})
+	})
+}
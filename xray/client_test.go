@@ -15,7 +15,6 @@
 package xray
 
 import (
-	"net/http"
 	"testing"
 
 	"github.com/franela/goblin"
@@ -47,54 +46,16 @@ func Test_client(t *testing.T) {
 		})
 	})
 
-	g.Describe("BuildURLForRequest", func() {
-		client, _ := NewClient("https://some.company.com", nil)
-
-		g.BeforeEach(func() {
-			client, _ = NewClient("https://some.company.com", nil)
-		})
-
-		g.It("- should be able to build url for request", func() {
-			actual, err := client.buildURLForRequest("test")
-
-			g.Assert(actual != "").IsTrue()
-			g.Assert(err == nil).IsTrue()
-		})
-
-		g.It("- should be able to build url for request", func() {
-			actual, err := client.buildURLForRequest("/test")
-
-			g.Assert(actual != "").IsTrue()
-			g.Assert(err == nil).IsTrue()
-		})
-
-		g.It("- should be able to build url for request", func() {
-			actual, err := client.buildURLForRequest("test/")
-
-			g.Assert(actual != "").IsTrue()
-			g.Assert(err == nil).IsTrue()
-		})
-
-		g.It("- should fail to build url for request", func() {
-			actual, err := client.buildURLForRequest("@$(:LKS24poihwekf1203")
-
-			g.Assert(actual == "").IsTrue()
-			g.Assert(err != nil).IsTrue()
-		})
-	})
-
 	g.Describe("AddAuthentication", func() {
 		client, _ := NewClient("https://some.company.com", nil)
-		request, _ := http.NewRequest("GET", "https://some.company.com/ping", nil)
 
 		g.BeforeEach(func() {
 			client, _ = NewClient("https://some.company.com", nil)
-			request, _ = http.NewRequest("GET", "https://some.company.com/ping", nil)
 		})
 
 		g.It("- should be able to add basic authentication for request", func() {
 			client.Authentication.SetBasicAuth("user", "pass")
-			client.addAuthentication(request)
+			request, _ := client.NewRequest("GET", "/ping", nil)
 
 			user, pass, _ := request.BasicAuth()
 
@@ -104,10 +65,17 @@ func Test_client(t *testing.T) {
 
 		g.It("- should be able to add token authentication for request", func() {
 			client.Authentication.SetTokenAuth("someToken")
-			client.addAuthentication(request)
+			request, _ := client.NewRequest("GET", "/ping", nil)
 
 			g.Assert(request.URL.Query().Get("token")).Equal("someToken")
 		})
+
+		g.It("- should be able to add bearer authentication for request", func() {
+			client.Authentication.SetBearerAuth("someToken")
+			request, _ := client.NewRequest("GET", "/ping", nil)
+
+			g.Assert(request.Header.Get("Authorization")).Equal("Bearer someToken")
+		})
 	})
 
 	g.Describe("NewRequest", func() {
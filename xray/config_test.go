@@ -0,0 +1,138 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xray
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/franela/goblin"
+)
+
+func Test_Config(t *testing.T) {
+	var (
+		header http.Header
+		path   string
+	)
+
+	// Create http test server recording the requests
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header, path = r.Header, r.URL.Path
+		w.Write([]byte(`{"status":"pong"}`))
+	}))
+
+	g := goblin.Goblin(t)
+	g.Describe("Config", func() {
+		var dir string
+
+		write := func(name, config string) {
+			_ = ioutil.WriteFile(filepath.Join(dir, name), []byte(config), 0600)
+		}
+
+		g.BeforeEach(func() {
+			header, path = nil, ""
+			dir = t.TempDir()
+
+			t.Setenv("JFROG_CLI_HOME_DIR", dir)
+			t.Setenv("JFROG_CLI_ENCRYPTION_KEY", "")
+
+			for _, env := range []string{"JFROG_URL", "JFROG_XRAY_URL", "JFROG_USER", "JFROG_PASSWORD", "JFROG_API_KEY", "JFROG_ACCESS_TOKEN"} {
+				t.Setenv(env, "")
+			}
+		})
+
+		// Close http test server after we're done using it
+		g.After(func() {
+			s.Close()
+		})
+
+		g.It("- should use the default server", func() {
+			write("jfrog-cli.conf.v6", `{
+				"servers": [
+					{"serverId": "other", "url": "http://localhost:1/", "accessToken": "other"},
+					{"serverId": "acme", "url": "`+s.URL+`/", "accessToken": "token", "isDefault": true}
+				],
+				"version": "6"
+			}`)
+
+			c, err := NewClientFromConfig("")
+			g.Assert(err == nil).IsTrue()
+
+			_, _, err = c.System.Ping()
+			g.Assert(err == nil).IsTrue()
+			g.Assert(path).Equal("/xray/api/v1/system/ping")
+			g.Assert(header.Get("Authorization")).Equal("Bearer token")
+		})
+
+		g.It("- should use the server with the provided ID", func() {
+			write("jfrog-cli.conf.v6", `{"servers": [{"serverId": "acme", "xrayUrl": "`+s.URL+`/xr/", "user": "admin", "apiKey": "key"}]}`)
+			write("jfrog-cli.conf.v5", `{"servers": [{"serverId": "acme", "xrayUrl": "http://localhost:1/"}]}`)
+
+			c, err := NewClientFromConfig("acme")
+			g.Assert(err == nil).IsTrue()
+
+			_, _, err = c.System.Ping()
+			g.Assert(err == nil).IsTrue()
+			g.Assert(path).Equal("/xr/api/v1/system/ping")
+
+			user, password, _ := (&http.Request{Header: header}).BasicAuth()
+			g.Assert(user).Equal("admin")
+			g.Assert(password).Equal("key")
+		})
+
+		g.It("- should read version 1 configs", func() {
+			write("jfrog-cli.conf", `{"artifactory": [{"serverId": "acme", "url": "`+s.URL+`/artifactory/", "user": "admin", "password": "password"}], "version": "1"}`)
+
+			c, err := NewClientFromConfig("")
+			g.Assert(err == nil).IsTrue()
+
+			_, _, err = c.System.Ping()
+			g.Assert(err == nil).IsTrue()
+			g.Assert(path).Equal("/xray/api/v1/system/ping")
+		})
+
+		g.It("- should fall back to the environment", func() {
+			t.Setenv("JFROG_URL", s.URL)
+			t.Setenv("JFROG_ACCESS_TOKEN", "token")
+
+			c, err := NewClientFromConfig("", WithConfigDir(t.TempDir()))
+			g.Assert(err == nil).IsTrue()
+
+			_, _, err = c.System.Ping()
+			g.Assert(err == nil).IsTrue()
+			g.Assert(path).Equal("/xray/api/v1/system/ping")
+			g.Assert(header.Get("Authorization")).Equal("Bearer token")
+		})
+
+		g.It("- should not fall back to the environment for a missing server", func() {
+			t.Setenv("JFROG_URL", s.URL)
+			t.Setenv("JFROG_ACCESS_TOKEN", "token")
+
+			_, err := NewClientFromConfig("ci")
+			g.Assert(err.Error()).Equal(`server "ci" not found in the JFrog CLI config`)
+		})
+
+		g.It("- should fail without a server", func() {
+			_, err := NewClientFromConfig("")
+			g.Assert(err != nil).IsTrue()
+
+			_, err = NewClientFromConfig("acme")
+			g.Assert(err != nil).IsTrue()
+		})
+	})
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xray
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/franela/goblin"
+)
+
+func Test_Debug(t *testing.T) {
+	// Create http test server echoing the request body
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		buf := new(bytes.Buffer)
+		buf.ReadFrom(r.Body)
+
+		if buf.Len() == 0 {
+			buf.WriteString(`{"name":"reader","email":"reader@company.com"}`)
+		}
+
+		w.Write(buf.Bytes())
+	}))
+
+	g := goblin.Goblin(t)
+	g.Describe("Debug", func() {
+		var (
+			buf *bytes.Buffer
+			c   *Client
+		)
+
+		g.BeforeEach(func() {
+			buf = new(bytes.Buffer)
+			c, _ = NewClient(s.URL, nil, WithDebug(buf, 1024))
+			c.Authentication.SetBasicAuth("admin", "hunter2")
+		})
+
+		// Close http test server after we're done using it
+		g.After(func() {
+			s.Close()
+		})
+
+		g.It("- should dump the request and the response", func() {
+			actual, _, err := c.Users.Get("reader")
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(actual.GetName()).Equal("reader")
+
+			dump := buf.String()
+			g.Assert(strings.Contains(dump, "> GET "+s.URL+"/api/v1/users/reader\n")).IsTrue()
+			g.Assert(strings.Contains(dump, "> Authorization: REDACTED\n")).IsTrue()
+			g.Assert(strings.Contains(dump, "< HTTP/1.1 200 OK")).IsTrue()
+			g.Assert(strings.Contains(dump, `< {"name":"reader","email":"reader@company.com"}`)).IsTrue()
+		})
+
+		g.It("- should redact the token", func() {
+			c.Authentication.SetTokenAuth("hunter2")
+
+			_, _, err := c.Users.Get("reader")
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(strings.Contains(buf.String(), "token=REDACTED")).IsTrue()
+			g.Assert(strings.Contains(buf.String(), "hunter2")).IsFalse()
+		})
+
+		g.It("- should redact the password of a User", func() {
+			_, _, err := c.Users.Create(&User{Name: String("reader"), Password: String("s3cret")})
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(strings.Contains(buf.String(), `> {"name":"reader","password":"REDACTED"}`)).IsTrue()
+			g.Assert(strings.Contains(buf.String(), `< {"name":"reader","password":"REDACTED"}`)).IsTrue()
+			g.Assert(strings.Contains(buf.String(), "s3cret")).IsFalse()
+		})
+
+		g.It("- should leave the response body readable", func() {
+			c, _ := NewClient(s.URL, nil, WithDebug(buf, 5))
+
+			actual, _, err := c.Users.Get("reader")
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(actual.GetEmail()).Equal("reader@company.com")
+			g.Assert(strings.Contains(buf.String(), "< [truncated at 5 bytes]")).IsTrue()
+		})
+
+		g.It("- should fail without a writer", func() {
+			_, err := NewClient(s.URL, nil, WithDebug(nil, 1024))
+
+			g.Assert(err != nil).IsTrue()
+		})
+	})
+}
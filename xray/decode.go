@@ -0,0 +1,51 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xray
+
+import (
+	"context"
+
+	"github.com/target/go-arty/v2/internal/rest"
+)
+
+// DecodeMode controls how JSON response bodies are decoded.
+type DecodeMode = rest.DecodeMode
+
+const (
+	// DecodeLenient ignores response bodies that can't be decoded,
+	// since the API doesn't always return JSON. This is the default.
+	DecodeLenient = rest.DecodeLenient
+
+	// DecodeStrict returns a *DecodeError if a response body can't be decoded.
+	DecodeStrict = rest.DecodeStrict
+
+	// DecodeStrictUnknownFields is like DecodeStrict, and also returns a
+	// *DecodeError if a response body has fields the Go type doesn't have.
+	DecodeStrictUnknownFields = rest.DecodeStrictUnknownFields
+)
+
+// DecodeError reports a response body that couldn't be decoded.
+type DecodeError = rest.DecodeError
+
+// WithDecodeMode sets how the Client decodes response bodies.
+func WithDecodeMode(mode DecodeMode) Option {
+	return rest.WithDecodeMode(mode)
+}
+
+// ContextWithDecodeMode returns a context overriding the DecodeMode of the
+// Client for the calls made with it.
+func ContextWithDecodeMode(ctx context.Context, mode DecodeMode) context.Context {
+	return rest.ContextWithDecodeMode(ctx, mode)
+}
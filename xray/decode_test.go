@@ -0,0 +1,64 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xray
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/franela/goblin"
+)
+
+func Test_Decode(t *testing.T) {
+	// Create http test server returning a body that doesn't match the user type
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"name":["admin"]}`)
+	}))
+
+	// Create the clients to interact with the http test server
+	lenient, _ := NewClient(s.URL, nil)
+	strict, _ := NewClient(s.URL, nil, WithDecodeMode(DecodeStrict))
+
+	g := goblin.Goblin(t)
+	g.Describe("Decode", func() {
+		// Close http test server after we're done using it
+		g.After(func() {
+			s.Close()
+		})
+
+		g.It("- should return a decode error from the services in strict mode", func() {
+			_, _, err := lenient.Users.Get("admin")
+			g.Assert(err == nil).IsTrue()
+
+			_, _, err = strict.Users.Get("admin")
+
+			dErr := new(DecodeError)
+			g.Assert(errors.As(err, &dErr)).IsTrue()
+			g.Assert(dErr.Type).Equal("*xray.User")
+		})
+
+		g.It("- should set the mode for a call", func() {
+			ctx := ContextWithDecodeMode(context.Background(), DecodeStrict)
+			_, _, err := lenient.Users.GetWithContext(ctx, "admin")
+
+			g.Assert(err != nil).IsTrue()
+		})
+	})
+}
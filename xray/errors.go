@@ -0,0 +1,60 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xray
+
+import (
+	"net/http"
+
+	"github.com/target/go-arty/v2/internal/rest"
+)
+
+// ErrorDetail represents a single error returned by the Xray API.
+type ErrorDetail = rest.ErrorDetail
+
+// ErrorResponse reports an error caused by an API request.
+//
+// Xray reports most errors as {"error":..}, while some endpoints use the
+// Artifactory {"errors":[{"status":..,"message":..}]} shape or plain text.
+// Whichever shape was returned is decoded into Message or Errors.
+type ErrorResponse = rest.ErrorResponse
+
+// CheckResponse checks the API response for errors, and returns them if present.
+// A response is considered an error if it has a status code outside the 200 range.
+// The returned error is an *ErrorResponse holding the decoded error body, of which
+// only the first MiB is read. That part of the response body is left readable for
+// callers that want to inspect it further.
+func CheckResponse(r *http.Response) error {
+	return rest.CheckResponse(r)
+}
+
+// IsNotFound reports whether err is an *ErrorResponse for a 404 Not Found response.
+func IsNotFound(err error) bool {
+	return rest.IsNotFound(err)
+}
+
+// IsUnauthorized reports whether err is an *ErrorResponse for a 401 Unauthorized response.
+func IsUnauthorized(err error) bool {
+	return rest.IsUnauthorized(err)
+}
+
+// IsForbidden reports whether err is an *ErrorResponse for a 403 Forbidden response.
+func IsForbidden(err error) bool {
+	return rest.IsForbidden(err)
+}
+
+// IsConflict reports whether err is an *ErrorResponse for a 409 Conflict response.
+func IsConflict(err error) bool {
+	return rest.IsConflict(err)
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xray
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/franela/goblin"
+)
+
+func Test_Errors(t *testing.T) {
+	// Create http test server that replies with the errors of Xray
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/users/missing":
+			w.WriteHeader(404)
+			fmt.Fprint(w, `{"error":"Item not found"}`)
+		case "/api/v1/users/locked":
+			w.WriteHeader(409)
+			fmt.Fprint(w, `{"error":"Conflict"}`)
+		case "/api/v1/users/secret":
+			w.WriteHeader(403)
+			fmt.Fprint(w, "Forbidden\n")
+		default:
+			w.WriteHeader(401)
+			fmt.Fprint(w, `{"error":"Bad credentials"}`)
+		}
+	}))
+
+	// Create the client to interact with the http test server
+	c, _ := NewClient(s.URL, nil)
+
+	g := goblin.Goblin(t)
+	g.Describe("ErrorResponse", func() {
+		// Close http test server after we're done using it
+		g.After(func() {
+			s.Close()
+		})
+
+		g.It("- should return the errors of the services", func() {
+			_, _, err := c.Users.Get("missing")
+
+			var actual *ErrorResponse
+			g.Assert(errors.As(err, &actual)).IsTrue()
+			g.Assert(actual.GetMessage()).Equal("Item not found")
+			g.Assert(IsNotFound(err)).IsTrue()
+		})
+
+		g.It("- should report the status of the errors", func() {
+			_, _, err := c.Users.Get("locked")
+			g.Assert(IsConflict(err)).IsTrue()
+
+			_, _, err = c.Users.Get("secret")
+			g.Assert(IsForbidden(err)).IsTrue()
+
+			_, _, err = c.Users.Get("other")
+			g.Assert(IsUnauthorized(err)).IsTrue()
+			g.Assert(IsNotFound(err)).IsFalse()
+		})
+	})
+}
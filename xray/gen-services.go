@@ -0,0 +1,469 @@
+// Copyright (c) 2013 The go-github AUTHORS. All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//    * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//    * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//    * Neither the name of Google Inc. nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+//go:build ignore
+
+// gen-services generates an interface for every service of the Client,
+// along with a stub implementation of it for tests.
+//
+// It is meant to be used by the go-arty authors in conjunction with the
+// go generate tool before sending a commit to GitHub.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+const (
+	fileSuffix = "-services.go"
+
+	// contextSuffix is the suffix of the methods taking a context.
+	contextSuffix = "WithContext"
+)
+
+var (
+	verbose = flag.Bool("v", false, "Print verbose log messages")
+
+	sourceTmpl = template.Must(template.New("source").Parse(source))
+)
+
+func logf(fmt string, args ...interface{}) {
+	if *verbose {
+		log.Printf(fmt, args...)
+	}
+}
+
+func main() {
+	flag.Parse()
+	fset := token.NewFileSet()
+
+	pkgs, err := parser.ParseDir(fset, ".", sourceFilter, parser.ParseComments)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	for pkgName, pkg := range pkgs {
+		t := &templateData{
+			filename: pkgName + fileSuffix,
+			Package:  pkgName,
+			Imports:  map[string]string{},
+			fset:     fset,
+			services: map[string]*service{},
+		}
+		for filename, f := range pkg.Files {
+			logf("Processing %v...", filename)
+			t.processServices(f)
+		}
+		for filename, f := range pkg.Files {
+			logf("Processing methods of %v...", filename)
+			if err := t.processMethods(f); err != nil {
+				log.Fatal(err)
+			}
+		}
+		if err := t.dump(); err != nil {
+			log.Fatal(err)
+		}
+	}
+	logf("Done.")
+}
+
+// processServices records the services declared in f, which are the types defined as a service.
+func (t *templateData) processServices(f *ast.File) {
+	for _, decl := range f.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			ts, ok := spec.(*ast.TypeSpec)
+			if !ok {
+				continue
+			}
+			if id, ok := ts.Type.(*ast.Ident); !ok || id.Name != "service" || !ast.IsExported(ts.Name.Name) {
+				continue
+			}
+
+			// PermissionsServiceV2 is implemented by PermissionsV2API.
+			name := strings.Replace(ts.Name.Name, "Service", "", 1) + "API"
+			logf("Service %v implements %v.", ts.Name.Name, name)
+			t.services[ts.Name.Name] = &service{
+				Type:      ts.Name.Name,
+				Interface: name,
+				Stub:      strings.TrimSuffix(name, "API") + "Stub",
+			}
+		}
+	}
+}
+
+// processMethods records the exported methods of the services declared in f.
+func (t *templateData) processMethods(f *ast.File) error {
+	imports := map[string]string{}
+	for _, imp := range f.Imports {
+		path, err := strconv.Unquote(imp.Path.Value)
+		if err != nil {
+			return err
+		}
+
+		name := path[strings.LastIndex(path, "/")+1:]
+		if imp.Name != nil {
+			name = imp.Name.Name
+		}
+		imports[name] = path
+	}
+
+	for _, decl := range f.Decls {
+		fd, ok := decl.(*ast.FuncDecl)
+		if !ok || fd.Recv == nil || !fd.Name.IsExported() {
+			continue
+		}
+
+		star, ok := fd.Recv.List[0].Type.(*ast.StarExpr)
+		if !ok {
+			continue
+		}
+		id, ok := star.X.(*ast.Ident)
+		if !ok {
+			continue
+		}
+		s, ok := t.services[id.Name]
+		if !ok {
+			continue
+		}
+
+		m, err := t.newMethod(fd, imports)
+		if err != nil {
+			return err
+		}
+		s.Methods = append(s.Methods, m)
+	}
+
+	return nil
+}
+
+// newMethod returns the method declared by fd, adding the imports of its signature.
+func (t *templateData) newMethod(fd *ast.FuncDecl, imports map[string]string) (*method, error) {
+	m := &method{Name: fd.Name.Name}
+
+	if fd.Doc != nil {
+		for _, line := range strings.Split(strings.TrimSpace(fd.Doc.Text()), "\n") {
+			m.Doc = append(m.Doc, strings.TrimSpace("// "+line))
+		}
+	}
+
+	var params, args, types []string
+	for _, field := range fd.Type.Params.List {
+		typ, err := t.expr(field.Type, imports)
+		if err != nil {
+			return nil, err
+		}
+
+		names := field.Names
+		if len(names) == 0 {
+			names = []*ast.Ident{ast.NewIdent(fmt.Sprintf("p%d", len(args)))}
+		}
+		for _, name := range names {
+			params = append(params, name.Name+" "+typ)
+			types = append(types, typ)
+
+			arg := name.Name
+			if _, ok := field.Type.(*ast.Ellipsis); ok {
+				arg += "..."
+			}
+			args = append(args, arg)
+		}
+	}
+
+	var results []string
+	if fd.Type.Results != nil {
+		for _, field := range fd.Type.Results.List {
+			typ, err := t.expr(field.Type, imports)
+			if err != nil {
+				return nil, err
+			}
+
+			for i := 0; i < len(field.Names) || (i == 0 && len(field.Names) == 0); i++ {
+				results = append(results, typ)
+			}
+		}
+	}
+
+	m.Params = strings.Join(params, ", ")
+	m.Args = strings.Join(args, ", ")
+	m.params = types
+	m.Results = strings.Join(results, ", ")
+	if len(results) > 1 {
+		m.Results = "(" + m.Results + ")"
+	}
+	m.Return = len(results) > 0
+
+	return m, nil
+}
+
+// expr prints the provided type, adding the import of any package it refers to.
+func (t *templateData) expr(e ast.Expr, imports map[string]string) (string, error) {
+	var err error
+	ast.Inspect(e, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		if id, ok := sel.X.(*ast.Ident); ok {
+			path, ok := imports[id.Name]
+			if !ok {
+				err = fmt.Errorf("unknown package %v", id.Name)
+				return false
+			}
+			t.Imports[path] = path
+		}
+		return false
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, t.fset, e); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+func sourceFilter(fi os.FileInfo) bool {
+	return !strings.HasSuffix(fi.Name(), "_test.go") && !strings.HasSuffix(fi.Name(), fileSuffix)
+}
+
+func (t *templateData) dump() error {
+	if len(t.services) == 0 {
+		logf("No services for %v; skipping.", t.filename)
+		return nil
+	}
+
+	for _, s := range t.services {
+		sort.Sort(byName(s.Methods))
+		s.link()
+		t.Services = append(t.Services, s)
+	}
+	sort.Slice(t.Services, func(i, j int) bool { return t.Services[i].Interface < t.Services[j].Interface })
+
+	var buf bytes.Buffer
+	if err := sourceTmpl.Execute(&buf, t); err != nil {
+		return err
+	}
+	clean, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+
+	logf("Writing %v...", t.filename)
+	return ioutil.WriteFile(t.filename, clean, 0644)
+}
+
+type templateData struct {
+	filename string
+	Package  string
+	Imports  map[string]string
+	Services []*service
+
+	fset     *token.FileSet
+	services map[string]*service
+}
+
+type service struct {
+	Type      string
+	Interface string
+	Stub      string
+	Methods   []*method
+}
+
+type method struct {
+	Name    string
+	Doc     []string
+	Params  string
+	Args    string
+	Results string
+	Return  bool
+
+	// WithContext is the context variant of the method, if any.
+	WithContext *method
+	// Base is the method this method is the context variant of, if any.
+	Base *method
+
+	params []string
+}
+
+// link pairs every method with its context variant, when their signatures match.
+func (s *service) link() {
+	byName := map[string]*method{}
+	for _, m := range s.Methods {
+		byName[m.Name] = m
+	}
+
+	for _, m := range s.Methods {
+		c, ok := byName[m.Name+contextSuffix]
+		if !ok || c.Results != m.Results || len(c.params) != len(m.params)+1 || c.params[0] != "context.Context" {
+			continue
+		}
+		if strings.Join(c.params[1:], ", ") != strings.Join(m.params, ", ") {
+			continue
+		}
+
+		m.WithContext, c.Base = c, m
+	}
+}
+
+// BaseArgs returns the arguments of the method without its context.
+func (m *method) BaseArgs() string {
+	args := strings.SplitN(m.Args, ", ", 2)
+	if len(args) < 2 {
+		return ""
+	}
+	return args[1]
+}
+
+// ContextArgs returns the arguments of the method, preceded by a background context.
+func (m *method) ContextArgs() string {
+	if len(m.Args) == 0 {
+		return "context.Background()"
+	}
+	return "context.Background(), " + m.Args
+}
+
+type byName []*method
+
+func (b byName) Len() int           { return len(b) }
+func (b byName) Less(i, j int) bool { return b[i].Name < b[j].Name }
+func (b byName) Swap(i, j int)      { b[i], b[j] = b[j], b[i] }
+
+const source = `// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+// Code generated by gen-services; DO NOT EDIT.
+
+package {{.Package}}
+
+{{with .Imports}}
+import (
+  {{- range . -}}
+  "{{.}}"
+  {{end -}}
+)
+{{end}}
+{{range .Services}}
+{{$s := .}}
+// {{.Interface}} is the interface implemented by the {{.Type}}.
+// The field of the Client can be set to a fake, like a {{.Stub}}, in tests.
+type {{.Interface}} interface {
+  {{- range $i, $m := .Methods}}
+  {{- if $i}}
+  {{end}}
+  {{- range .Doc}}
+  {{.}}
+  {{- end}}
+  {{.Name}}({{.Params}}) {{.Results}}
+  {{- end}}
+}
+
+// {{.Stub}} is an implementation of the {{.Interface}} for tests.
+// Every method calls the field of the same name with a Func suffix.
+// When it is nil, the method calls the field of its context variant,
+// or of the method it is the context variant of, and panics if that
+// one is nil too.
+type {{.Stub}} struct {
+  {{- range .Methods}}
+  {{.Name}}Func func({{.Params}}) {{.Results}}
+  {{- end}}
+}
+{{range .Methods}}
+// {{.Name}} calls {{.Name}}Func.
+func (stub *{{$s.Stub}}) {{.Name}}({{.Params}}) {{.Results}} {
+  if stub.{{.Name}}Func != nil {
+    {{if .Return}}return {{end}}stub.{{.Name}}Func({{.Args}})
+    {{- if not .Return}}
+    return
+    {{- end}}
+  }
+  {{- with .WithContext}}
+  if stub.{{.Name}}Func != nil {
+    {{if .Return}}return {{end}}stub.{{.Name}}Func({{$.ContextArgsOf .Base}})
+    {{- if not .Return}}
+    return
+    {{- end}}
+  }
+  {{- end}}
+  {{- with .Base}}
+  if stub.{{.Name}}Func != nil {
+    {{if .Return}}return {{end}}stub.{{.Name}}Func({{$.BaseArgsOf .WithContext}})
+    {{- if not .Return}}
+    return
+    {{- end}}
+  }
+  {{- end}}
+  panic("{{$s.Stub}}.{{.Name}} not stubbed")
+}
+{{end}}
+var (
+  _ {{.Interface}} = (*{{.Type}})(nil)
+  _ {{.Interface}} = (*{{.Stub}})(nil)
+)
+{{end}}
+`
+
+// ContextArgsOf returns the arguments of m, preceded by a background context.
+func (t *templateData) ContextArgsOf(m *method) string {
+	return m.ContextArgs()
+}
+
+// BaseArgsOf returns the arguments of m without its context.
+func (t *templateData) BaseArgsOf(m *method) string {
+	return m.BaseArgs()
+}
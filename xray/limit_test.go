@@ -0,0 +1,105 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xray
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/franela/goblin"
+)
+
+func Test_Limit(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		inFlight int
+		peak     int
+		barrier  int
+	)
+
+	// Create http test server recording the requests in flight. The requests
+	// wait until barrier of them were in flight together, so that the peak
+	// doesn't depend on how fast they are sent.
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		if inFlight > peak {
+			peak = inFlight
+		}
+		mu.Unlock()
+
+		for deadline := time.Now().Add(time.Second); time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+			mu.Lock()
+			arrived := peak >= barrier
+			mu.Unlock()
+
+			if arrived {
+				break
+			}
+		}
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+
+		w.Write([]byte(`{}`))
+	}))
+
+	g := goblin.Goblin(t)
+	g.Describe("Limit", func() {
+		g.BeforeEach(func() {
+			inFlight, peak, barrier = 0, 0, 0
+		})
+
+		// Close http test server after we're done using it
+		g.After(func() {
+			s.Close()
+		})
+
+		// concurrently sends n requests to u concurrently.
+		concurrently := func(c *Client, u string, n int) {
+			var wg sync.WaitGroup
+			for i := 0; i < n; i++ {
+				wg.Add(1)
+
+				go func() {
+					defer wg.Done()
+					_, _ = c.Call("GET", u, nil, nil)
+				}()
+			}
+			wg.Wait()
+		}
+
+		g.It("- should limit the requests to a class of endpoints", func() {
+			c, _ := NewClient(s.URL, nil, WithEndpointLimit(ScanEndpoints, Limit{MaxInFlight: 1}))
+
+			barrier = 4
+			concurrently(c, "/api/v1/users", 4)
+			g.Assert(peak).Equal(4)
+
+			peak, barrier = 0, 0
+			concurrently(c, "/api/v1/summary/artifact", 4)
+			g.Assert(peak).Equal(1)
+		})
+
+		g.It("- should fail with an unknown endpoint class", func() {
+			_, err := NewClient(s.URL, nil, WithEndpointLimit("storage", Limit{Rate: 1}))
+			g.Assert(err != nil).IsTrue()
+		})
+	})
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xray
+
+import (
+	"time"
+
+	"github.com/target/go-arty/v2/internal/rest"
+)
+
+// FailoverMode is how requests are spread across the nodes of a Client.
+type FailoverMode = rest.FailoverMode
+
+const (
+	// RoundRobin spreads requests evenly across the healthy nodes.
+	RoundRobin = rest.RoundRobin
+
+	// PrimarySecondary sends requests to the first healthy node, in the order
+	// the nodes were provided.
+	PrimarySecondary = rest.PrimarySecondary
+)
+
+// NodeStatus represents the health of a node as tracked by the Client.
+type NodeStatus = rest.NodeStatus
+
+// WithNodes spreads requests across several nodes of an Xray HA cluster.
+// The baseUrl of the Client is the first node, followed by the provided URLs.
+//
+// A node that returns a connection error or a 5xx status is marked unhealthy,
+// and idempotent requests fail over to the next healthy node. An unhealthy node
+// is checked with SystemService.Ping once its cooldown is over, before it
+// receives requests again.
+func WithNodes(mode FailoverMode, urls ...string) Option {
+	return rest.WithNodes(mode, urls...)
+}
+
+// WithNodeCooldown sets how long a failed node is avoided before it is checked again.
+// Defaults to 30 seconds.
+func WithNodeCooldown(cooldown time.Duration) Option {
+	return rest.WithNodeCooldown(cooldown)
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xray
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/franela/goblin"
+)
+
+func Test_Nodes(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		paths   []string
+		healthy = true
+	)
+
+	// Create http test servers for a node that can fail, and a healthy one
+	first := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		paths = append(paths, r.URL.Path)
+		if !healthy {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		w.Write([]byte(`{"xray_version":"3.0.0"}`))
+	}))
+	second := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"xray_version":"3.0.1"}`))
+	}))
+
+	g := goblin.Goblin(t)
+	g.Describe("Nodes", func() {
+		g.BeforeEach(func() {
+			mu.Lock()
+			defer mu.Unlock()
+
+			paths, healthy = nil, true
+		})
+
+		// Close http test servers after we're done using them
+		g.After(func() {
+			first.Close()
+			second.Close()
+		})
+
+		g.It("- should fail over to a healthy node", func() {
+			c, _ := NewClient(first.URL, nil, WithNodes(PrimarySecondary, second.URL))
+
+			mu.Lock()
+			healthy = false
+			mu.Unlock()
+
+			v, _, err := c.System.Version()
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(v.GetXrayVersion()).Equal("3.0.1")
+			g.Assert(c.Nodes()).Equal([]NodeStatus{{URL: first.URL, Healthy: false}, {URL: second.URL, Healthy: true}})
+		})
+
+		g.It("- should check the nodes with System.Ping", func() {
+			c, _ := NewClient(first.URL, nil, WithNodes(RoundRobin, second.URL))
+
+			statuses := c.CheckNodes()
+
+			g.Assert(statuses[0].Healthy).IsTrue()
+			g.Assert(paths).Equal([]string{"/api/v1/system/ping"})
+		})
+	})
+}
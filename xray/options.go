@@ -0,0 +1,64 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xray
+
+import (
+	"crypto/tls"
+	"net/http"
+	"time"
+
+	"github.com/target/go-arty/v2/internal/rest"
+)
+
+// Option configures a Client created with NewClient.
+type Option = rest.Option
+
+// WithTimeout sets the time limit for requests made by the Client,
+// including reading the response body.
+func WithTimeout(timeout time.Duration) Option {
+	return rest.WithTimeout(timeout)
+}
+
+// WithCABundle trusts the PEM encoded certificates in the provided bundle,
+// in addition to the system certificate pool.
+func WithCABundle(pem []byte) Option {
+	return rest.WithCABundle(pem)
+}
+
+// WithClientCertificate presents the provided certificate for mutual TLS authentication.
+func WithClientCertificate(cert tls.Certificate) Option {
+	return rest.WithClientCertificate(cert)
+}
+
+// WithProxy sends requests through the provided HTTP proxy.
+func WithProxy(proxyURL string) Option {
+	return rest.WithProxy(proxyURL)
+}
+
+// WithMaxIdleConns sets the maximum number of idle connections kept open to Xray.
+func WithMaxIdleConns(n int) Option {
+	return rest.WithMaxIdleConns(n)
+}
+
+// WithUserAgentSuffix appends the provided suffix to the user agent of the Client.
+func WithUserAgentSuffix(suffix string) Option {
+	return rest.WithUserAgentSuffix(suffix)
+}
+
+// WithHeaders sends the provided headers with every request,
+// unless the request sets them itself.
+func WithHeaders(headers http.Header) Option {
+	return rest.WithHeaders(headers)
+}
@@ -0,0 +1,23 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xray
+
+import "github.com/target/go-arty/v2/internal/rest"
+
+// escapePath escapes each segment of the provided slash separated path,
+// so that it can be placed inside a URL path.
+func escapePath(path string) string {
+	return rest.EscapePath(path)
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xray
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func Test_servicePaths(t *testing.T) {
+	var (
+		mu  sync.Mutex
+		uri string
+	)
+
+	// Create http test server recording the escaped URI of the requests
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		uri = r.URL.RequestURI()
+		mu.Unlock()
+
+		w.Write([]byte(`{}`))
+	}))
+	defer s.Close()
+
+	c, _ := NewClient(s.URL, nil)
+
+	name := "my name#1?;v"
+
+	var tests = []struct {
+		call func()
+		out  string
+	}{
+		{func() { c.Users.Get(name) }, "/api/v1/users/my%20name%231%3F%3Bv"},
+		{func() { c.Users.Delete(name) }, "/api/v1/users/my%20name%231%3F%3Bv"},
+		{func() { c.Summary.Build(name, 2) }, "/api/v1/summary/build?build_name=my+name%231%3F%3Bv&build_number=2"},
+	}
+
+	for i, tt := range tests {
+		tt.call()
+
+		mu.Lock()
+		got := uri
+		mu.Unlock()
+
+		if got != tt.out {
+			t.Errorf("service URI #%d is %q, want %q", i, got, tt.out)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xray
+
+import "github.com/target/go-arty/v2/internal/rest"
+
+// RetryPolicy configures how the Client retries failed requests.
+//
+// A request is retried when the transport fails or the response has one of
+// the RetryableStatuses. Only idempotent requests are retried unless
+// RetryNonIdempotent is set.
+//
+// The body of a retried request is replayed on every attempt. Seekable
+// bodies are rewound, while other bodies are buffered in memory up to
+// MaxBufferedBody; a larger body is sent once, without retries.
+type RetryPolicy = rest.RetryPolicy
+
+// MaxBufferedBody is the size of the largest non-seekable request body
+// buffered in memory to be replayed by retries, token refreshes and failover.
+const MaxBufferedBody = rest.MaxBufferedBody
+
+// WithRetryPolicy retries the failed requests of the Client as allowed by the provided policy.
+func WithRetryPolicy(policy *RetryPolicy) Option {
+	return rest.WithRetryPolicy(policy)
+}
+
+// DefaultRetryPolicy returns a RetryPolicy that makes up to 4 attempts
+// for 429, 502, 503 and 504 responses and transport errors.
+func DefaultRetryPolicy() *RetryPolicy {
+	return rest.DefaultRetryPolicy()
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xray
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/franela/goblin"
+)
+
+func Test_Retry(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		attempts int
+	)
+
+	// Create http test server that fails the first two requests
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		attempts++
+		if attempts <= 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		w.Write([]byte(`{"status":"pong"}`))
+	}))
+
+	policy := DefaultRetryPolicy()
+	policy.MinBackoff = time.Millisecond
+	policy.MaxBackoff = 5 * time.Millisecond
+
+	g := goblin.Goblin(t)
+	g.Describe("RetryPolicy", func() {
+		g.BeforeEach(func() {
+			attempts = 0
+		})
+
+		// Close http test server after we're done using it
+		g.After(func() {
+			s.Close()
+		})
+
+		g.It("- should retry the calls of the services", func() {
+			c, _ := NewClient(s.URL, nil)
+			c.RetryPolicy = policy
+
+			_, _, err := c.System.Ping()
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(attempts).Equal(3)
+		})
+
+		g.It("- should retry with the policy passed as an option", func() {
+			c, _ := NewClient(s.URL, nil, WithRetryPolicy(policy))
+
+			_, _, err := c.System.Ping()
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(attempts).Equal(3)
+		})
+	})
+}
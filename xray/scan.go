@@ -14,6 +14,8 @@
 
 package xray
 
+import "context"
+
 // ScanService handles communication with the scan related
 // methods of the Xray API.
 //
@@ -153,10 +155,17 @@ type ScanLicense struct {
 //
 // Docs: https://www.jfrog.com/confluence/display/XRAY/Xray+REST+API#XrayRESTAPI-ScanArtifact
 func (s *ScanService) Artifact(scan *ScanArtifactRequest) (*ScanArtifactResponse, *Response, error) {
+	return s.ArtifactWithContext(context.Background(), scan)
+}
+
+// ArtifactWithContext invokes scanning of an artifact using the provided context.
+func (s *ScanService) ArtifactWithContext(ctx context.Context, scan *ScanArtifactRequest) (*ScanArtifactResponse, *Response, error) {
+	ctx = withOperation(ctx, "Scan.Artifact", "/api/v1/scanArtifact")
+
 	u := "/api/v1/scanArtifact"
 	v := new(ScanArtifactResponse)
 
-	resp, err := s.client.Call("POST", u, scan, v)
+	resp, err := s.client.CallContext(ctx, "POST", u, scan, v)
 	return v, resp, err
 }
 
@@ -164,9 +173,16 @@ func (s *ScanService) Artifact(scan *ScanArtifactRequest) (*ScanArtifactResponse
 //
 // Docs: https://www.jfrog.com/confluence/display/XRAY/Xray+REST+API#XrayRESTAPI-ScanBuild
 func (s *ScanService) Build(scan *ScanBuildRequest) (*ScanBuildResponse, *Response, error) {
+	return s.BuildWithContext(context.Background(), scan)
+}
+
+// BuildWithContext invokes scanning of a build that was uploaded to Artifactory as requested by a CI server using the provided context.
+func (s *ScanService) BuildWithContext(ctx context.Context, scan *ScanBuildRequest) (*ScanBuildResponse, *Response, error) {
+	ctx = withOperation(ctx, "Scan.Build", "/api/v1/scanBuild")
+
 	u := "/api/v1/scanBuild"
 	v := new(ScanBuildResponse)
 
-	resp, err := s.client.Call("POST", u, scan, v)
+	resp, err := s.client.CallContext(ctx, "POST", u, scan, v)
 	return v, resp, err
 }
@@ -76,12 +76,12 @@ func Test_Scan(t *testing.T) {
 					},
 					Alerts: &[]ScanAlert{
 						ScanAlert{
-							Created:     &Timestamp{time.Date(2011, time.November, 11, 11, 11, 11, 0, time.UTC)},
+							Created:     &Timestamp{Time: time.Date(2011, time.November, 11, 11, 11, 11, 0, time.UTC)},
 							TopSeverity: String("string"),
 							WatchName:   String("string"),
 							Issues: &[]ScanIssue{
 								ScanIssue{
-									Created:     &Timestamp{time.Date(2012, time.December, 12, 12, 12, 12, 0, time.UTC)},
+									Created:     &Timestamp{Time: time.Date(2012, time.December, 12, 12, 12, 12, 0, time.UTC)},
 									Cve:         String("string"),
 									Description: String("string"),
 									Provider:    String("string"),
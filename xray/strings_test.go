@@ -79,7 +79,7 @@ func TestStringify(t *testing.T) {
 
 		// actual Xray structs
 		{
-			Timestamp{time.Date(2006, time.January, 02, 15, 04, 05, 0, time.UTC)},
+			Timestamp{Time: time.Date(2006, time.January, 02, 15, 04, 05, 0, time.UTC)},
 			`xray.Timestamp{2006-01-02 15:04:05 +0000 UTC}`,
 		},
 		{
@@ -115,7 +115,7 @@ func TestString(t *testing.T) {
 		{SummaryResponse{Errors: &[]SummaryError{SummaryError{Error: String("test")}}}, `xray.SummaryResponse{Errors:[xray.SummaryError{Error:"test"}]}`},
 		{Ping{Status: String("test")}, `xray.Ping{Status:"test"}`},
 		{Versions{XrayVersion: String("test")}, `xray.Versions{XrayVersion:"test"}`},
-		{Timestamp{time.Date(2006, time.January, 02, 15, 04, 05, 0, time.UTC)}, `2006-01-02 15:04:05 +0000 UTC`},
+		{Timestamp{Time: time.Date(2006, time.January, 02, 15, 04, 05, 0, time.UTC)}, `2006-01-02 15:04:05 +0000 UTC`},
 		{User{Name: String("test")}, `xray.User{Name:"test"}`},
 	}
 
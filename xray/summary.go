@@ -14,7 +14,11 @@
 
 package xray
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
 
 // SummaryService handles communication with the summary related
 // methods of the Xray API.
@@ -92,10 +96,17 @@ type SummaryError struct {
 //
 // Docs: https://www.jfrog.com/confluence/display/XRAY/Xray+REST+API#XrayRESTAPI-ArtifactSummary
 func (s *SummaryService) Artifact(summary *SummaryArtifactRequest) (*SummaryResponse, *Response, error) {
+	return s.ArtifactWithContext(context.Background(), summary)
+}
+
+// ArtifactWithContext provides details about any artifact specified by path identifiers or checksum using the provided context.
+func (s *SummaryService) ArtifactWithContext(ctx context.Context, summary *SummaryArtifactRequest) (*SummaryResponse, *Response, error) {
+	ctx = withOperation(ctx, "Summary.Artifact", "/api/v1/summary/artifact")
+
 	u := "/api/v1/summary/artifact"
 	v := new(SummaryResponse)
 
-	resp, err := s.client.Call("POST", u, summary, v)
+	resp, err := s.client.CallContext(ctx, "POST", u, summary, v)
 	return v, resp, err
 }
 
@@ -103,9 +114,16 @@ func (s *SummaryService) Artifact(summary *SummaryArtifactRequest) (*SummaryResp
 //
 // Docs: https://www.jfrog.com/confluence/display/XRAY/Xray+REST+API#XrayRESTAPI-BuildSummary
 func (s *SummaryService) Build(buildName string, buildNumber int) (*SummaryResponse, *Response, error) {
-	u := fmt.Sprintf("/api/v1/summary/build?build_name=%s&build_number=%d", buildName, buildNumber)
+	return s.BuildWithContext(context.Background(), buildName, buildNumber)
+}
+
+// BuildWithContext provides details about any build specified by path identifiers or checksum using the provided context.
+func (s *SummaryService) BuildWithContext(ctx context.Context, buildName string, buildNumber int) (*SummaryResponse, *Response, error) {
+	ctx = withOperation(ctx, "Summary.Build", "/api/v1/summary/build")
+
+	u := fmt.Sprintf("/api/v1/summary/build?build_name=%s&build_number=%d", url.QueryEscape(buildName), buildNumber)
 	v := new(SummaryResponse)
 
-	resp, err := s.client.Call("GET", u, nil, v)
+	resp, err := s.client.CallContext(ctx, "GET", u, nil, v)
 	return v, resp, err
 }
@@ -14,6 +14,8 @@
 
 package xray
 
+import "context"
+
 // SystemService handles communication with the system related
 // methods of the Xray API.
 //
@@ -43,10 +45,17 @@ func (v Versions) String() string {
 //
 // Docs: https://www.jfrog.com/confluence/display/XRAY/Xray+REST+API#XrayRESTAPI-PingRequest
 func (s *SystemService) Ping() (*Ping, *Response, error) {
+	return s.PingWithContext(context.Background())
+}
+
+// PingWithContext returns a simple status response using the provided context.
+func (s *SystemService) PingWithContext(ctx context.Context) (*Ping, *Response, error) {
+	ctx = withOperation(ctx, "System.Ping", "/api/v1/system/ping")
+
 	u := "/api/v1/system/ping"
 	v := new(Ping)
 
-	resp, err := s.client.Call("GET", u, nil, v)
+	resp, err := s.client.CallContext(ctx, "GET", u, nil, v)
 	return v, resp, err
 }
 
@@ -54,9 +63,16 @@ func (s *SystemService) Ping() (*Ping, *Response, error) {
 //
 // Docs: https://www.jfrog.com/confluence/display/XRAY/Xray+REST+API#XrayRESTAPI-GetVersion
 func (s *SystemService) Version() (*Versions, *Response, error) {
+	return s.VersionWithContext(context.Background())
+}
+
+// VersionWithContext returns information about the current version using the provided context.
+func (s *SystemService) VersionWithContext(ctx context.Context) (*Versions, *Response, error) {
+	ctx = withOperation(ctx, "System.Version", "/api/v1/system/version")
+
 	u := "/api/v1/system/version"
 	v := new(Versions)
 
-	resp, err := s.client.Call("GET", u, nil, v)
+	resp, err := s.client.CallContext(ctx, "GET", u, nil, v)
 	return v, resp, err
 }
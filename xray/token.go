@@ -0,0 +1,47 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xray
+
+import (
+	"time"
+
+	"github.com/target/go-arty/v2/internal/rest"
+)
+
+// TokenSource supplies access tokens for Bearer authentication.
+//
+// Token is called before every request, so implementations should cache
+// the token and refresh it before it expires. Refresh is called once when
+// a request fails with 401 Unauthorized, before the request is retried.
+type TokenSource = rest.TokenSource
+
+// AccessToken represents an access token issued by Artifactory for the JFrog Platform.
+//
+// Docs: https://www.jfrog.com/confluence/display/JFROG/Artifactory+REST+API#ArtifactoryRESTAPI-CreateToken
+type AccessToken = rest.AccessToken
+
+// RefreshTokenSource is a TokenSource that refreshes an access token with
+// its refresh token, using the token endpoint of the Artifactory instance
+// that issued it.
+//
+// Docs: https://www.jfrog.com/confluence/display/JFROG/Artifactory+REST+API#ArtifactoryRESTAPI-RefreshToken
+type RefreshTokenSource = rest.RefreshTokenSource
+
+// NewRefreshTokenSource returns a TokenSource for the provided access and refresh tokens.
+// expiry is when the access token expires; if zero, the token is only refreshed
+// after it is rejected.
+func NewRefreshTokenSource(tokenURL, accessToken, refreshToken string, expiry time.Time) *RefreshTokenSource {
+	return rest.NewRefreshTokenSource(tokenURL, accessToken, refreshToken, expiry)
+}
@@ -14,7 +14,10 @@
 
 package xray
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // UsersService handles communication with the user related
 // methods of the Xray API.
@@ -40,10 +43,17 @@ func (u User) String() string {
 //
 // Docs: https://www.jfrog.com/confluence/display/XRAY/Xray+REST+API#XrayRESTAPI-GetUsers/GetUser
 func (s *UsersService) GetAll() (*[]User, *Response, error) {
+	return s.GetAllWithContext(context.Background())
+}
+
+// GetAllWithContext returns a list of all users using the provided context.
+func (s *UsersService) GetAllWithContext(ctx context.Context) (*[]User, *Response, error) {
+	ctx = withOperation(ctx, "Users.GetAll", "/api/v1/users")
+
 	u := "/api/v1/users"
 	v := new([]User)
 
-	resp, err := s.client.Call("GET", u, nil, v)
+	resp, err := s.client.CallContext(ctx, "GET", u, nil, v)
 	return v, resp, err
 }
 
@@ -51,10 +61,17 @@ func (s *UsersService) GetAll() (*[]User, *Response, error) {
 //
 // Docs: https://www.jfrog.com/confluence/display/XRAY/Xray+REST+API#XrayRESTAPI-GetUsers/GetUser
 func (s *UsersService) Get(user string) (*User, *Response, error) {
-	u := fmt.Sprintf("/api/v1/users/%s", user)
+	return s.GetWithContext(context.Background(), user)
+}
+
+// GetWithContext returns the provided user using the provided context.
+func (s *UsersService) GetWithContext(ctx context.Context, user string) (*User, *Response, error) {
+	ctx = withOperation(ctx, "Users.Get", "/api/v1/users/{user}")
+
+	u := fmt.Sprintf("/api/v1/users/%s", escapePath(user))
 	v := new(User)
 
-	resp, err := s.client.Call("GET", u, nil, v)
+	resp, err := s.client.CallContext(ctx, "GET", u, nil, v)
 	return v, resp, err
 }
 
@@ -62,10 +79,17 @@ func (s *UsersService) Get(user string) (*User, *Response, error) {
 //
 // Docs: https://www.jfrog.com/confluence/display/XRAY/Xray+REST+API#XrayRESTAPI-CreateUser
 func (s *UsersService) Create(user *User) (*User, *Response, error) {
+	return s.CreateWithContext(context.Background(), user)
+}
+
+// CreateWithContext constructs a new User with the provided details using the provided context.
+func (s *UsersService) CreateWithContext(ctx context.Context, user *User) (*User, *Response, error) {
+	ctx = withOperation(ctx, "Users.Create", "/api/v1/users")
+
 	u := "/api/v1/users"
 	v := new(User)
 
-	resp, err := s.client.Call("POST", u, user, v)
+	resp, err := s.client.CallContext(ctx, "POST", u, user, v)
 	return v, resp, err
 }
 
@@ -73,10 +97,17 @@ func (s *UsersService) Create(user *User) (*User, *Response, error) {
 //
 // Docs: https://www.jfrog.com/confluence/display/XRAY/Xray+REST+API#XrayRESTAPI-UpdateUser
 func (s *UsersService) Update(user *User) (*string, *Response, error) {
-	u := fmt.Sprintf("/api/v1/users/%s", *user.Name)
+	return s.UpdateWithContext(context.Background(), user)
+}
+
+// UpdateWithContext modifies a user with the provided details using the provided context.
+func (s *UsersService) UpdateWithContext(ctx context.Context, user *User) (*string, *Response, error) {
+	ctx = withOperation(ctx, "Users.Update", "/api/v1/users/{user}")
+
+	u := fmt.Sprintf("/api/v1/users/%s", escapePath(*user.Name))
 	v := new(string)
 
-	resp, err := s.client.Call("PUT", u, user, v)
+	resp, err := s.client.CallContext(ctx, "PUT", u, user, v)
 	return v, resp, err
 }
 
@@ -84,9 +115,16 @@ func (s *UsersService) Update(user *User) (*string, *Response, error) {
 //
 // Docs: https://www.jfrog.com/confluence/display/XRAY/Xray+REST+API#XrayRESTAPI-DeleteUser
 func (s *UsersService) Delete(user string) (*string, *Response, error) {
-	u := fmt.Sprintf("/api/v1/users/%s", user)
+	return s.DeleteWithContext(context.Background(), user)
+}
+
+// DeleteWithContext removes the provided user using the provided context.
+func (s *UsersService) DeleteWithContext(ctx context.Context, user string) (*string, *Response, error) {
+	ctx = withOperation(ctx, "Users.Delete", "/api/v1/users/{user}")
+
+	u := fmt.Sprintf("/api/v1/users/%s", escapePath(user))
 	v := new(string)
 
-	resp, err := s.client.Call("DELETE", u, nil, v)
+	resp, err := s.client.CallContext(ctx, "DELETE", u, nil, v)
 	return v, resp, err
 }
@@ -0,0 +1,440 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+// Code generated by gen-services; DO NOT EDIT.
+
+package xray
+
+import (
+	"context"
+)
+
+// ScanAPI is the interface implemented by the ScanService.
+// The field of the Client can be set to a fake, like a ScanStub, in tests.
+type ScanAPI interface {
+	// Artifact invokes scanning of an artifact.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/XRAY/Xray+REST+API#XrayRESTAPI-ScanArtifact
+	Artifact(scan *ScanArtifactRequest) (*ScanArtifactResponse, *Response, error)
+
+	// ArtifactWithContext invokes scanning of an artifact using the provided context.
+	ArtifactWithContext(ctx context.Context, scan *ScanArtifactRequest) (*ScanArtifactResponse, *Response, error)
+
+	// Build invokes scanning of a build that was uploaded to Artifactory as requested by a CI server.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/XRAY/Xray+REST+API#XrayRESTAPI-ScanBuild
+	Build(scan *ScanBuildRequest) (*ScanBuildResponse, *Response, error)
+
+	// BuildWithContext invokes scanning of a build that was uploaded to Artifactory as requested by a CI server using the provided context.
+	BuildWithContext(ctx context.Context, scan *ScanBuildRequest) (*ScanBuildResponse, *Response, error)
+}
+
+// ScanStub is an implementation of the ScanAPI for tests.
+// Every method calls the field of the same name with a Func suffix.
+// When it is nil, the method calls the field of its context variant,
+// or of the method it is the context variant of, and panics if that
+// one is nil too.
+type ScanStub struct {
+	ArtifactFunc            func(scan *ScanArtifactRequest) (*ScanArtifactResponse, *Response, error)
+	ArtifactWithContextFunc func(ctx context.Context, scan *ScanArtifactRequest) (*ScanArtifactResponse, *Response, error)
+	BuildFunc               func(scan *ScanBuildRequest) (*ScanBuildResponse, *Response, error)
+	BuildWithContextFunc    func(ctx context.Context, scan *ScanBuildRequest) (*ScanBuildResponse, *Response, error)
+}
+
+// Artifact calls ArtifactFunc.
+func (stub *ScanStub) Artifact(scan *ScanArtifactRequest) (*ScanArtifactResponse, *Response, error) {
+	if stub.ArtifactFunc != nil {
+		return stub.ArtifactFunc(scan)
+	}
+	if stub.ArtifactWithContextFunc != nil {
+		return stub.ArtifactWithContextFunc(context.Background(), scan)
+	}
+	panic("ScanStub.Artifact not stubbed")
+}
+
+// ArtifactWithContext calls ArtifactWithContextFunc.
+func (stub *ScanStub) ArtifactWithContext(ctx context.Context, scan *ScanArtifactRequest) (*ScanArtifactResponse, *Response, error) {
+	if stub.ArtifactWithContextFunc != nil {
+		return stub.ArtifactWithContextFunc(ctx, scan)
+	}
+	if stub.ArtifactFunc != nil {
+		return stub.ArtifactFunc(scan)
+	}
+	panic("ScanStub.ArtifactWithContext not stubbed")
+}
+
+// Build calls BuildFunc.
+func (stub *ScanStub) Build(scan *ScanBuildRequest) (*ScanBuildResponse, *Response, error) {
+	if stub.BuildFunc != nil {
+		return stub.BuildFunc(scan)
+	}
+	if stub.BuildWithContextFunc != nil {
+		return stub.BuildWithContextFunc(context.Background(), scan)
+	}
+	panic("ScanStub.Build not stubbed")
+}
+
+// BuildWithContext calls BuildWithContextFunc.
+func (stub *ScanStub) BuildWithContext(ctx context.Context, scan *ScanBuildRequest) (*ScanBuildResponse, *Response, error) {
+	if stub.BuildWithContextFunc != nil {
+		return stub.BuildWithContextFunc(ctx, scan)
+	}
+	if stub.BuildFunc != nil {
+		return stub.BuildFunc(scan)
+	}
+	panic("ScanStub.BuildWithContext not stubbed")
+}
+
+var (
+	_ ScanAPI = (*ScanService)(nil)
+	_ ScanAPI = (*ScanStub)(nil)
+)
+
+// SummaryAPI is the interface implemented by the SummaryService.
+// The field of the Client can be set to a fake, like a SummaryStub, in tests.
+type SummaryAPI interface {
+	// Artifact provides details about any artifact specified by path identifiers or checksum.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/XRAY/Xray+REST+API#XrayRESTAPI-ArtifactSummary
+	Artifact(summary *SummaryArtifactRequest) (*SummaryResponse, *Response, error)
+
+	// ArtifactWithContext provides details about any artifact specified by path identifiers or checksum using the provided context.
+	ArtifactWithContext(ctx context.Context, summary *SummaryArtifactRequest) (*SummaryResponse, *Response, error)
+
+	// Build provides details about any build specified by path identifiers or checksum.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/XRAY/Xray+REST+API#XrayRESTAPI-BuildSummary
+	Build(buildName string, buildNumber int) (*SummaryResponse, *Response, error)
+
+	// BuildWithContext provides details about any build specified by path identifiers or checksum using the provided context.
+	BuildWithContext(ctx context.Context, buildName string, buildNumber int) (*SummaryResponse, *Response, error)
+}
+
+// SummaryStub is an implementation of the SummaryAPI for tests.
+// Every method calls the field of the same name with a Func suffix.
+// When it is nil, the method calls the field of its context variant,
+// or of the method it is the context variant of, and panics if that
+// one is nil too.
+type SummaryStub struct {
+	ArtifactFunc            func(summary *SummaryArtifactRequest) (*SummaryResponse, *Response, error)
+	ArtifactWithContextFunc func(ctx context.Context, summary *SummaryArtifactRequest) (*SummaryResponse, *Response, error)
+	BuildFunc               func(buildName string, buildNumber int) (*SummaryResponse, *Response, error)
+	BuildWithContextFunc    func(ctx context.Context, buildName string, buildNumber int) (*SummaryResponse, *Response, error)
+}
+
+// Artifact calls ArtifactFunc.
+func (stub *SummaryStub) Artifact(summary *SummaryArtifactRequest) (*SummaryResponse, *Response, error) {
+	if stub.ArtifactFunc != nil {
+		return stub.ArtifactFunc(summary)
+	}
+	if stub.ArtifactWithContextFunc != nil {
+		return stub.ArtifactWithContextFunc(context.Background(), summary)
+	}
+	panic("SummaryStub.Artifact not stubbed")
+}
+
+// ArtifactWithContext calls ArtifactWithContextFunc.
+func (stub *SummaryStub) ArtifactWithContext(ctx context.Context, summary *SummaryArtifactRequest) (*SummaryResponse, *Response, error) {
+	if stub.ArtifactWithContextFunc != nil {
+		return stub.ArtifactWithContextFunc(ctx, summary)
+	}
+	if stub.ArtifactFunc != nil {
+		return stub.ArtifactFunc(summary)
+	}
+	panic("SummaryStub.ArtifactWithContext not stubbed")
+}
+
+// Build calls BuildFunc.
+func (stub *SummaryStub) Build(buildName string, buildNumber int) (*SummaryResponse, *Response, error) {
+	if stub.BuildFunc != nil {
+		return stub.BuildFunc(buildName, buildNumber)
+	}
+	if stub.BuildWithContextFunc != nil {
+		return stub.BuildWithContextFunc(context.Background(), buildName, buildNumber)
+	}
+	panic("SummaryStub.Build not stubbed")
+}
+
+// BuildWithContext calls BuildWithContextFunc.
+func (stub *SummaryStub) BuildWithContext(ctx context.Context, buildName string, buildNumber int) (*SummaryResponse, *Response, error) {
+	if stub.BuildWithContextFunc != nil {
+		return stub.BuildWithContextFunc(ctx, buildName, buildNumber)
+	}
+	if stub.BuildFunc != nil {
+		return stub.BuildFunc(buildName, buildNumber)
+	}
+	panic("SummaryStub.BuildWithContext not stubbed")
+}
+
+var (
+	_ SummaryAPI = (*SummaryService)(nil)
+	_ SummaryAPI = (*SummaryStub)(nil)
+)
+
+// SystemAPI is the interface implemented by the SystemService.
+// The field of the Client can be set to a fake, like a SystemStub, in tests.
+type SystemAPI interface {
+	// Ping returns a simple status response.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/XRAY/Xray+REST+API#XrayRESTAPI-PingRequest
+	Ping() (*Ping, *Response, error)
+
+	// PingWithContext returns a simple status response using the provided context.
+	PingWithContext(ctx context.Context) (*Ping, *Response, error)
+
+	// Version returns information about the current version.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/XRAY/Xray+REST+API#XrayRESTAPI-GetVersion
+	Version() (*Versions, *Response, error)
+
+	// VersionWithContext returns information about the current version using the provided context.
+	VersionWithContext(ctx context.Context) (*Versions, *Response, error)
+}
+
+// SystemStub is an implementation of the SystemAPI for tests.
+// Every method calls the field of the same name with a Func suffix.
+// When it is nil, the method calls the field of its context variant,
+// or of the method it is the context variant of, and panics if that
+// one is nil too.
+type SystemStub struct {
+	PingFunc               func() (*Ping, *Response, error)
+	PingWithContextFunc    func(ctx context.Context) (*Ping, *Response, error)
+	VersionFunc            func() (*Versions, *Response, error)
+	VersionWithContextFunc func(ctx context.Context) (*Versions, *Response, error)
+}
+
+// Ping calls PingFunc.
+func (stub *SystemStub) Ping() (*Ping, *Response, error) {
+	if stub.PingFunc != nil {
+		return stub.PingFunc()
+	}
+	if stub.PingWithContextFunc != nil {
+		return stub.PingWithContextFunc(context.Background())
+	}
+	panic("SystemStub.Ping not stubbed")
+}
+
+// PingWithContext calls PingWithContextFunc.
+func (stub *SystemStub) PingWithContext(ctx context.Context) (*Ping, *Response, error) {
+	if stub.PingWithContextFunc != nil {
+		return stub.PingWithContextFunc(ctx)
+	}
+	if stub.PingFunc != nil {
+		return stub.PingFunc()
+	}
+	panic("SystemStub.PingWithContext not stubbed")
+}
+
+// Version calls VersionFunc.
+func (stub *SystemStub) Version() (*Versions, *Response, error) {
+	if stub.VersionFunc != nil {
+		return stub.VersionFunc()
+	}
+	if stub.VersionWithContextFunc != nil {
+		return stub.VersionWithContextFunc(context.Background())
+	}
+	panic("SystemStub.Version not stubbed")
+}
+
+// VersionWithContext calls VersionWithContextFunc.
+func (stub *SystemStub) VersionWithContext(ctx context.Context) (*Versions, *Response, error) {
+	if stub.VersionWithContextFunc != nil {
+		return stub.VersionWithContextFunc(ctx)
+	}
+	if stub.VersionFunc != nil {
+		return stub.VersionFunc()
+	}
+	panic("SystemStub.VersionWithContext not stubbed")
+}
+
+var (
+	_ SystemAPI = (*SystemService)(nil)
+	_ SystemAPI = (*SystemStub)(nil)
+)
+
+// UsersAPI is the interface implemented by the UsersService.
+// The field of the Client can be set to a fake, like a UsersStub, in tests.
+type UsersAPI interface {
+	// Create constructs a new User with the provided details.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/XRAY/Xray+REST+API#XrayRESTAPI-CreateUser
+	Create(user *User) (*User, *Response, error)
+
+	// CreateWithContext constructs a new User with the provided details using the provided context.
+	CreateWithContext(ctx context.Context, user *User) (*User, *Response, error)
+
+	// Delete removes the provided user.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/XRAY/Xray+REST+API#XrayRESTAPI-DeleteUser
+	Delete(user string) (*string, *Response, error)
+
+	// DeleteWithContext removes the provided user using the provided context.
+	DeleteWithContext(ctx context.Context, user string) (*string, *Response, error)
+
+	// Get returns the provided user.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/XRAY/Xray+REST+API#XrayRESTAPI-GetUsers/GetUser
+	Get(user string) (*User, *Response, error)
+
+	// GetAll returns a list of all users.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/XRAY/Xray+REST+API#XrayRESTAPI-GetUsers/GetUser
+	GetAll() (*[]User, *Response, error)
+
+	// GetAllWithContext returns a list of all users using the provided context.
+	GetAllWithContext(ctx context.Context) (*[]User, *Response, error)
+
+	// GetWithContext returns the provided user using the provided context.
+	GetWithContext(ctx context.Context, user string) (*User, *Response, error)
+
+	// Update modifies a user with the provided details.
+	//
+	// Docs: https://www.jfrog.com/confluence/display/XRAY/Xray+REST+API#XrayRESTAPI-UpdateUser
+	Update(user *User) (*string, *Response, error)
+
+	// UpdateWithContext modifies a user with the provided details using the provided context.
+	UpdateWithContext(ctx context.Context, user *User) (*string, *Response, error)
+}
+
+// UsersStub is an implementation of the UsersAPI for tests.
+// Every method calls the field of the same name with a Func suffix.
+// When it is nil, the method calls the field of its context variant,
+// or of the method it is the context variant of, and panics if that
+// one is nil too.
+type UsersStub struct {
+	CreateFunc            func(user *User) (*User, *Response, error)
+	CreateWithContextFunc func(ctx context.Context, user *User) (*User, *Response, error)
+	DeleteFunc            func(user string) (*string, *Response, error)
+	DeleteWithContextFunc func(ctx context.Context, user string) (*string, *Response, error)
+	GetFunc               func(user string) (*User, *Response, error)
+	GetAllFunc            func() (*[]User, *Response, error)
+	GetAllWithContextFunc func(ctx context.Context) (*[]User, *Response, error)
+	GetWithContextFunc    func(ctx context.Context, user string) (*User, *Response, error)
+	UpdateFunc            func(user *User) (*string, *Response, error)
+	UpdateWithContextFunc func(ctx context.Context, user *User) (*string, *Response, error)
+}
+
+// Create calls CreateFunc.
+func (stub *UsersStub) Create(user *User) (*User, *Response, error) {
+	if stub.CreateFunc != nil {
+		return stub.CreateFunc(user)
+	}
+	if stub.CreateWithContextFunc != nil {
+		return stub.CreateWithContextFunc(context.Background(), user)
+	}
+	panic("UsersStub.Create not stubbed")
+}
+
+// CreateWithContext calls CreateWithContextFunc.
+func (stub *UsersStub) CreateWithContext(ctx context.Context, user *User) (*User, *Response, error) {
+	if stub.CreateWithContextFunc != nil {
+		return stub.CreateWithContextFunc(ctx, user)
+	}
+	if stub.CreateFunc != nil {
+		return stub.CreateFunc(user)
+	}
+	panic("UsersStub.CreateWithContext not stubbed")
+}
+
+// Delete calls DeleteFunc.
+func (stub *UsersStub) Delete(user string) (*string, *Response, error) {
+	if stub.DeleteFunc != nil {
+		return stub.DeleteFunc(user)
+	}
+	if stub.DeleteWithContextFunc != nil {
+		return stub.DeleteWithContextFunc(context.Background(), user)
+	}
+	panic("UsersStub.Delete not stubbed")
+}
+
+// DeleteWithContext calls DeleteWithContextFunc.
+func (stub *UsersStub) DeleteWithContext(ctx context.Context, user string) (*string, *Response, error) {
+	if stub.DeleteWithContextFunc != nil {
+		return stub.DeleteWithContextFunc(ctx, user)
+	}
+	if stub.DeleteFunc != nil {
+		return stub.DeleteFunc(user)
+	}
+	panic("UsersStub.DeleteWithContext not stubbed")
+}
+
+// Get calls GetFunc.
+func (stub *UsersStub) Get(user string) (*User, *Response, error) {
+	if stub.GetFunc != nil {
+		return stub.GetFunc(user)
+	}
+	if stub.GetWithContextFunc != nil {
+		return stub.GetWithContextFunc(context.Background(), user)
+	}
+	panic("UsersStub.Get not stubbed")
+}
+
+// GetAll calls GetAllFunc.
+func (stub *UsersStub) GetAll() (*[]User, *Response, error) {
+	if stub.GetAllFunc != nil {
+		return stub.GetAllFunc()
+	}
+	if stub.GetAllWithContextFunc != nil {
+		return stub.GetAllWithContextFunc(context.Background())
+	}
+	panic("UsersStub.GetAll not stubbed")
+}
+
+// GetAllWithContext calls GetAllWithContextFunc.
+func (stub *UsersStub) GetAllWithContext(ctx context.Context) (*[]User, *Response, error) {
+	if stub.GetAllWithContextFunc != nil {
+		return stub.GetAllWithContextFunc(ctx)
+	}
+	if stub.GetAllFunc != nil {
+		return stub.GetAllFunc()
+	}
+	panic("UsersStub.GetAllWithContext not stubbed")
+}
+
+// GetWithContext calls GetWithContextFunc.
+func (stub *UsersStub) GetWithContext(ctx context.Context, user string) (*User, *Response, error) {
+	if stub.GetWithContextFunc != nil {
+		return stub.GetWithContextFunc(ctx, user)
+	}
+	if stub.GetFunc != nil {
+		return stub.GetFunc(user)
+	}
+	panic("UsersStub.GetWithContext not stubbed")
+}
+
+// Update calls UpdateFunc.
+func (stub *UsersStub) Update(user *User) (*string, *Response, error) {
+	if stub.UpdateFunc != nil {
+		return stub.UpdateFunc(user)
+	}
+	if stub.UpdateWithContextFunc != nil {
+		return stub.UpdateWithContextFunc(context.Background(), user)
+	}
+	panic("UsersStub.Update not stubbed")
+}
+
+// UpdateWithContext calls UpdateWithContextFunc.
+func (stub *UsersStub) UpdateWithContext(ctx context.Context, user *User) (*string, *Response, error) {
+	if stub.UpdateWithContextFunc != nil {
+		return stub.UpdateWithContextFunc(ctx, user)
+	}
+	if stub.UpdateFunc != nil {
+		return stub.UpdateFunc(user)
+	}
+	panic("UsersStub.UpdateWithContext not stubbed")
+}
+
+var (
+	_ UsersAPI = (*UsersService)(nil)
+	_ UsersAPI = (*UsersStub)(nil)
+)
@@ -0,0 +1,269 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xraytest
+
+import (
+	"strings"
+	"time"
+)
+
+// severities lists the severities of Xray, from the lowest to the highest.
+var severities = []string{"Unknown", "Information", "Low", "Medium", "High", "Critical"}
+
+// bannedLicenseSeverity is the severity of the issues for banned licenses.
+const bannedLicenseSeverity = "High"
+
+// Component is a component indexed by the Server, like a package or a
+// library. Components refer to their CVEs, licenses and dependencies by ID
+// and name; the ones not added to the Server are ignored.
+type Component struct {
+	// ID is the component ID, like "gav://org.slf4j:slf4j-api:1.7.30".
+	ID string
+	// Name is the name of the component. It defaults to the ID without
+	// its package type prefix.
+	Name string
+	// PkgType is the package type. It defaults to the prefix of the ID,
+	// like "Maven" for "gav://" IDs.
+	PkgType string
+	// Path is the path of the component in Artifactory, like
+	// "libs-release-local/org/slf4j/slf4j-api/1.7.30/slf4j-api-1.7.30.jar".
+	Path string
+	// SHA1 and SHA256 are the checksums of the component.
+	SHA1   string
+	SHA256 string
+
+	// CVEs are the IDs of the CVEs affecting the component itself.
+	CVEs []string
+	// Licenses are the names of the licenses of the component.
+	Licenses []string
+	// Dependencies are the IDs of the components this one depends on.
+	// Their issues and licenses are reported on this component too.
+	Dependencies []string
+}
+
+// CVE is a vulnerability known to the Server.
+type CVE struct {
+	// ID is the CVE ID, like "CVE-2021-44228".
+	ID string
+	// Summary and Description describe the vulnerability.
+	Summary     string
+	Description string
+	// Severity is one of "Information", "Low", "Medium", "High" and
+	// "Critical". It defaults to "Unknown".
+	Severity string
+	// CVSSv2 and CVSSv3 are the CVSS scores with their vectors,
+	// like "10.0/CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:C/C:H/I:H/A:H".
+	CVSSv2 string
+	CVSSv3 string
+	// CWE are the IDs of the weaknesses, like "CWE-502".
+	CWE []string
+	// Created is the time the vulnerability was published. It defaults to
+	// the time the CVE was added to the Server.
+	Created time.Time
+}
+
+// License is a license known to the Server.
+type License struct {
+	// Name is the short name of the license, like "Apache-2.0".
+	Name string
+	// FullName is the full name of the license. It defaults to the Name.
+	FullName string
+	// MoreInfoURL are the links to the text of the license.
+	MoreInfoURL []string
+	// Banned reports components with the license as issues of
+	// severity "High" in the scans of builds.
+	Banned bool
+}
+
+// Build is a build known to the Server, made of components.
+type Build struct {
+	// Name and Number identify the build.
+	Name   string
+	Number string
+	// Components are the IDs of the components the build produced.
+	Components []string
+}
+
+// AddComponent adds the component to the Server, replacing the one with
+// the same ID, if any.
+func (s *Server) AddComponent(component Component) {
+	if component.PkgType == "" {
+		component.PkgType = pkgType(component.ID)
+	}
+
+	if component.Name == "" {
+		_, component.Name, _ = strings.Cut(component.ID, "://")
+		if component.Name == "" {
+			component.Name = component.ID
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.components[component.ID] = &component
+}
+
+// AddCVE adds the CVE to the Server, replacing the one with the same ID,
+// if any.
+func (s *Server) AddCVE(cve CVE) {
+	if cve.Severity == "" {
+		cve.Severity = severities[0]
+	}
+
+	if cve.Created.IsZero() {
+		cve.Created = now()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.cves[cve.ID] = &cve
+}
+
+// AddLicense adds the license to the Server, replacing the one with the
+// same name, if any.
+func (s *Server) AddLicense(license License) {
+	if license.FullName == "" {
+		license.FullName = license.Name
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.licenses[license.Name] = &license
+}
+
+// AddBuild adds the build to the Server, replacing the one with the same
+// name and number, if any.
+func (s *Server) AddBuild(build Build) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.builds[buildID(build.Name, build.Number)] = &build
+}
+
+// SetFailSeverity sets the lowest severity of the issues failing the scans
+// of builds. By default, any issue fails the build.
+func (s *Server) SetFailSeverity(severity string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.failSeverity = severity
+}
+
+// pkgTypes maps the prefixes of the component IDs to their package types.
+var pkgTypes = map[string]string{
+	"bower":   "Bower",
+	"build":   "Build",
+	"deb":     "Debian",
+	"docker":  "Docker",
+	"gav":     "Maven",
+	"generic": "Generic",
+	"go":      "Go",
+	"npm":     "Npm",
+	"nuget":   "NuGet",
+	"pypi":    "Pypi",
+	"rpm":     "Rpm",
+	"sha256":  "Generic",
+}
+
+// pkgType returns the package type of the component with the provided ID.
+func pkgType(id string) string {
+	prefix, _, found := strings.Cut(id, "://")
+	if !found {
+		return "Generic"
+	}
+
+	if t, ok := pkgTypes[prefix]; ok {
+		return t
+	}
+
+	return strings.ToUpper(prefix[:1]) + prefix[1:]
+}
+
+// buildID returns the component ID of the build.
+func buildID(name, number string) string {
+	return "build://" + name + ":" + number
+}
+
+// rank returns the rank of the severity, higher for the more severe.
+func rank(severity string) int {
+	for i, s := range severities {
+		if strings.EqualFold(s, severity) {
+			return i
+		}
+	}
+
+	return 0
+}
+
+// dependency is a component reached from another one.
+type dependency struct {
+	*Component
+
+	// path lists the names of the components leading to this one,
+	// starting with the component it was reached from.
+	path []string
+}
+
+// dependencies returns the component and all the components it depends
+// on, directly or not, each once, in depth-first order.
+func (s *Server) dependencies(component *Component) []dependency {
+	visited := map[string]bool{}
+
+	var deps []dependency
+
+	var walk func(c *Component, path []string)
+	walk = func(c *Component, path []string) {
+		if visited[c.ID] {
+			return
+		}
+		visited[c.ID] = true
+
+		path = append(path[:len(path):len(path)], c.Name)
+		deps = append(deps, dependency{Component: c, path: path})
+
+		for _, id := range c.Dependencies {
+			if d, ok := s.components[id]; ok {
+				walk(d, path)
+			}
+		}
+	}
+	walk(component, nil)
+
+	return deps
+}
+
+// findComponent returns the component with the provided checksum or path.
+// Paths may be prefixed by the ID of Artifactory, like "default/".
+func (s *Server) findComponent(identifier string) *Component {
+	if identifier == "" {
+		return nil
+	}
+
+	for _, id := range sortedKeys(s.components) {
+		c := s.components[id]
+
+		switch {
+		case identifier == c.SHA256, identifier == c.SHA1:
+			return c
+		case c.Path != "" && (identifier == c.Path || strings.HasSuffix(identifier, "/"+c.Path)):
+			return c
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,210 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xraytest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/target/go-arty/v2/xray"
+)
+
+// watchName is the name of the watch raising the alerts of the Server.
+const watchName = "xraytest"
+
+func (s *Server) scanArtifact(c *gin.Context) {
+	var req xray.ScanArtifactRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeError(c, http.StatusBadRequest, "Failed to parse request")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, ok := s.components[req.GetComponentID()]
+	if !ok && req.Checksum != nil {
+		ok = s.findComponent(req.Checksum.GetSha256()) != nil || s.findComponent(req.Checksum.GetSha1()) != nil
+	}
+
+	if !ok {
+		writeError(c, http.StatusNotFound, fmt.Sprintf("Component %s doesn't exist", req.GetComponentID()))
+		return
+	}
+
+	c.JSON(http.StatusOK, xray.ScanArtifactResponse{Info: xray.String("Scan of artifact is in progress")})
+}
+
+func (s *Server) scanBuild(c *gin.Context) {
+	var req xray.ScanBuildRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeError(c, http.StatusBadRequest, "Failed to parse request")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	name, number := req.GetBuildName(), req.GetBuildNumber()
+
+	build, ok := s.build(c, name, number)
+	if !ok {
+		return
+	}
+
+	issues := s.issues(build)
+
+	top, fail := "", false
+	for _, issue := range issues {
+		if rank(issue.GetSeverity()) > rank(top) || top == "" {
+			top = issue.GetSeverity()
+		}
+
+		fail = fail || rank(issue.GetSeverity()) >= rank(s.failSeverity)
+	}
+
+	alerts := []xray.ScanAlert{}
+	message := fmt.Sprintf("Build %s number %s was scanned by Xray and passed with no Alerts", name, number)
+
+	if len(issues) > 0 {
+		alerts = append(alerts, xray.ScanAlert{
+			Created:     &xray.Timestamp{Time: now()},
+			Issues:      &issues,
+			TopSeverity: xray.String(top),
+			WatchName:   xray.String(watchName),
+		})
+		message = fmt.Sprintf("Build %s number %s was scanned by Xray and %d Alerts were generated", name, number, len(issues))
+	}
+
+	summary := s.summarize(build)
+
+	licenses := []xray.ScanLicense{}
+	for _, l := range summary.GetLicenses() {
+		licenses = append(licenses, xray.ScanLicense(l))
+	}
+
+	c.JSON(http.StatusOK, xray.ScanBuildResponse{
+		Summary: &xray.ScanSummary{
+			FailBuild:      xray.Bool(fail),
+			Message:        xray.String(message),
+			MoreDetailsURL: xray.String(fmt.Sprintf("%s/web/#/component/details/build:~2F~2F%s/%s", s.URL, name, number)),
+			TotalAlerts:    xray.String(strconv.Itoa(len(issues))),
+		},
+		Alerts:   &alerts,
+		Licenses: &licenses,
+	})
+}
+
+// issues returns the security issues for the CVEs and the license issues
+// for the banned licenses of the components of the build, each impacting
+// the components of the build depending on them.
+func (s *Server) issues(build *Component) []xray.ScanIssue {
+	issues := []xray.ScanIssue{}
+	byKey := map[string]int{}
+
+	// add records the infected dependency of the artifact of the build
+	// on the issue with the provided key, adding the issue if needed.
+	add := func(key string, artifact *Component, d dependency, issue xray.ScanIssue) {
+		i, ok := byKey[key]
+		if !ok {
+			i = len(issues)
+			byKey[key] = i
+
+			issue.ImpactedArtifacts = &[]xray.ScanImpactedArtifact{}
+			issues = append(issues, issue)
+		}
+
+		impacted := issues[i].ImpactedArtifacts
+		if n := len(*impacted); n == 0 || (*impacted)[n-1].GetName() != artifact.Name {
+			*impacted = append(*impacted, xray.ScanImpactedArtifact{
+				Depth:         xray.String("0"),
+				DisplayName:   xray.String(artifact.Name),
+				InfectedFiles: &[]xray.ScanInfectedFile{},
+				Name:          xray.String(artifact.Name),
+				Path:          optional(artifact.Path),
+				PkgType:       xray.String(artifact.PkgType),
+				Sha1:          optional(artifact.SHA1),
+				Sha256:        optional(artifact.SHA256),
+			})
+		}
+
+		files := (*impacted)[len(*impacted)-1].InfectedFiles
+		*files = append(*files, xray.ScanInfectedFile{
+			ComponentID: xray.String(d.ID),
+			Depth:       xray.String(strconv.Itoa(len(d.path) - 1)),
+			DisplayName: xray.String(d.Name),
+			Name:        xray.String(d.Name),
+			Path:        optional(d.Path),
+			PkgType:     xray.String(d.PkgType),
+			Sha1:        optional(d.SHA1),
+			Sha256:      optional(d.SHA256),
+		})
+	}
+
+	for _, id := range build.Dependencies {
+		artifact, ok := s.components[id]
+		if !ok {
+			continue
+		}
+
+		for _, d := range s.dependencies(artifact) {
+			for _, id := range d.CVEs {
+				cve, ok := s.cves[id]
+				if !ok {
+					continue
+				}
+
+				add("security:"+id, artifact, d, xray.ScanIssue{
+					Created:     &xray.Timestamp{Time: cve.Created},
+					Cve:         xray.String(cve.ID),
+					Description: xray.String(cve.Description),
+					Provider:    xray.String("JFrog"),
+					Severity:    xray.String(cve.Severity),
+					Summary:     xray.String(cve.Summary),
+					Type:        xray.String("security"),
+				})
+			}
+
+			for _, name := range d.Licenses {
+				license, ok := s.licenses[name]
+				if !ok || !license.Banned {
+					continue
+				}
+
+				add("license:"+name, artifact, d, xray.ScanIssue{
+					Created:     &xray.Timestamp{Time: now()},
+					Description: xray.String(fmt.Sprintf("The license %s is banned", license.FullName)),
+					Provider:    xray.String("JFrog"),
+					Severity:    xray.String(bannedLicenseSeverity),
+					Summary:     xray.String(fmt.Sprintf("Banned license %s", license.Name)),
+					Type:        xray.String("license"),
+				})
+			}
+		}
+	}
+
+	return issues
+}
+
+// optional returns a pointer to the string, or nil if it is empty.
+func optional(v string) *string {
+	if v == "" {
+		return nil
+	}
+
+	return &v
+}
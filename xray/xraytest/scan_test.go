@@ -0,0 +1,122 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xraytest
+
+import (
+	"testing"
+
+	"github.com/franela/goblin"
+	"github.com/target/go-arty/v2/xray"
+)
+
+func Test_Scan(t *testing.T) {
+	s := NewServer()
+
+	c, _ := xray.NewClient(s.URL, nil)
+
+	g := goblin.Goblin(t)
+	g.Describe("Scan", func() {
+		g.BeforeEach(func() {
+			s.Reset()
+			seed(s)
+		})
+
+		g.After(func() {
+			s.Close()
+		})
+
+		g.It("- should scan a build and fail it", func() {
+			scan, _, err := c.Scan.Build(&xray.ScanBuildRequest{
+				BuildName:   xray.String("app"),
+				BuildNumber: xray.String("42"),
+			})
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(scan.GetSummary().GetFailBuild()).IsTrue()
+			g.Assert(scan.GetSummary().GetTotalAlerts()).Equal("3")
+			g.Assert(len(scan.GetAlerts())).Equal(1)
+
+			alert := scan.GetAlerts()[0]
+			g.Assert(alert.GetTopSeverity()).Equal("Critical")
+			g.Assert(alert.GetWatchName()).Equal(watchName)
+
+			issues := alert.GetIssues()
+			g.Assert(issues[0].GetCve()).Equal("CVE-2021-44228")
+			g.Assert(issues[0].GetType()).Equal("security")
+			g.Assert(issues[2].GetType()).Equal("license")
+			g.Assert(issues[2].GetSeverity()).Equal("High")
+
+			impacted := issues[0].GetImpactedArtifacts()[0]
+			g.Assert(impacted.GetName()).Equal("com.example:app:1.0")
+			g.Assert(impacted.GetSha256()).Equal(appSha256)
+			g.Assert(impacted.GetInfectedFiles()[0].GetComponentID()).Equal(log4j)
+			g.Assert(impacted.GetInfectedFiles()[0].GetDepth()).Equal("1")
+
+			g.Assert(len(scan.GetLicenses())).Equal(2)
+		})
+
+		g.It("- should pass a build below the fail severity", func() {
+			s.SetFailSeverity("Critical")
+			s.AddComponent(Component{ID: log4j, Licenses: []string{"Apache-2.0"}})
+
+			scan, _, err := c.Scan.Build(&xray.ScanBuildRequest{
+				BuildName:   xray.String("app"),
+				BuildNumber: xray.String("42"),
+			})
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(scan.GetSummary().GetFailBuild()).IsFalse()
+			g.Assert(scan.GetSummary().GetTotalAlerts()).Equal("2")
+			g.Assert(scan.GetAlerts()[0].GetTopSeverity()).Equal("High")
+		})
+
+		g.It("- should pass a build without issues", func() {
+			s.AddBuild(Build{Name: "lib", Number: "1", Components: []string{"npm://left-pad:1.0.0"}})
+			s.AddComponent(Component{ID: "npm://left-pad:1.0.0"})
+
+			scan, _, err := c.Scan.Build(&xray.ScanBuildRequest{
+				BuildName:   xray.String("lib"),
+				BuildNumber: xray.String("1"),
+			})
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(scan.GetSummary().GetFailBuild()).IsFalse()
+			g.Assert(scan.GetSummary().GetMessage()).Equal("Build lib number 1 was scanned by Xray and passed with no Alerts")
+			g.Assert(len(scan.GetAlerts())).Equal(0)
+		})
+
+		g.It("- should return an error for an unknown build", func() {
+			_, resp, err := c.Scan.Build(&xray.ScanBuildRequest{
+				BuildName:   xray.String("app"),
+				BuildNumber: xray.String("43"),
+			})
+
+			g.Assert(err != nil).IsTrue()
+			g.Assert(resp.StatusCode).Equal(404)
+		})
+
+		g.It("- should scan a known artifact", func() {
+			scan, _, err := c.Scan.Artifact(&xray.ScanArtifactRequest{ComponentID: xray.String(log4j)})
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(scan.GetInfo()).Equal("Scan of artifact is in progress")
+
+			_, resp, err := c.Scan.Artifact(&xray.ScanArtifactRequest{ComponentID: xray.String("gav://missing:missing:1")})
+
+			g.Assert(err != nil).IsTrue()
+			g.Assert(resp.StatusCode).Equal(404)
+		})
+	})
+}
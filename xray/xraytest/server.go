@@ -0,0 +1,125 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package xraytest provides a fake Xray server for tests.
+//
+// The Server emulates the Xray API used by the xray package with in-memory
+// state. Tests seed it with components, CVEs, licenses and builds, and the
+// artifact and build summaries and build scans are computed from them:
+//
+//	s := xraytest.NewServer()
+//	defer s.Close()
+//
+//	s.AddCVE(xraytest.CVE{ID: "CVE-2021-44228", Severity: "Critical"})
+//	s.AddComponent(xraytest.Component{
+//		ID:   "gav://org.apache.logging.log4j:log4j-core:2.14.1",
+//		CVEs: []string{"CVE-2021-44228"},
+//	})
+//
+//	client, _ := xray.NewClient(s.URL, nil)
+//
+// Users created through the API persist across calls. Requests are not
+// authenticated.
+package xraytest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/target/go-arty/v2/xray"
+)
+
+// Server is a fake Xray server with in-memory state.
+type Server struct {
+	*httptest.Server
+
+	mu           sync.Mutex
+	components   map[string]*Component
+	cves         map[string]*CVE
+	licenses     map[string]*License
+	builds       map[string]*Build
+	users        map[string]*xray.User
+	failSeverity string
+}
+
+// NewServer starts and returns a new Server without any content.
+// The caller should call Close when finished, to shut it down.
+func NewServer() *Server {
+	s := new(Server)
+	s.Reset()
+	s.Server = httptest.NewServer(s.handler())
+
+	return s
+}
+
+// Reset removes all the content of the Server.
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.components = map[string]*Component{}
+	s.cves = map[string]*CVE{}
+	s.licenses = map[string]*License{}
+	s.builds = map[string]*Build{}
+	s.users = map[string]*xray.User{}
+	s.failSeverity = ""
+}
+
+// handler returns the http.Handler routing the requests to the Server.
+func (s *Server) handler() http.Handler {
+	e := gin.New()
+
+	e.GET("/api/v1/system/ping", s.ping)
+	e.GET("/api/v1/system/version", s.getVersion)
+
+	e.POST("/api/v1/summary/artifact", s.summarizeArtifacts)
+	e.GET("/api/v1/summary/build", s.summarizeBuild)
+
+	e.POST("/api/v1/scanArtifact", s.scanArtifact)
+	e.POST("/api/v1/scanBuild", s.scanBuild)
+
+	e.GET("/api/v1/users", s.getUsers)
+	e.GET("/api/v1/users/:user", s.getUser)
+	e.POST("/api/v1/users", s.createUser)
+	e.PUT("/api/v1/users/:user", s.updateUser)
+	e.DELETE("/api/v1/users/:user", s.deleteUser)
+
+	return e
+}
+
+// writeError replies with an error in the shape returned by Xray.
+func writeError(c *gin.Context, status int, message string) {
+	c.JSON(status, gin.H{"error": message})
+}
+
+// now returns the current time, as precise as the times of Xray.
+func now() time.Time {
+	return time.Now().UTC().Truncate(time.Millisecond)
+}
+
+// sortedKeys returns the keys of the provided map in order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
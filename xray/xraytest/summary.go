@@ -0,0 +1,173 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xraytest
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/target/go-arty/v2/xray"
+)
+
+// notIndexed is the error reported for artifacts unknown to the Server.
+const notIndexed = "Artifact doesn't exist or not indexed/cached in Xray"
+
+func (s *Server) summarizeArtifacts(c *gin.Context) {
+	var req xray.SummaryArtifactRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		writeError(c, http.StatusBadRequest, "Failed to parse request")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	res := xray.SummaryResponse{
+		Artifacts: &[]xray.SummaryArtifact{},
+		Errors:    &[]xray.SummaryError{},
+	}
+
+	for _, identifier := range append(req.GetChecksums(), req.GetPaths()...) {
+		component := s.findComponent(identifier)
+		if component == nil {
+			*res.Errors = append(*res.Errors, xray.SummaryError{
+				Error:      xray.String(notIndexed),
+				Identifier: xray.String(identifier),
+			})
+
+			continue
+		}
+
+		*res.Artifacts = append(*res.Artifacts, s.summarize(component))
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (s *Server) summarizeBuild(c *gin.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	build, ok := s.build(c, c.Query("build_name"), c.Query("build_number"))
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, xray.SummaryResponse{
+		Artifacts: &[]xray.SummaryArtifact{s.summarize(build)},
+		Errors:    &[]xray.SummaryError{},
+	})
+}
+
+// build returns the component standing for the build with the provided
+// name and number, replying with an error if it does not exist.
+func (s *Server) build(c *gin.Context, name, number string) (*Component, bool) {
+	b, ok := s.builds[buildID(name, number)]
+	if !ok {
+		writeError(c, http.StatusNotFound, fmt.Sprintf("Build %s number %s doesn't exist", name, number))
+		return nil, false
+	}
+
+	return &Component{
+		ID:           buildID(b.Name, b.Number),
+		Name:         b.Name + ":" + b.Number,
+		PkgType:      pkgTypes["build"],
+		Dependencies: b.Components,
+	}, true
+}
+
+// summarize returns the summary of the component, with the issues and
+// licenses of all its dependencies.
+func (s *Server) summarize(component *Component) xray.SummaryArtifact {
+	general := &xray.SummaryGeneral{
+		ComponentID: xray.String(component.ID),
+		Name:        xray.String(component.Name),
+		Path:        optional(component.Path),
+		PkgType:     xray.String(component.PkgType),
+		Sha256:      optional(component.SHA256),
+	}
+
+	root := component.Name
+	if component.Path != "" {
+		root = component.Path
+	}
+
+	issues := []xray.SummaryIssue{}
+	byCVE := map[string]int{}
+	licenses := []xray.SummaryLicense{}
+	byLicense := map[string]int{}
+
+	for _, d := range s.dependencies(component) {
+		impactPath := strings.Join(append([]string{root}, d.path[1:]...), "/")
+
+		for _, id := range d.CVEs {
+			cve, ok := s.cves[id]
+			if !ok {
+				continue
+			}
+
+			if i, ok := byCVE[id]; ok {
+				*issues[i].ImpactPath = append(*issues[i].ImpactPath, impactPath)
+				continue
+			}
+
+			byCVE[id] = len(issues)
+			issues = append(issues, xray.SummaryIssue{
+				Created:     xray.String(cve.Created.Format(time.RFC3339)),
+				Description: xray.String(cve.Description),
+				ImpactPath:  &[]string{impactPath},
+				IssueType:   xray.String("security"),
+				Provider:    xray.String("JFrog"),
+				Cves: &[]xray.SummaryCve{{
+					Cve:   xray.String(cve.ID),
+					Cwe:   &cve.CWE,
+					Cvss2: xray.String(cve.CVSSv2),
+					Cvss3: xray.String(cve.CVSSv3),
+				}},
+				Severity: xray.String(cve.Severity),
+				Summary:  xray.String(cve.Summary),
+			})
+		}
+
+		for _, name := range d.Licenses {
+			license, ok := s.licenses[name]
+			if !ok {
+				continue
+			}
+
+			if i, ok := byLicense[name]; ok {
+				*licenses[i].Components = append(*licenses[i].Components, d.ID)
+				continue
+			}
+
+			byLicense[name] = len(licenses)
+			licenses = append(licenses, xray.SummaryLicense{
+				Components:  &[]string{d.ID},
+				FullName:    xray.String(license.FullName),
+				MoreInfoURL: &license.MoreInfoURL,
+				Name:        xray.String(license.Name),
+			})
+		}
+	}
+
+	return xray.SummaryArtifact{
+		General:  general,
+		Issues:   &issues,
+		Licenses: &licenses,
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xraytest
+
+import (
+	"testing"
+
+	"github.com/franela/goblin"
+	"github.com/target/go-arty/v2/xray"
+)
+
+const (
+	app    = "gav://com.example:app:1.0"
+	log4j  = "gav://org.apache.logging.log4j:log4j-core:2.14.1"
+	readln = "gav://org.gnu:readline:8.1"
+
+	appPath   = "libs-release-local/com/example/app/1.0/app-1.0.jar"
+	appSha256 = "4bf5122f344554c53bde2ebb8cd2b7e3d1600ad631c385a5d7cce23c7785459a"
+)
+
+// seed adds an application depending on a vulnerable library and on a
+// library with a banned license to the Server, and a build producing it.
+func seed(s *Server) {
+	s.AddCVE(CVE{
+		ID:       "CVE-2021-44228",
+		Summary:  "Log4Shell",
+		Severity: "Critical",
+		CVSSv3:   "10.0/CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:C/C:H/I:H/A:H",
+		CWE:      []string{"CWE-502"},
+	})
+	s.AddCVE(CVE{ID: "CVE-2022-0001", Severity: "Low"})
+
+	s.AddLicense(License{Name: "Apache-2.0", FullName: "The Apache Software License, Version 2.0"})
+	s.AddLicense(License{Name: "GPL-3.0", Banned: true})
+
+	s.AddComponent(Component{
+		ID:           app,
+		Path:         appPath,
+		SHA256:       appSha256,
+		Licenses:     []string{"Apache-2.0"},
+		Dependencies: []string{log4j, readln},
+	})
+	s.AddComponent(Component{
+		ID:       log4j,
+		CVEs:     []string{"CVE-2021-44228"},
+		Licenses: []string{"Apache-2.0"},
+	})
+	s.AddComponent(Component{
+		ID:           readln,
+		CVEs:         []string{"CVE-2022-0001"},
+		Licenses:     []string{"GPL-3.0"},
+		Dependencies: []string{app},
+	})
+
+	s.AddBuild(Build{Name: "app", Number: "42", Components: []string{app}})
+}
+
+func Test_Summary(t *testing.T) {
+	s := NewServer()
+
+	c, _ := xray.NewClient(s.URL, nil)
+
+	g := goblin.Goblin(t)
+	g.Describe("Summary", func() {
+		g.BeforeEach(func() {
+			s.Reset()
+			seed(s)
+		})
+
+		g.After(func() {
+			s.Close()
+		})
+
+		g.It("- should summarize artifacts by checksum and path", func() {
+			summary, _, err := c.Summary.Artifact(&xray.SummaryArtifactRequest{
+				Checksums: &[]string{appSha256},
+				Paths:     &[]string{"default/" + appPath},
+			})
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(len(summary.GetArtifacts())).Equal(2)
+			g.Assert(len(summary.GetErrors())).Equal(0)
+
+			artifact := summary.GetArtifacts()[0]
+			g.Assert(artifact.GetGeneral().GetComponentID()).Equal(app)
+			g.Assert(artifact.GetGeneral().GetName()).Equal("com.example:app:1.0")
+			g.Assert(artifact.GetGeneral().GetPkgType()).Equal("Maven")
+			g.Assert(artifact.GetGeneral().GetPath()).Equal(appPath)
+			g.Assert(len(artifact.GetIssues())).Equal(2)
+
+			issue := artifact.GetIssues()[0]
+			g.Assert(issue.GetSeverity()).Equal("Critical")
+			g.Assert(issue.GetSummary()).Equal("Log4Shell")
+			g.Assert(issue.GetIssueType()).Equal("security")
+			g.Assert(issue.GetImpactPath()).Equal([]string{appPath + "/org.apache.logging.log4j:log4j-core:2.14.1"})
+			g.Assert(issue.GetCves()[0].GetCve()).Equal("CVE-2021-44228")
+			g.Assert(issue.GetCves()[0].GetCwe()).Equal([]string{"CWE-502"})
+		})
+
+		g.It("- should aggregate the licenses of the dependencies", func() {
+			summary, _, err := c.Summary.Artifact(&xray.SummaryArtifactRequest{Checksums: &[]string{appSha256}})
+
+			g.Assert(err == nil).IsTrue()
+
+			licenses := summary.GetArtifacts()[0].GetLicenses()
+			g.Assert(len(licenses)).Equal(2)
+			g.Assert(licenses[0].GetName()).Equal("Apache-2.0")
+			g.Assert(licenses[0].GetFullName()).Equal("The Apache Software License, Version 2.0")
+			g.Assert(licenses[0].GetComponents()).Equal([]string{app, log4j})
+			g.Assert(licenses[1].GetName()).Equal("GPL-3.0")
+			g.Assert(licenses[1].GetComponents()).Equal([]string{readln})
+		})
+
+		g.It("- should report unknown artifacts as errors", func() {
+			summary, _, err := c.Summary.Artifact(&xray.SummaryArtifactRequest{Paths: &[]string{"default/libs-release-local/missing.jar"}})
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(len(summary.GetArtifacts())).Equal(0)
+			g.Assert(summary.GetErrors()[0].GetIdentifier()).Equal("default/libs-release-local/missing.jar")
+			g.Assert(summary.GetErrors()[0].GetError()).Equal(notIndexed)
+		})
+
+		g.It("- should ignore the CVEs not added to the server", func() {
+			s.AddComponent(Component{ID: "npm://left-pad:1.0.0", SHA256: "abc", CVEs: []string{"CVE-1999-0001"}})
+
+			summary, _, err := c.Summary.Artifact(&xray.SummaryArtifactRequest{Checksums: &[]string{"abc"}})
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(len(summary.GetArtifacts()[0].GetIssues())).Equal(0)
+			g.Assert(summary.GetArtifacts()[0].GetGeneral().GetPkgType()).Equal("Npm")
+		})
+
+		g.It("- should summarize a build", func() {
+			summary, _, err := c.Summary.Build("app", 42)
+
+			g.Assert(err == nil).IsTrue()
+
+			artifact := summary.GetArtifacts()[0]
+			g.Assert(artifact.GetGeneral().GetComponentID()).Equal("build://app:42")
+			g.Assert(artifact.GetGeneral().GetPkgType()).Equal("Build")
+			g.Assert(len(artifact.GetIssues())).Equal(2)
+			g.Assert(artifact.GetIssues()[1].GetImpactPath()).Equal([]string{"app:42/com.example:app:1.0/org.gnu:readline:8.1"})
+		})
+
+		g.It("- should return an error for an unknown build", func() {
+			_, resp, err := c.Summary.Build("app", 43)
+
+			g.Assert(err != nil).IsTrue()
+			g.Assert(resp.StatusCode).Equal(404)
+		})
+	})
+}
@@ -0,0 +1,38 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xraytest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/target/go-arty/v2/xray"
+)
+
+// Version is the Xray version reported by the Server.
+const Version = "3.82.11"
+
+// ping replies pong, like a healthy Xray.
+func (s *Server) ping(c *gin.Context) {
+	c.JSON(http.StatusOK, xray.Ping{Status: xray.String("pong")})
+}
+
+// getVersion returns the version of the Server.
+func (s *Server) getVersion(c *gin.Context) {
+	c.JSON(http.StatusOK, xray.Versions{
+		XrayVersion:  xray.String(Version),
+		XrayRevision: xray.String("f2b8b6e"),
+	})
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xraytest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/target/go-arty/v2/xray"
+)
+
+func (s *Server) getUsers(c *gin.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	users := []xray.User{}
+	for _, name := range sortedKeys(s.users) {
+		users = append(users, public(s.users[name]))
+	}
+
+	c.JSON(http.StatusOK, users)
+}
+
+func (s *Server) getUser(c *gin.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	user, ok := s.user(c)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, public(user))
+}
+
+func (s *Server) createUser(c *gin.Context) {
+	var user xray.User
+	if err := c.ShouldBindJSON(&user); err != nil || user.GetName() == "" {
+		writeError(c, http.StatusBadRequest, "User name is required")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.users[user.GetName()]; ok {
+		writeError(c, http.StatusConflict, fmt.Sprintf("User %s already exists", user.GetName()))
+		return
+	}
+
+	if user.Admin == nil {
+		user.Admin = xray.Bool(false)
+	}
+
+	s.users[user.GetName()] = &user
+
+	c.JSON(http.StatusCreated, public(&user))
+}
+
+func (s *Server) updateUser(c *gin.Context) {
+	var update xray.User
+	if err := c.ShouldBindJSON(&update); err != nil {
+		writeError(c, http.StatusBadRequest, "Failed to parse request")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	user, ok := s.user(c)
+	if !ok {
+		return
+	}
+
+	if update.Admin != nil {
+		user.Admin = update.Admin
+	}
+	if update.Email != nil {
+		user.Email = update.Email
+	}
+	if update.Password != nil {
+		user.Password = update.Password
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func (s *Server) deleteUser(c *gin.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	user, ok := s.user(c)
+	if !ok {
+		return
+	}
+
+	delete(s.users, user.GetName())
+
+	c.Status(http.StatusOK)
+}
+
+// user returns the user named in the request, replying with an error if
+// it does not exist.
+func (s *Server) user(c *gin.Context) (*xray.User, bool) {
+	user, ok := s.users[c.Param("user")]
+	if !ok {
+		writeError(c, http.StatusNotFound, fmt.Sprintf("User %s doesn't exist", c.Param("user")))
+		return nil, false
+	}
+
+	return user, true
+}
+
+// public returns a copy of the user without its password.
+func public(user *xray.User) xray.User {
+	u := *user
+	u.Password = nil
+
+	return u
+}
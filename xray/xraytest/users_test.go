@@ -0,0 +1,88 @@
+// Copyright (c) 2018 Target Brands, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xraytest
+
+import (
+	"testing"
+
+	"github.com/franela/goblin"
+	"github.com/target/go-arty/v2/xray"
+)
+
+func Test_Users(t *testing.T) {
+	s := NewServer()
+
+	c, _ := xray.NewClient(s.URL, nil)
+
+	g := goblin.Goblin(t)
+	g.Describe("Users", func() {
+		g.BeforeEach(func() {
+			s.Reset()
+		})
+
+		g.After(func() {
+			s.Close()
+		})
+
+		g.It("- should create, update and delete a user", func() {
+			user, _, err := c.Users.Create(&xray.User{
+				Name:     xray.String("jane"),
+				Email:    xray.String("jane@example.com"),
+				Password: xray.String("secret"),
+			})
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(user.GetName()).Equal("jane")
+			g.Assert(user.Password == nil).IsTrue()
+
+			_, _, err = c.Users.Update(&xray.User{Name: xray.String("jane"), Admin: xray.Bool(true)})
+			g.Assert(err == nil).IsTrue()
+
+			user, _, err = c.Users.Get("jane")
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(user.GetEmail()).Equal("jane@example.com")
+			g.Assert(user.GetAdmin()).IsTrue()
+
+			_, _, err = c.Users.Delete("jane")
+			g.Assert(err == nil).IsTrue()
+
+			_, resp, err := c.Users.Get("jane")
+
+			g.Assert(err != nil).IsTrue()
+			g.Assert(resp.StatusCode).Equal(404)
+		})
+
+		g.It("- should list the users", func() {
+			c.Users.Create(&xray.User{Name: xray.String("jane")})
+			c.Users.Create(&xray.User{Name: xray.String("alice")})
+
+			users, _, err := c.Users.GetAll()
+
+			g.Assert(err == nil).IsTrue()
+			g.Assert(len(*users)).Equal(2)
+			g.Assert((*users)[0].GetName()).Equal("alice")
+		})
+
+		g.It("- should return an error for a duplicate user", func() {
+			c.Users.Create(&xray.User{Name: xray.String("jane")})
+
+			_, resp, err := c.Users.Create(&xray.User{Name: xray.String("jane")})
+
+			g.Assert(err != nil).IsTrue()
+			g.Assert(resp.StatusCode).Equal(409)
+		})
+	})
+}